@@ -2,20 +2,36 @@ package langfuse
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
 )
 
 type Organization struct {
 	ID       string            `json:"id"`
 	Name     string            `json:"name"`
 	Metadata map[string]string `json:"metadata"`
+	// CloudConfig is only returned by instances that manage plans, i.e. Langfuse Cloud.
+	CloudConfig *OrganizationCloudConfig `json:"cloudConfig,omitempty"`
+}
+
+// OrganizationCloudConfig holds the plan and usage limits of an organization.
+type OrganizationCloudConfig struct {
+	Plan                    string `json:"plan,omitempty"`
+	MonthlyObservationLimit *int64 `json:"monthlyObservationLimit,omitempty"`
 }
 
 type OrganizationApiKey struct {
 	ID        string `json:"id"`
 	PublicKey string `json:"publicKey"`
 	SecretKey string `json:"secretKey"`
+	// The fields below are only returned when listing keys; the secret key is then masked.
+	DisplaySecretKey string     `json:"displaySecretKey,omitempty"`
+	Note             string     `json:"note,omitempty"`
+	CreatedAt        *time.Time `json:"createdAt,omitempty"`
+	ExpiresAt        *time.Time `json:"expiresAt,omitempty"`
+	LastUsedAt       *time.Time `json:"lastUsedAt,omitempty"`
 }
 
 type ListOrganizationsResponse struct {
@@ -27,9 +43,22 @@ type CreateOrganizationRequest struct {
 	Metadata map[string]string `json:"metadata,omitempty"`
 }
 
+// UpdateOrganizationRequest changes an organization. Langfuse requires the name; Metadata is only
+// sent when set, so a rename leaves metadata changed outside Terraform alone. A non-nil empty
+// Metadata removes all metadata.
 type UpdateOrganizationRequest struct {
 	Name     string            `json:"name"`
-	Metadata map[string]string `json:"metadata,omitempty"`
+	Metadata map[string]string `json:"metadata"`
+}
+
+func (r UpdateOrganizationRequest) MarshalJSON() ([]byte, error) {
+	type request UpdateOrganizationRequest
+	if r.Metadata != nil {
+		return json.Marshal(request(r))
+	}
+	return json.Marshal(struct {
+		Name string `json:"name"`
+	}{Name: r.Name})
 }
 
 type deleteOrganizationResponse struct {
@@ -52,6 +81,7 @@ type AdminClient interface {
 	CreateOrganization(ctx context.Context, request *CreateOrganizationRequest) (*Organization, error)
 	UpdateOrganization(ctx context.Context, orgID string, request *UpdateOrganizationRequest) (*Organization, error)
 	DeleteOrganization(ctx context.Context, orgID string) error
+	ListOrganizationApiKeys(ctx context.Context, orgID string) ([]OrganizationApiKey, error)
 	GetOrganizationApiKey(ctx context.Context, orgID string, apiKeyID string) (*OrganizationApiKey, error)
 	CreateOrganizationApiKey(ctx context.Context, orgID string) (*OrganizationApiKey, error)
 	DeleteOrganizationApiKey(ctx context.Context, orgID string, apiKeyID string) error
@@ -61,6 +91,8 @@ type adminClientImpl struct {
 	host       string
 	apiKey     string
 	httpClient *http.Client
+	// apiVersion is the API version negotiated by the factory's clients; nil for standalone clients.
+	apiVersion *apiVersionTracker
 }
 
 func NewAdminClient(host, apiKey string) AdminClient {
@@ -144,7 +176,9 @@ func (c *adminClientImpl) DeleteOrganization(ctx context.Context, orgID string)
 	return nil
 }
 
-func (c *adminClientImpl) GetOrganizationApiKey(ctx context.Context, orgID string, apiKeyID string) (*OrganizationApiKey, error) {
+// ListOrganizationApiKeys returns every API key of the organization, including keys that were
+// not created through this provider. Secret keys are masked in the response.
+func (c *adminClientImpl) ListOrganizationApiKeys(ctx context.Context, orgID string) ([]OrganizationApiKey, error) {
 	resp, err := c.makeRequest(ctx, http.MethodGet, fmt.Sprintf("api/admin/organizations/%s/apiKeys", orgID), nil)
 	if err != nil {
 		return nil, err
@@ -154,13 +188,22 @@ func (c *adminClientImpl) GetOrganizationApiKey(ctx context.Context, orgID strin
 	if err := decodeResponse(resp, &listOrgApiKeysResp); err != nil {
 		return nil, err
 	}
-	for _, key := range listOrgApiKeysResp.ApiKeys {
+
+	return listOrgApiKeysResp.ApiKeys, nil
+}
+
+func (c *adminClientImpl) GetOrganizationApiKey(ctx context.Context, orgID string, apiKeyID string) (*OrganizationApiKey, error) {
+	apiKeys, err := c.ListOrganizationApiKeys(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range apiKeys {
 		if key.ID == apiKeyID {
 			return &key, nil
 		}
 	}
 
-	return nil, fmt.Errorf("cannot find API key with ID %s in organization %s", apiKeyID, orgID)
+	return nil, fmt.Errorf("cannot find API key with ID %s in organization %s: %w", apiKeyID, orgID, ErrNotFound)
 }
 
 func (c *adminClientImpl) CreateOrganizationApiKey(ctx context.Context, orgID string) (*OrganizationApiKey, error) {
@@ -205,6 +248,9 @@ func (c *adminClientImpl) makeRequest(ctx context.Context, method, apiPath strin
 	if err != nil {
 		return nil, fmt.Errorf("failed to make request: %w", err)
 	}
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		return nil, newAuthError(resp, CredentialTypeAdminKey)
+	}
 
 	return resp, nil
 }
@@ -0,0 +1,62 @@
+package langfuse
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestAdminClientContract(t *testing.T) {
+	host, httpClient := newCassette(t, "admin_client")
+	client := &adminClientImpl{host: host, apiKey: cassetteEnv("LANGFUSE_ADMIN_KEY", "admin-key"), httpClient: httpClient}
+	ctx := context.Background()
+
+	organization, err := client.CreateOrganization(ctx, &CreateOrganizationRequest{
+		Name:     "contract-test",
+		Metadata: map[string]string{"suite": "contract"},
+	})
+	if err != nil {
+		t.Fatalf("CreateOrganization: %v", err)
+	}
+	if organization.ID == "" || organization.Metadata["suite"] != "contract" {
+		t.Errorf("unexpected organization: %+v", organization)
+	}
+
+	apiKey, err := client.CreateOrganizationApiKey(ctx, organization.ID)
+	if err != nil {
+		t.Fatalf("CreateOrganizationApiKey: %v", err)
+	}
+	if apiKey.PublicKey == "" || apiKey.SecretKey == "" {
+		t.Errorf("expected the key pair to be returned at creation, got %+v", apiKey)
+	}
+
+	// Listing never returns the secret, only a masked version of it.
+	apiKeys, err := client.ListOrganizationApiKeys(ctx, organization.ID)
+	if err != nil {
+		t.Fatalf("ListOrganizationApiKeys: %v", err)
+	}
+	if len(apiKeys) != 1 || apiKeys[0].ID != apiKey.ID || apiKeys[0].SecretKey != "" || apiKeys[0].DisplaySecretKey == "" {
+		t.Errorf("unexpected listed keys: %+v", apiKeys)
+	}
+
+	if _, err := client.GetOrganizationApiKey(ctx, organization.ID, "does-not-exist"); err == nil {
+		t.Error("expected GetOrganizationApiKey to fail for an unknown key")
+	}
+
+	if err := client.DeleteOrganizationApiKey(ctx, organization.ID, apiKey.ID); err != nil {
+		t.Fatalf("DeleteOrganizationApiKey: %v", err)
+	}
+	if err := client.DeleteOrganization(ctx, organization.ID); err != nil {
+		t.Fatalf("DeleteOrganization: %v", err)
+	}
+
+	_, err = client.GetOrganization(ctx, organization.ID)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+		t.Fatalf("expected a 404 APIError for a deleted organization, got %v", err)
+	}
+	if apiErr.RequestID == "" {
+		t.Errorf("expected the error to carry the request ID, got %q", apiErr.Error())
+	}
+}
@@ -0,0 +1,80 @@
+package langfuse
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+)
+
+// APIVersionHeader carries the API version on requests, as the highest version the provider
+// speaks, and on responses of instances that negotiate, as the version the instance answers with.
+const APIVersionHeader = "X-Langfuse-Api-Version"
+
+// Versions of the Langfuse API request and response shapes. APIVersionCurrent covers the endpoints
+// of every supported instance; instances that do not negotiate are assumed to speak it.
+// APIVersionNext guarantees the by-ID endpoints of projects, which report the retention, and of
+// project API keys, which current instances may lack.
+const (
+	APIVersionCurrent = 1
+	APIVersionNext    = 2
+)
+
+// maxAPIVersion is the highest API version the provider requests. It is raised once the request
+// shapes of that version are implemented and gated on apiVersionTracker.supports.
+const maxAPIVersion = APIVersionNext
+
+// apiVersionTracker records the API version negotiated with the instance, shared by all clients of
+// a factory. Clients branch on supports where the shapes of versions differ.
+type apiVersionTracker struct {
+	mu         sync.Mutex
+	negotiated int
+}
+
+func (t *apiVersionTracker) observe(resp *http.Response) {
+	version, err := strconv.Atoi(resp.Header.Get(APIVersionHeader))
+	if err != nil || version < APIVersionCurrent {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	// An instance cannot answer with a version that was not requested.
+	t.negotiated = min(version, maxAPIVersion)
+}
+
+// version returns the negotiated API version, APIVersionCurrent until an instance negotiated one.
+func (t *apiVersionTracker) version() int {
+	if t == nil {
+		return APIVersionCurrent
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.negotiated == 0 {
+		return APIVersionCurrent
+	}
+	return t.negotiated
+}
+
+// supports reports whether requests may use the shapes of API version minimum.
+func (t *apiVersionTracker) supports(minimum int) bool {
+	return t.version() >= minimum
+}
+
+// apiVersionTransport requests maxAPIVersion on every request and records the version the instance
+// answers with.
+type apiVersionTransport struct {
+	next    http.RoundTripper
+	tracker *apiVersionTracker
+}
+
+func (t *apiVersionTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set(APIVersionHeader, strconv.Itoa(maxAPIVersion))
+
+	resp, err := t.next.RoundTrip(req)
+	if err == nil {
+		t.tracker.observe(resp)
+	}
+	return resp, err
+}
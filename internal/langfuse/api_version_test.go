@@ -0,0 +1,131 @@
+package langfuse
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+func TestAPIVersionNegotiation(t *testing.T) {
+	testCases := []struct {
+		name     string
+		answered string
+		want     int
+	}{
+		{name: "instance without negotiation", want: APIVersionCurrent},
+		{name: "current version", answered: "1", want: APIVersionCurrent},
+		{name: "version that was not requested", answered: strconv.Itoa(maxAPIVersion + 1), want: maxAPIVersion},
+		{name: "invalid version", answered: "v2", want: APIVersionCurrent},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var requested string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requested = r.Header.Get(APIVersionHeader)
+				if tc.answered != "" {
+					w.Header().Set(APIVersionHeader, tc.answered)
+				}
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"projects":[]}`))
+			}))
+			t.Cleanup(server.Close)
+
+			cf := NewClientFactory(server.URL, "admin-key").(*clientFactoryImpl)
+			client := cf.NewOrganizationClient("pk-org", "sk-org").(*organizationClientImpl)
+			if _, err := client.ListProjects(context.Background()); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if requested != strconv.Itoa(maxAPIVersion) {
+				t.Errorf("expected requested version %d, got %q", maxAPIVersion, requested)
+			}
+			if got := client.apiVersion.version(); got != tc.want {
+				t.Errorf("expected negotiated version %d, got %d", tc.want, got)
+			}
+			if got := cf.NewProjectClient("pk-lf", "sk-lf").(*projectClientImpl).apiVersion.version(); got != tc.want {
+				t.Errorf("expected clients of the factory to share version %d, got %d", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestAPIVersionStandaloneClient(t *testing.T) {
+	client := NewOrganizationClient("http://localhost", "pk-org", "sk-org").(*organizationClientImpl)
+	if client.apiVersion.supports(APIVersionNext) || !client.apiVersion.supports(APIVersionCurrent) {
+		t.Errorf("expected a standalone client to use the current API version")
+	}
+}
+
+func TestAPIVersionGatesProjectApiKeyLookup(t *testing.T) {
+	testCases := []struct {
+		name     string
+		answered string
+		listings int
+	}{
+		{name: "instance without negotiation", listings: 1},
+		{name: "next version", answered: strconv.Itoa(APIVersionNext), listings: 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var listings int
+			mux := http.NewServeMux()
+			mux.HandleFunc("GET /api/public/projects/proj-1/apiKeys", func(w http.ResponseWriter, r *http.Request) {
+				listings++
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"apiKeys":[]}`))
+			})
+			mux.HandleFunc("GET /api/public/projects/proj-1/apiKeys/{apiKeyID}", func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+			})
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tc.answered != "" {
+					w.Header().Set(APIVersionHeader, tc.answered)
+				}
+				mux.ServeHTTP(w, r)
+			}))
+			t.Cleanup(server.Close)
+
+			client := NewClientFactory(server.URL, "admin-key").NewOrganizationClient("pk-org", "sk-org")
+			if _, err := client.GetProjectApiKey(context.Background(), "proj-1", "key-1"); !errors.Is(err, ErrNotFound) {
+				t.Fatalf("expected ErrNotFound, got %v", err)
+			}
+			if listings != tc.listings {
+				t.Errorf("expected %d key listings, got %d", tc.listings, listings)
+			}
+		})
+	}
+}
+
+func TestAPIVersionGatesSingleProjectEndpoint(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(APIVersionHeader, strconv.Itoa(APIVersionNext))
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/public/projects/proj-1":
+			_, _ = w.Write([]byte(`{"id":"proj-1","name":"Project","retentionDays":30}`))
+		case "/api/public/health":
+			_, _ = w.Write([]byte(`{"status":"OK","version":"3.100.0"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	cf := NewClientFactory(server.URL, "admin-key")
+	// The first response negotiates the version.
+	if _, err := cf.InstanceVersion(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	project, err := cf.NewOrganizationClient("pk-org", "sk-org").GetProject(context.Background(), "proj-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if project.RetentionDays == nil || *project.RetentionDays != 30 {
+		t.Errorf("expected the retention reported by the single-project endpoint, got %+v", project)
+	}
+}
@@ -0,0 +1,92 @@
+package langfuse
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// AuditEntry is a single line of the mutation audit log. Terraform does not share resource
+// addresses with providers, so the API path (which carries the object IDs) identifies the target.
+type AuditEntry struct {
+	Timestamp  string `json:"timestamp"`
+	Method     string `json:"method"`
+	Path       string `json:"path"`
+	Outcome    string `json:"outcome"`
+	StatusCode int    `json:"status_code,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// auditTransport appends an AuditEntry to a JSON lines file for every mutating request. The file
+// is opened before the request is sent so that a request is never made without being recorded.
+// The lock only serializes the writes, so that mutations are not sent one at a time.
+type auditTransport struct {
+	next    http.RoundTripper
+	path    string
+	runtime Runtime
+	mu      sync.Mutex
+
+	// failures lists the mutations that were sent but could not be recorded, until reported.
+	failures []string
+}
+
+func (t *auditTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method == http.MethodGet || req.Method == http.MethodHead {
+		return t.next.RoundTrip(req)
+	}
+
+	file, err := os.OpenFile(t.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, fmt.Errorf("failed to open audit log, refusing to send %s %s: %w", req.Method, req.URL.Path, err)
+	}
+	defer file.Close()
+
+	entry := AuditEntry{
+		Timestamp: t.runtime.withDefaults().Now().UTC().Format(time.RFC3339Nano),
+		Method:    req.Method,
+		Path:      req.URL.Path,
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	switch {
+	case err != nil:
+		entry.Outcome = "error"
+		entry.Error = err.Error()
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		entry.Outcome = "success"
+		entry.StatusCode = resp.StatusCode
+	default:
+		entry.Outcome = "failure"
+		entry.StatusCode = resp.StatusCode
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	line, writeErr := json.Marshal(entry)
+	if writeErr == nil {
+		_, writeErr = file.Write(append(line, '\n'))
+	}
+	if writeErr != nil {
+		// The mutation has been sent and its response must reach the resource, or Terraform would
+		// lose track of what it created; the logging failure is reported as a warning instead.
+		t.failures = append(t.failures, fmt.Sprintf("%s %s: %v", req.Method, req.URL.Path, writeErr))
+	}
+
+	return resp, err
+}
+
+// takeFailures returns the mutations that could not be recorded since the last call.
+func (t *auditTransport) takeFailures() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	failures := t.failures
+	t.failures = nil
+	return failures
+}
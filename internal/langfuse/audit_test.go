@@ -0,0 +1,116 @@
+package langfuse
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestAuditTransportRecordsMutations(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	auditLog := filepath.Join(t.TempDir(), "audit.jsonl")
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	runtime := Runtime{Now: func() time.Time { return now }}
+	cf := NewClientFactory(server.URL, "admin", WithAuditLog(auditLog), WithRuntime(runtime)).(*clientFactoryImpl)
+	for _, method := range []string{http.MethodGet, http.MethodPost} {
+		req, _ := http.NewRequest(method, server.URL+"/api/public/projects", nil)
+		resp, err := cf.httpClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+
+	file, err := os.Open(auditLog)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	var entries []AuditEntry
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var entry AuditEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			t.Fatal(err)
+		}
+		entries = append(entries, entry)
+	}
+	if len(entries) != 1 || entries[0].Method != http.MethodPost || entries[0].Outcome != "success" || entries[0].StatusCode != http.StatusCreated {
+		t.Errorf("expected the POST to be recorded, got %+v", entries)
+	}
+	if len(entries) == 1 && entries[0].Timestamp != "2026-03-01T12:00:00Z" {
+		t.Errorf("expected the timestamp of the runtime clock, got %s", entries[0].Timestamp)
+	}
+	if failures := cf.AuditLogFailures(); len(failures) != 0 {
+		t.Errorf("expected no failures, got %v", failures)
+	}
+}
+
+func TestAuditTransportSendsConcurrently(t *testing.T) {
+	// Both requests must be in flight at the same time for the handler to answer them.
+	var arrived sync.WaitGroup
+	arrived.Add(2)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		arrived.Done()
+		arrived.Wait()
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	cf := NewClientFactory(server.URL, "admin", WithAuditLog(filepath.Join(t.TempDir(), "audit.jsonl"))).(*clientFactoryImpl)
+	cf.httpClient.Timeout = 5 * time.Second
+
+	var done sync.WaitGroup
+	for range 2 {
+		done.Add(1)
+		go func() {
+			defer done.Done()
+			resp, err := cf.httpClient.Post(server.URL, "application/json", nil)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			resp.Body.Close()
+		}()
+	}
+	done.Wait()
+}
+
+func TestAuditTransportKeepsResponseWhenLoggingFails(t *testing.T) {
+	// Writes to /dev/full fail with ENOSPC after the file was opened.
+	if _, err := os.Stat("/dev/full"); err != nil {
+		t.Skip("/dev/full is not available")
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	cf := NewClientFactory(server.URL, "admin", WithAuditLog("/dev/full")).(*clientFactoryImpl)
+	resp, err := cf.httpClient.Post(server.URL+"/api/public/projects", "application/json", nil)
+	if err != nil {
+		t.Fatalf("expected the response despite the logging failure, got %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusCreated {
+		t.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	failures := cf.AuditLogFailures()
+	if len(failures) != 1 {
+		t.Fatalf("expected one failure, got %v", failures)
+	}
+	if failures := cf.AuditLogFailures(); len(failures) != 0 {
+		t.Errorf("expected failures to be reported once, got %v", failures)
+	}
+}
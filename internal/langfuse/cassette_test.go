@@ -0,0 +1,187 @@
+package langfuse
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// Contract tests replay HTTP interactions stored in testdata/cassettes so client behavior is
+// checked against API responses without a Langfuse instance. Set LANGFUSE_RECORD=1 together
+// with LANGFUSE_HOST, LANGFUSE_ADMIN_KEY, LANGFUSE_ORG_PUBLIC_KEY and LANGFUSE_ORG_SECRET_KEY to
+// run the tests against a real instance and overwrite the cassettes with what it returned.
+var recordCassettes = os.Getenv("LANGFUSE_RECORD") != ""
+
+// replayHost is the host the clients are pointed at when replaying; no request leaves the process.
+const replayHost = "https://langfuse.test"
+
+// cassetteHeaders are the response headers kept in cassettes; everything else is dropped.
+var cassetteHeaders = append([]string{"Content-Type"}, requestIDHeaders...)
+
+// secretFields matches JSON string fields whose values must never be written to a cassette.
+var secretFields = regexp.MustCompile(`"(secretKey|password)"\s*:\s*"[^"]*"`)
+
+type cassetteInteraction struct {
+	Method       string            `json:"method"`
+	Path         string            `json:"path"`
+	RequestBody  string            `json:"request_body,omitempty"`
+	StatusCode   int               `json:"status_code"`
+	Headers      map[string]string `json:"headers,omitempty"`
+	ResponseBody string            `json:"response_body"`
+}
+
+type cassetteTransport struct {
+	path         string
+	interactions []cassetteInteraction
+	position     int
+}
+
+// newCassette returns the host and HTTP client a contract test should use. When replaying, the
+// client answers from the cassette and the test fails if a request does not match the next
+// recorded interaction or if recorded interactions are left over.
+func newCassette(t *testing.T, name string) (string, *http.Client) {
+	t.Helper()
+
+	transport := &cassetteTransport{path: filepath.Join("testdata", "cassettes", name+".json")}
+
+	if recordCassettes {
+		t.Cleanup(func() {
+			if err := transport.save(); err != nil {
+				t.Errorf("failed to save cassette: %v", err)
+			}
+		})
+		return os.Getenv("LANGFUSE_HOST"), &http.Client{Transport: transport}
+	}
+
+	data, err := os.ReadFile(transport.path)
+	if err != nil {
+		t.Fatalf("failed to read cassette: %v", err)
+	}
+	if err := json.Unmarshal(data, &transport.interactions); err != nil {
+		t.Fatalf("failed to parse cassette %s: %v", transport.path, err)
+	}
+	t.Cleanup(func() {
+		if remaining := len(transport.interactions) - transport.position; remaining > 0 {
+			t.Errorf("cassette %s has %d unused interactions, starting with %s %s", transport.path, remaining,
+				transport.interactions[transport.position].Method, transport.interactions[transport.position].Path)
+		}
+	})
+	return replayHost, &http.Client{Transport: transport}
+}
+
+func (c *cassetteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var requestBody string
+	if req.Body != nil {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		requestBody = string(body)
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	if recordCassettes {
+		return c.record(req, requestBody)
+	}
+	return c.replay(req, requestBody)
+}
+
+func (c *cassetteTransport) record(req *http.Request, requestBody string) (*http.Response, error) {
+	resp, err := http.DefaultTransport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	interaction := cassetteInteraction{
+		Method:       req.Method,
+		Path:         req.URL.Path,
+		RequestBody:  secretFields.ReplaceAllString(requestBody, `"$1":"redacted"`),
+		StatusCode:   resp.StatusCode,
+		Headers:      map[string]string{},
+		ResponseBody: secretFields.ReplaceAllString(string(body), `"$1":"redacted"`),
+	}
+	for _, header := range cassetteHeaders {
+		if value := resp.Header.Get(header); value != "" {
+			interaction.Headers[header] = value
+		}
+	}
+	c.interactions = append(c.interactions, interaction)
+
+	// The caller still gets the unredacted response so that recorded flows keep working.
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
+
+func (c *cassetteTransport) replay(req *http.Request, requestBody string) (*http.Response, error) {
+	if c.position >= len(c.interactions) {
+		return nil, fmt.Errorf("cassette %s: unexpected request %s %s, no interactions left", c.path, req.Method, req.URL.Path)
+	}
+
+	interaction := c.interactions[c.position]
+	c.position++
+
+	if interaction.Method != req.Method || interaction.Path != req.URL.Path {
+		return nil, fmt.Errorf("cassette %s: expected %s %s, got %s %s", c.path, interaction.Method, interaction.Path, req.Method, req.URL.Path)
+	}
+	if interaction.RequestBody != "" && !jsonEqual(interaction.RequestBody, requestBody) {
+		return nil, fmt.Errorf("cassette %s: request body of %s %s does not match.\nrecorded: %s\nsent:     %s", c.path, req.Method, req.URL.Path, interaction.RequestBody, requestBody)
+	}
+
+	header := http.Header{}
+	for name, value := range interaction.Headers {
+		header.Set(name, value)
+	}
+	return &http.Response{
+		StatusCode: interaction.StatusCode,
+		Status:     fmt.Sprintf("%d %s", interaction.StatusCode, http.StatusText(interaction.StatusCode)),
+		Header:     header,
+		Body:       io.NopCloser(strings.NewReader(interaction.ResponseBody)),
+		Request:    req,
+	}, nil
+}
+
+func (c *cassetteTransport) save() error {
+	data, err := json.MarshalIndent(c.interactions, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(c.path, append(data, '\n'), 0o644)
+}
+
+// jsonEqual compares two bodies as JSON documents, treating redacted secrets as wildcards.
+func jsonEqual(recorded, sent string) bool {
+	var recordedValue, sentValue any
+	if json.Unmarshal([]byte(recorded), &recordedValue) != nil {
+		return recorded == sent
+	}
+	if json.Unmarshal([]byte(secretFields.ReplaceAllString(sent, `"$1":"redacted"`)), &sentValue) != nil {
+		return false
+	}
+	recordedJSON, _ := json.Marshal(recordedValue)
+	sentJSON, _ := json.Marshal(sentValue)
+	return bytes.Equal(recordedJSON, sentJSON)
+}
+
+func cassetteEnv(name, replayValue string) string {
+	if recordCassettes {
+		return os.Getenv(name)
+	}
+	return replayValue
+}
@@ -1,26 +1,488 @@
 package langfuse
 
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+)
+
 type clientFactoryImpl struct {
 	host        string
 	adminApiKey string
+	credentials map[string]OrganizationCredentials
+	defaults    *OrganizationCredentials
+	projectKeys *ProjectCredentials
+	readOnly    bool
+	auditLog    string
+	warnUnknown bool
+	fastRefresh bool
+	sensitive   bool
+	retention   bool
+	maxRespSize int64
+	readRetry   RetryPolicy
+	writeRetry  RetryPolicy
+	httpClient  *http.Client
+	runtime     Runtime
+	headers     map[string]string
+	managedBy   map[string]string
+	stateKey    []byte
+
+	keyCreationConcurrency int
+	keyCreation            *keyCreationLimiter
+	projectApiKeys         ProjectApiKeyRegistry
+	issuedSecrets          IssuedSecrets
+	rateLimit              rateLimitTracker
+	audit                  *auditTransport
+	apiVersion             apiVersionTracker
+
+	versionMu    sync.Mutex
+	version      string
+	versionKnown bool
+
+	// Clients are created once per credential pair, so that every resource using the same key pair
+	// shares one client instead of creating its own for every call.
+	clientsMu           sync.Mutex
+	adminClient         AdminClient
+	organizationClients map[clientCredentials]OrganizationClient
+	projectClients      map[clientCredentials]ProjectClient
+
+	// opts are kept to build the factories of other instances, which are created once per host.
+	opts    []ClientFactoryOption
+	hostsMu sync.Mutex
+	hosts   map[string]ClientFactory
+}
+
+// clientCredentials is the key pair a cached client authenticates with.
+type clientCredentials struct {
+	publicKey string
+	secretKey string
+}
+
+// OrganizationCredentials is an organization API key pair declared once at
+// provider level and referenced by name from resources.
+type OrganizationCredentials struct {
+	PublicKey  string
+	PrivateKey string
+}
+
+// ProjectCredentials is a project API key pair declared at provider level, used by resources
+// that authenticate with a project key and do not set one.
+type ProjectCredentials struct {
+	PublicKey string
+	SecretKey string
 }
 
 type ClientFactory interface {
+	Host() string
+	// HasAdminAPIKey reports whether an admin API key is configured; only admin API clients need one.
+	HasAdminAPIKey() bool
+	// NewAdminClient, NewOrganizationClient and NewProjectClient return the same client for the same
+	// key pair, so its connections are reused across resources.
 	NewAdminClient() AdminClient
 	NewOrganizationClient(publicKey, privateKey string) OrganizationClient
+	NewProjectClient(publicKey, secretKey string) ProjectClient
+	OrganizationCredentials(name string) (OrganizationCredentials, bool)
+	DefaultOrganizationCredentials() (OrganizationCredentials, bool)
+	DefaultProjectCredentials() (ProjectCredentials, bool)
+	InstanceVersion(ctx context.Context) (string, error)
+	// Runtime returns the clock and ID generator resources should use instead of the system ones.
+	Runtime() Runtime
+	// FastRefresh reports whether refreshes may skip list-based lookups of resources with complete state.
+	FastRefresh() bool
+	// HasRequestHeaders reports whether requests carry configured headers, which may reference the
+	// request attributes set with WithRequestAttributes.
+	HasRequestHeaders() bool
+	// SensitiveStateSummary reports whether plans should list the sensitive attributes they write to state.
+	SensitiveStateSummary() bool
+	// RetentionWarning reports whether plans should warn about production projects that keep data indefinitely.
+	RetentionWarning() bool
+	// ManagedByMetadata returns the metadata stamped on organizations and projects the provider creates
+	// or updates, or nil when stamping is off.
+	ManagedByMetadata() map[string]string
+	// StateEncryptionKey returns the AES-256 key resources encrypt secrets with before writing them to
+	// state, or nil when state encryption is off.
+	StateEncryptionKey() []byte
+	// RateLimitWarning returns the peak rate limit usage once, after it crossed RateLimitWarningThreshold.
+	RateLimitWarning() (RateLimitUsage, bool)
+	// ProjectApiKeys returns the registry resources check the project API keys they create against.
+	ProjectApiKeys() *ProjectApiKeyRegistry
+	// IssuedSecrets returns the secrets of created objects that resources hand out once instead of
+	// writing them to state.
+	IssuedSecrets() *IssuedSecrets
+	// AuditLogFailures returns the mutations sent since the last call that could not be recorded in
+	// the audit log.
+	AuditLogFailures() []string
+	// ForHost returns a factory with the same settings whose clients talk to another Langfuse instance.
+	// The admin API key and the default organization credentials belong to the configured instance
+	// and are not carried over.
+	ForHost(host string) ClientFactory
+}
+
+type ClientFactoryOption func(*clientFactoryImpl)
+
+// WithOrganizationCredentials registers named organization credentials on the factory.
+func WithOrganizationCredentials(credentials map[string]OrganizationCredentials) ClientFactoryOption {
+	return func(cf *clientFactoryImpl) {
+		cf.credentials = credentials
+	}
+}
+
+// WithDefaultOrganizationCredentials sets the organization credentials used by resources
+// that neither set a key pair nor reference named credentials.
+func WithDefaultOrganizationCredentials(credentials OrganizationCredentials) ClientFactoryOption {
+	return func(cf *clientFactoryImpl) {
+		cf.defaults = &credentials
+	}
+}
+
+// WithDefaultProjectCredentials sets the project credentials used by resources that authenticate
+// with a project key pair and do not set one.
+func WithDefaultProjectCredentials(credentials ProjectCredentials) ClientFactoryOption {
+	return func(cf *clientFactoryImpl) {
+		cf.projectKeys = &credentials
+	}
+}
+
+// WithReadOnly makes every client created by the factory refuse requests that could mutate data.
+func WithReadOnly(readOnly bool) ClientFactoryOption {
+	return func(cf *clientFactoryImpl) {
+		cf.readOnly = readOnly
+	}
+}
+
+// WithRuntime replaces the clock and ID generator handed to resources; unset fields keep their
+// defaults. Tests use it to make time-based behavior deterministic.
+func WithRuntime(runtime Runtime) ClientFactoryOption {
+	return func(cf *clientFactoryImpl) {
+		cf.runtime = runtime
+	}
+}
+
+// WithFastRefresh lets resources that are looked up through list endpoints keep their state on
+// refresh instead of calling Langfuse.
+func WithFastRefresh(enabled bool) ClientFactoryOption {
+	return func(cf *clientFactoryImpl) {
+		cf.fastRefresh = enabled
+	}
+}
+
+// WithSensitiveStateSummary makes resources report, at plan time, which sensitive attributes the
+// apply will write to state.
+func WithSensitiveStateSummary(enabled bool) ClientFactoryOption {
+	return func(cf *clientFactoryImpl) {
+		cf.sensitive = enabled
+	}
+}
+
+// WithRetentionWarning makes project resources warn, at plan time, when a project marked as
+// production in its metadata has no retention period.
+func WithRetentionWarning(enabled bool) ClientFactoryOption {
+	return func(cf *clientFactoryImpl) {
+		cf.retention = enabled
+	}
+}
+
+// WithManagedByMetadata stamps metadata on the organizations and projects resources create or
+// update, so they are recognisable as managed by Terraform. A nil map turns stamping off.
+func WithManagedByMetadata(metadata map[string]string) ClientFactoryOption {
+	return func(cf *clientFactoryImpl) {
+		cf.managedBy = metadata
+	}
+}
+
+// WithStateEncryptionKey sets the AES-256 key resources encrypt secrets with before writing them to
+// state. A nil key turns state encryption off.
+func WithStateEncryptionKey(key []byte) ClientFactoryOption {
+	return func(cf *clientFactoryImpl) {
+		cf.stateKey = key
+	}
+}
+
+// WithRequestHeaders adds headers to every request made by clients created by the factory, e.g. for
+// gateways that route by tenant. Values may reference request attributes as ${organization_id} or
+// ${project_id}; see WithRequestAttributes.
+func WithRequestHeaders(headers map[string]string) ClientFactoryOption {
+	return func(cf *clientFactoryImpl) {
+		cf.headers = headers
+	}
+}
+
+// WithAuditLog appends a JSON line to the file at path for every mutating request made by
+// clients created by the factory. An empty path disables the audit log.
+func WithAuditLog(path string) ClientFactoryOption {
+	return func(cf *clientFactoryImpl) {
+		cf.auditLog = path
+	}
 }
 
-func NewClientFactory(host, adminApiKey string) ClientFactory {
-	return &clientFactoryImpl{
-		host:        host,
-		adminApiKey: adminApiKey,
+// WithRetryPolicies sets how transiently failed requests are retried, separately for reads
+// (GET, HEAD) and for writes.
+func WithRetryPolicies(read, write RetryPolicy) ClientFactoryOption {
+	return func(cf *clientFactoryImpl) {
+		cf.readRetry = read
+		cf.writeRetry = write
+	}
+}
+
+// WithUnknownFieldWarnings makes clients created by the factory log a warning whenever a response
+// contains fields the client types do not model.
+func WithUnknownFieldWarnings(enabled bool) ClientFactoryOption {
+	return func(cf *clientFactoryImpl) {
+		cf.warnUnknown = enabled
+	}
+}
+
+// WithMaxResponseSize limits the decompressed size, in bytes, of the response bodies read by clients
+// created by the factory. Zero or less removes the limit.
+func WithMaxResponseSize(size int64) ClientFactoryOption {
+	return func(cf *clientFactoryImpl) {
+		cf.maxRespSize = size
+	}
+}
+
+// WithKeyCreationConcurrency bounds the number of project API key creation calls in flight per
+// project across all clients created by the factory. Zero or less removes the bound.
+func WithKeyCreationConcurrency(concurrency int) ClientFactoryOption {
+	return func(cf *clientFactoryImpl) {
+		cf.keyCreationConcurrency = concurrency
+	}
+}
+
+func NewClientFactory(host, adminApiKey string, opts ...ClientFactoryOption) ClientFactory {
+	cf := &clientFactoryImpl{
+		host:                   host,
+		adminApiKey:            adminApiKey,
+		keyCreationConcurrency: DefaultKeyCreationConcurrency,
+		readRetry:              DefaultReadRetryPolicy,
+		writeRetry:             DefaultWriteRetryPolicy,
+		maxRespSize:            DefaultMaxResponseSize,
+		opts:                   opts,
+	}
+	for _, opt := range opts {
+		opt(cf)
+	}
+	cf.runtime = cf.runtime.withDefaults()
+	cf.keyCreation = newKeyCreationLimiter(cf.keyCreationConcurrency)
+
+	// Retries sit closest to the network so that the audit log records each request once; the rate
+	// limit headers of every attempt are recorded below them. Responses are decompressed before the
+	// size limit applies, so that the limit bounds the decompressed body.
+	var transport http.RoundTripper = &responseSizeTransport{next: &gzipTransport{next: http.DefaultTransport}, limit: cf.maxRespSize}
+	transport = &retryTransport{
+		next:    &apiVersionTransport{next: &rateLimitTransport{next: transport, tracker: &cf.rateLimit}, tracker: &cf.apiVersion},
+		read:    cf.readRetry,
+		write:   cf.writeRetry,
+		runtime: cf.runtime,
+	}
+	if cf.warnUnknown {
+		transport = &unknownFieldsTransport{next: transport}
 	}
+	if cf.readOnly {
+		transport = &readOnlyTransport{next: transport}
+	}
+	// The audit log wraps the read-only guard so that refused mutations are recorded as well.
+	if cf.auditLog != "" {
+		cf.audit = &auditTransport{next: transport, path: cf.auditLog, runtime: cf.runtime}
+		transport = cf.audit
+	}
+	// Headers are added before retries so that every attempt carries them.
+	if len(cf.headers) > 0 {
+		transport = &headerTransport{next: transport, headers: cf.headers}
+	}
+	cf.httpClient = &http.Client{Transport: transport}
+
+	return cf
+}
+
+// Host returns the base URL of the Langfuse instance the clients talk to.
+func (cf *clientFactoryImpl) Host() string {
+	return cf.host
+}
+
+func (cf *clientFactoryImpl) Runtime() Runtime {
+	return cf.runtime
+}
+
+func (cf *clientFactoryImpl) FastRefresh() bool {
+	return cf.fastRefresh
+}
+
+func (cf *clientFactoryImpl) HasRequestHeaders() bool {
+	return len(cf.headers) > 0
+}
+
+func (cf *clientFactoryImpl) SensitiveStateSummary() bool {
+	return cf.sensitive
+}
+
+func (cf *clientFactoryImpl) RetentionWarning() bool {
+	return cf.retention
 }
 
+func (cf *clientFactoryImpl) ManagedByMetadata() map[string]string {
+	return cf.managedBy
+}
+
+func (cf *clientFactoryImpl) StateEncryptionKey() []byte {
+	return cf.stateKey
+}
+
+func (cf *clientFactoryImpl) HasAdminAPIKey() bool {
+	return cf.adminApiKey != ""
+}
+
+// NewAdminClient returns the admin client of the factory, created on first use.
 func (cf *clientFactoryImpl) NewAdminClient() AdminClient {
-	return NewAdminClient(cf.host, cf.adminApiKey)
+	cf.clientsMu.Lock()
+	defer cf.clientsMu.Unlock()
+
+	if cf.adminClient == nil {
+		cf.adminClient = &adminClientImpl{
+			host:       cf.host,
+			apiKey:     cf.adminApiKey,
+			httpClient: cf.httpClient,
+			apiVersion: &cf.apiVersion,
+		}
+	}
+	return cf.adminClient
 }
 
+// NewOrganizationClient returns the client of an organization key pair, created on first use of
+// the pair. It is safe for concurrent use.
 func (cf *clientFactoryImpl) NewOrganizationClient(publicKey, privateKey string) OrganizationClient {
-	return NewOrganizationClient(cf.host, publicKey, privateKey)
+	cf.clientsMu.Lock()
+	defer cf.clientsMu.Unlock()
+
+	credentials := clientCredentials{publicKey: publicKey, secretKey: privateKey}
+	if client, ok := cf.organizationClients[credentials]; ok {
+		return client
+	}
+	if cf.organizationClients == nil {
+		cf.organizationClients = make(map[clientCredentials]OrganizationClient)
+	}
+	client := &organizationClientImpl{
+		host:        cf.host,
+		publicKey:   publicKey,
+		privateKey:  privateKey,
+		httpClient:  cf.httpClient,
+		keyCreation: cf.keyCreation,
+		apiVersion:  &cf.apiVersion,
+	}
+	cf.organizationClients[credentials] = client
+	return client
+}
+
+// NewProjectClient returns the client of a project key pair, created on first use of the pair. It
+// is safe for concurrent use.
+func (cf *clientFactoryImpl) NewProjectClient(publicKey, secretKey string) ProjectClient {
+	cf.clientsMu.Lock()
+	defer cf.clientsMu.Unlock()
+
+	credentials := clientCredentials{publicKey: publicKey, secretKey: secretKey}
+	if client, ok := cf.projectClients[credentials]; ok {
+		return client
+	}
+	if cf.projectClients == nil {
+		cf.projectClients = make(map[clientCredentials]ProjectClient)
+	}
+	client := &projectClientImpl{
+		host:       cf.host,
+		publicKey:  publicKey,
+		secretKey:  secretKey,
+		httpClient: cf.httpClient,
+		apiVersion: &cf.apiVersion,
+	}
+	cf.projectClients[credentials] = client
+	return client
+}
+
+func (cf *clientFactoryImpl) OrganizationCredentials(name string) (OrganizationCredentials, bool) {
+	credentials, ok := cf.credentials[name]
+	return credentials, ok
+}
+
+func (cf *clientFactoryImpl) DefaultOrganizationCredentials() (OrganizationCredentials, bool) {
+	if cf.defaults == nil {
+		return OrganizationCredentials{}, false
+	}
+	return *cf.defaults, true
+}
+
+func (cf *clientFactoryImpl) DefaultProjectCredentials() (ProjectCredentials, bool) {
+	if cf.projectKeys == nil {
+		return ProjectCredentials{}, false
+	}
+	return *cf.projectKeys, true
+}
+
+// InstanceVersion returns the version reported by the instance's health endpoint. A successful
+// lookup is cached for the provider configuration; a failure, e.g. a cancelled context, is not,
+// so the next caller asks again.
+func (cf *clientFactoryImpl) InstanceVersion(ctx context.Context) (string, error) {
+	cf.versionMu.Lock()
+	defer cf.versionMu.Unlock()
+	if cf.versionKnown {
+		return cf.version, nil
+	}
+
+	health, err := GetHealth(ctx, cf.httpClient, cf.host)
+	if err != nil {
+		return "", err
+	}
+	cf.version = health.Version
+	cf.versionKnown = true
+	return cf.version, nil
+}
+
+func (cf *clientFactoryImpl) ProjectApiKeys() *ProjectApiKeyRegistry {
+	return &cf.projectApiKeys
+}
+
+func (cf *clientFactoryImpl) IssuedSecrets() *IssuedSecrets {
+	return &cf.issuedSecrets
+}
+
+func (cf *clientFactoryImpl) RateLimitWarning() (RateLimitUsage, bool) {
+	return cf.rateLimit.warning()
+}
+
+// AuditLogFailures includes the failures of the factories of other instances, which resources
+// report through the factory of the configured instance.
+func (cf *clientFactoryImpl) AuditLogFailures() []string {
+	var failures []string
+	if cf.audit != nil {
+		failures = cf.audit.takeFailures()
+	}
+
+	cf.hostsMu.Lock()
+	defer cf.hostsMu.Unlock()
+	for _, factory := range cf.hosts {
+		failures = append(failures, factory.AuditLogFailures()...)
+	}
+	return failures
+}
+
+// ForHost returns the factory of another instance, created on first use. Each instance negotiates
+// its own API version and keeps its own rate limit and version caches; the configured instance's
+// factory is returned when host is its own.
+func (cf *clientFactoryImpl) ForHost(host string) ClientFactory {
+	host = strings.TrimRight(host, "/")
+	if host == "" || host == strings.TrimRight(cf.host, "/") {
+		return cf
+	}
+
+	cf.hostsMu.Lock()
+	defer cf.hostsMu.Unlock()
+	if factory, ok := cf.hosts[host]; ok {
+		return factory
+	}
+	if cf.hosts == nil {
+		cf.hosts = make(map[string]ClientFactory)
+	}
+	factory := NewClientFactory(host, "", cf.opts...).(*clientFactoryImpl)
+	factory.defaults = nil
+	factory.projectKeys = nil
+	cf.hosts[host] = factory
+	return factory
 }
@@ -0,0 +1,124 @@
+package langfuse
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestClientFactoryForHost(t *testing.T) {
+	t.Parallel()
+
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("X-Tenant") != "acme" {
+			t.Errorf("expected the headers of the factory, got %q", r.Header.Get("X-Tenant"))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"projects":[]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	cf := NewClientFactory("http://localhost:3000", "admin-key",
+		WithRequestHeaders(map[string]string{"X-Tenant": "acme"}),
+		WithOrganizationCredentials(map[string]OrganizationCredentials{"legacy": {PublicKey: "pk-org", PrivateKey: "sk-org"}}),
+		WithDefaultOrganizationCredentials(OrganizationCredentials{PublicKey: "pk-default", PrivateKey: "sk-default"}),
+	)
+
+	if cf.ForHost("") != cf || cf.ForHost("http://localhost:3000/") != cf {
+		t.Errorf("expected the factory itself for its own host")
+	}
+
+	other := cf.ForHost(server.URL + "/")
+	if other.Host() != server.URL {
+		t.Errorf("unexpected host %q, want %q", other.Host(), server.URL)
+	}
+	if cf.ForHost(server.URL) != other {
+		t.Errorf("expected the factory of a host to be reused")
+	}
+	if other.HasAdminAPIKey() {
+		t.Errorf("expected the admin API key not to be carried over")
+	}
+	if _, ok := other.DefaultOrganizationCredentials(); ok {
+		t.Errorf("expected the default organization credentials not to be carried over")
+	}
+	if _, ok := other.OrganizationCredentials("legacy"); !ok {
+		t.Errorf("expected named organization credentials to be carried over")
+	}
+
+	if _, err := other.NewOrganizationClient("pk-org", "sk-org").ListProjects(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("expected 1 request to the other host, got %d", requests)
+	}
+}
+
+func TestClientFactoryReusesClients(t *testing.T) {
+	t.Parallel()
+
+	cf := NewClientFactory("http://localhost:3000", "admin-key")
+
+	var wg sync.WaitGroup
+	clients := make([]OrganizationClient, 20)
+	for i := range clients {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			clients[i] = cf.NewOrganizationClient("pk-org", "sk-org")
+		}()
+	}
+	wg.Wait()
+	for _, client := range clients {
+		if client != clients[0] {
+			t.Fatalf("expected concurrent calls with the same key pair to share one client")
+		}
+	}
+
+	if cf.NewOrganizationClient("pk-org", "sk-other") == clients[0] {
+		t.Errorf("expected another secret key to get its own client")
+	}
+	if cf.NewProjectClient("pk-lf", "sk-lf") != cf.NewProjectClient("pk-lf", "sk-lf") {
+		t.Errorf("expected project clients to be reused")
+	}
+	if cf.NewAdminClient() != cf.NewAdminClient() {
+		t.Errorf("expected the admin client to be reused")
+	}
+	if cf.ForHost("http://localhost:4000").NewOrganizationClient("pk-org", "sk-org") == clients[0] {
+		t.Errorf("expected clients of another host not to be shared")
+	}
+}
+
+func TestClientFactoryInstanceVersionRetriesFailures(t *testing.T) {
+	t.Parallel()
+
+	var lookups atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lookups.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status":"OK","version":"3.112.0"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	cf := NewClientFactory(server.URL, "admin-key")
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := cf.InstanceVersion(cancelled); err == nil {
+		t.Fatal("expected the lookup with a cancelled context to fail")
+	}
+
+	for range 2 {
+		version, err := cf.InstanceVersion(context.Background())
+		if err != nil || version != "3.112.0" {
+			t.Fatalf("expected version 3.112.0 after a failed lookup, got %q, %v", version, err)
+		}
+	}
+	if got := lookups.Load(); got != 1 {
+		t.Errorf("expected the successful lookup to be cached, got %d requests", got)
+	}
+}
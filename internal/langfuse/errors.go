@@ -0,0 +1,199 @@
+package langfuse
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CredentialType describes which kind of credential authenticated a request.
+type CredentialType string
+
+const (
+	CredentialTypeAdminKey        CredentialType = "admin API key"
+	CredentialTypeOrganizationKey CredentialType = "organization API key pair"
+	CredentialTypeProjectKey      CredentialType = "project API key pair"
+)
+
+// requestIDHeaders lists the response headers that carry a correlation ID, in order of preference.
+var requestIDHeaders = []string{"X-Request-Id", "X-Trace-Id", "X-Amzn-Trace-Id", "X-Vercel-Id"}
+
+// requestID returns the correlation ID Langfuse (or the proxy in front of it) attached to the response.
+func requestID(resp *http.Response) string {
+	for _, header := range requestIDHeaders {
+		if value := resp.Header.Get(header); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+func requestIDSuffix(id string) string {
+	if id == "" {
+		return ""
+	}
+	return fmt.Sprintf(" (request ID: %s)", id)
+}
+
+// APIError is returned when Langfuse answers a request with a non-2xx status code.
+type APIError struct {
+	StatusCode int
+	Method     string
+	Path       string
+	Body       string
+	RequestID  string
+	// Attempts is the number of times the request was sent, and Elapsed the time spent on all of
+	// them; both are only set when the request was retried.
+	Attempts int
+	Elapsed  time.Duration
+}
+
+func newAPIError(resp *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Method:     resp.Request.Method,
+		Path:       resp.Request.URL.Path,
+		Body:       string(body),
+		RequestID:  requestID(resp),
+	}
+	if attempts, err := strconv.Atoi(resp.Header.Get(retryAttemptsHeader)); err == nil {
+		apiErr.Attempts = attempts
+		apiErr.Elapsed, _ = time.ParseDuration(resp.Header.Get(retryElapsedHeader))
+	}
+	return apiErr
+}
+
+func (e *APIError) Error() string {
+	if e.Attempts > 1 {
+		return fmt.Sprintf("gave up after %d attempts over %s: %d from %s %s, response body: %s%s",
+			e.Attempts, formatElapsed(e.Elapsed), e.StatusCode, e.Method, e.Path, e.Body, requestIDSuffix(e.RequestID))
+	}
+	return fmt.Sprintf("request failed with status code %d, response body: %s%s", e.StatusCode, e.Body, requestIDSuffix(e.RequestID))
+}
+
+// FieldError is a field-level validation error Langfuse reported for a rejected request body.
+type FieldError struct {
+	// Field is the path of the offending field of the request body, e.g. "retention" or "metadata.env".
+	Field   string
+	Message string
+}
+
+// validationErrorResponse is the body Langfuse answers requests that fail schema validation with,
+// e.g. {"message": "Invalid request data", "error": [{"path": ["retention"], "message": "..."}]}.
+// Older instances and proxies name the list "errors" or "issues".
+type validationErrorResponse struct {
+	Error  json.RawMessage `json:"error"`
+	Errors json.RawMessage `json:"errors"`
+	Issues json.RawMessage `json:"issues"`
+}
+
+type validationIssue struct {
+	Path    []any  `json:"path"`
+	Message string `json:"message"`
+}
+
+// FieldErrors returns the field-level validation errors of a 400 Bad Request or 422 Unprocessable
+// Entity response, or nil when the response carries none.
+func (e *APIError) FieldErrors() []FieldError {
+	if e.StatusCode != http.StatusBadRequest && e.StatusCode != http.StatusUnprocessableEntity {
+		return nil
+	}
+	var body validationErrorResponse
+	if err := json.Unmarshal([]byte(e.Body), &body); err != nil {
+		return nil
+	}
+
+	var fieldErrors []FieldError
+	for _, raw := range []json.RawMessage{body.Error, body.Errors, body.Issues} {
+		var issues []validationIssue
+		if len(raw) == 0 || json.Unmarshal(raw, &issues) != nil {
+			continue
+		}
+		for _, issue := range issues {
+			if len(issue.Path) == 0 {
+				continue
+			}
+			field := make([]string, len(issue.Path))
+			for i, element := range issue.Path {
+				field[i] = fmt.Sprint(element)
+			}
+			fieldErrors = append(fieldErrors, FieldError{Field: strings.Join(field, "."), Message: issue.Message})
+		}
+	}
+	return fieldErrors
+}
+
+// Transient reports whether the status code signals a temporary condition that retrying may
+// resolve, as opposed to a request Langfuse rejects permanently.
+func (e *APIError) Transient() bool {
+	return isRetryable(&http.Response{StatusCode: e.StatusCode}, nil)
+}
+
+// ErrNotFound is wrapped by errors reporting that an object looked up by ID does not exist, as
+// opposed to the lookup itself failing.
+var ErrNotFound = errors.New("not found")
+
+// ErrAuditLogUnavailable is returned when the instance does not serve the audit log API, e.g.
+// because audit logging is not part of its edition or is disabled.
+var ErrAuditLogUnavailable = errors.New("audit log API not available")
+
+// AuthError is returned when Langfuse rejects a request with 401 Unauthorized or 403 Forbidden.
+type AuthError struct {
+	StatusCode int
+	Method     string
+	Path       string
+	Credential CredentialType
+	RequestID  string
+}
+
+func newAuthError(resp *http.Response, credential CredentialType) *AuthError {
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	return &AuthError{
+		StatusCode: resp.StatusCode,
+		Method:     resp.Request.Method,
+		Path:       resp.Request.URL.Path,
+		Credential: credential,
+		RequestID:  requestID(resp),
+	}
+}
+
+// IsAuthenticationFailure reports whether the credential itself was rejected (401),
+// as opposed to being valid but not permitted to perform the request (403).
+func (e *AuthError) IsAuthenticationFailure() bool {
+	return e.StatusCode == http.StatusUnauthorized
+}
+
+func (e *AuthError) Error() string {
+	reason := "was not permitted to call"
+	if e.IsAuthenticationFailure() {
+		reason = "was rejected by"
+	}
+	return fmt.Sprintf("the %s %s %s %s (status code %d). %s%s", e.Credential, reason, e.Method, e.Path, e.StatusCode, e.hint(), requestIDSuffix(e.RequestID))
+}
+
+func (e *AuthError) hint() string {
+	switch {
+	case e.Credential == CredentialTypeAdminKey && e.IsAuthenticationFailure():
+		return "Check admin_api_key (or LANGFUSE_ADMIN_KEY) and host. Note that admin_api_key is only valid on self-hosted instances " +
+			"where ADMIN_API_KEY is configured; it cannot be used against Langfuse Cloud."
+	case e.Credential == CredentialTypeAdminKey:
+		return "The admin API requires an Enterprise license on the target instance (LANGFUSE_EE_LICENSE_KEY)."
+	case e.Credential == CredentialTypeProjectKey && e.IsAuthenticationFailure():
+		return "Check the project public and secret key and host. The key pair may have been deleted or belong to a different Langfuse instance."
+	case e.Credential == CredentialTypeProjectKey:
+		return "Project-scoped endpoints require a project API key of the project the object belongs to."
+	case e.IsAuthenticationFailure():
+		return "Check organization_public_key/organization_private_key (or the referenced credentials) and host. " +
+			"The key pair may have been deleted or rotated, or belong to a different Langfuse instance."
+	default:
+		return "Organization-scoped endpoints require an organization API key; project API keys are not accepted. " +
+			"The organization may also lack the plan or license required for this feature."
+	}
+}
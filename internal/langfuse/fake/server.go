@@ -0,0 +1,848 @@
+// Package fake implements an in-memory Langfuse REST API for tests and for running modules
+// against the provider without a real Langfuse instance.
+//
+// Only the endpoints used by the provider are implemented, and only with the behavior the
+// provider relies on. State lives for the lifetime of the Server.
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+)
+
+// AdminAPIKey is the admin API key accepted by every fake Server.
+const AdminAPIKey = "fake-admin-api-key"
+
+// Version is the Langfuse version reported by the fake health endpoint.
+const Version = "3.100.0"
+
+type project struct {
+	langfuse.Project
+	organizationID string
+}
+
+type projectApiKey struct {
+	langfuse.ProjectApiKey
+	projectID string
+}
+
+type score struct {
+	langfuse.Score
+	projectID string
+}
+
+// promptKey identifies a prompt, whose names are only unique within a project.
+type promptKey struct {
+	projectID string
+	name      string
+}
+
+type organizationApiKey struct {
+	langfuse.OrganizationApiKey
+	organizationID string
+}
+
+// Server is an httptest server backed by in-memory maps.
+type Server struct {
+	URL string
+
+	server *httptest.Server
+	mu     sync.Mutex
+	nextID int
+
+	organizations       map[string]*langfuse.Organization
+	organizationApiKeys map[string]*organizationApiKey
+	projects            map[string]*project
+	projectApiKeys      map[string]*projectApiKey
+	// users maps email addresses to user IDs across all organizations.
+	users map[string]string
+	// memberships maps organization IDs to memberships keyed by user ID.
+	memberships map[string]map[string]*langfuse.OrganizationMembership
+	// traces maps trace IDs to ingested traces. Ingestion is processed synchronously.
+	traces map[string]*langfuse.Trace
+	// scores maps score IDs to scores together with the project they belong to.
+	scores map[string]*score
+	// prompts maps prompts to their versions, in version order.
+	prompts map[promptKey][]*langfuse.Prompt
+}
+
+// NewServer starts a fake Langfuse server. Call Close when done.
+func NewServer() *Server {
+	s := &Server{
+		organizations:       make(map[string]*langfuse.Organization),
+		organizationApiKeys: make(map[string]*organizationApiKey),
+		projects:            make(map[string]*project),
+		projectApiKeys:      make(map[string]*projectApiKey),
+		users:               make(map[string]string),
+		memberships:         make(map[string]map[string]*langfuse.OrganizationMembership),
+		traces:              make(map[string]*langfuse.Trace),
+		scores:              make(map[string]*score),
+		prompts:             make(map[promptKey][]*langfuse.Prompt),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/public/health", s.health)
+
+	mux.HandleFunc("GET /api/admin/organizations", s.admin(s.listOrganizations))
+	mux.HandleFunc("POST /api/admin/organizations", s.admin(s.createOrganization))
+	mux.HandleFunc("GET /api/admin/organizations/{organizationID}", s.admin(s.getOrganization))
+	mux.HandleFunc("PUT /api/admin/organizations/{organizationID}", s.admin(s.updateOrganization))
+	mux.HandleFunc("DELETE /api/admin/organizations/{organizationID}", s.admin(s.deleteOrganization))
+	mux.HandleFunc("GET /api/admin/organizations/{organizationID}/apiKeys", s.admin(s.listOrganizationApiKeys))
+	mux.HandleFunc("POST /api/admin/organizations/{organizationID}/apiKeys", s.admin(s.createOrganizationApiKey))
+	mux.HandleFunc("DELETE /api/admin/organizations/{organizationID}/apiKeys/{apiKeyID}", s.admin(s.deleteOrganizationApiKey))
+
+	mux.HandleFunc("GET /api/public/organizations/projects", s.organization(s.listProjects))
+	mux.HandleFunc("POST /api/public/projects", s.organization(s.createProject))
+	mux.HandleFunc("PUT /api/public/projects/{projectID}", s.organization(s.updateProject))
+	mux.HandleFunc("DELETE /api/public/projects/{projectID}", s.organization(s.deleteProject))
+	mux.HandleFunc("GET /api/public/projects/{projectID}/apiKeys", s.organization(s.listProjectApiKeys))
+	mux.HandleFunc("POST /api/public/projects/{projectID}/apiKeys", s.organization(s.createProjectApiKey))
+	mux.HandleFunc("DELETE /api/public/projects/{projectID}/apiKeys/{apiKeyID}", s.organization(s.deleteProjectApiKey))
+	mux.HandleFunc("GET /api/public/organizations/memberships", s.organization(s.listMemberships))
+	mux.HandleFunc("PUT /api/public/organizations/memberships", s.organization(s.updateMembership))
+	mux.HandleFunc("DELETE /api/public/organizations/memberships", s.organization(s.removeMembership))
+	mux.HandleFunc("GET /api/public/scim/Users", s.organization(s.listSCIMUsers))
+	mux.HandleFunc("POST /api/public/scim/Users", s.organization(s.createSCIMUser))
+
+	mux.HandleFunc("POST /api/public/ingestion", s.project(s.ingest))
+	mux.HandleFunc("GET /api/public/traces/{traceID}", s.project(s.getTrace))
+	mux.HandleFunc("GET /api/public/metrics", s.project(s.queryMetrics))
+	mux.HandleFunc("POST /api/public/scores", s.project(s.createScore))
+	mux.HandleFunc("GET /api/public/scores/{scoreID}", s.project(s.getScore))
+	mux.HandleFunc("DELETE /api/public/scores/{scoreID}", s.project(s.deleteScore))
+	mux.HandleFunc("POST /api/public/v2/prompts", s.project(s.createPrompt))
+	mux.HandleFunc("GET /api/public/v2/prompts/{promptName}", s.project(s.getPrompt))
+	mux.HandleFunc("DELETE /api/public/v2/prompts/{promptName}", s.project(s.deletePrompt))
+	mux.HandleFunc("PATCH /api/public/v2/prompts/{promptName}/versions/{version}", s.project(s.updatePromptLabels))
+
+	s.server = httptest.NewServer(mux)
+	s.URL = s.server.URL
+	return s
+}
+
+// Close shuts the server down.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+func (s *Server) newID(prefix string) string {
+	s.nextID++
+	return fmt.Sprintf("%s_%d", prefix, s.nextID)
+}
+
+// admin wraps a handler with admin API key authentication.
+func (s *Server) admin(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+AdminAPIKey {
+			writeError(w, http.StatusUnauthorized, "Invalid admin API key")
+			return
+		}
+
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		next(w, r)
+	}
+}
+
+// organization wraps a handler with organization API key authentication. The organization
+// the key belongs to is passed to the handler through the organizationID path value.
+func (s *Server) organization(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		publicKey, secretKey, ok := r.BasicAuth()
+		if !ok {
+			writeError(w, http.StatusUnauthorized, "Missing organization API key")
+			return
+		}
+		for _, key := range s.organizationApiKeys {
+			if key.PublicKey == publicKey && key.SecretKey == secretKey {
+				r.SetPathValue("organizationID", key.organizationID)
+				next(w, r)
+				return
+			}
+		}
+		writeError(w, http.StatusUnauthorized, "Invalid organization API key")
+	}
+}
+
+// project wraps a handler with project API key authentication. The project the key belongs to
+// is passed to the handler through the projectID path value.
+func (s *Server) project(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		publicKey, secretKey, ok := r.BasicAuth()
+		if !ok {
+			writeError(w, http.StatusUnauthorized, "Missing project API key")
+			return
+		}
+		for _, key := range s.projectApiKeys {
+			if key.PublicKey == publicKey && key.SecretKey == secretKey {
+				r.SetPathValue("projectID", key.projectID)
+				next(w, r)
+				return
+			}
+		}
+		writeError(w, http.StatusUnauthorized, "Invalid project API key")
+	}
+}
+
+func (s *Server) health(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, langfuse.HealthResponse{Status: "OK", Version: Version})
+}
+
+func (s *Server) listOrganizations(w http.ResponseWriter, r *http.Request) {
+	organizations := make([]*langfuse.Organization, 0, len(s.organizations))
+	for _, organization := range s.organizations {
+		organizations = append(organizations, organization)
+	}
+	sort.Slice(organizations, func(i, j int) bool { return organizations[i].ID < organizations[j].ID })
+	writeJSON(w, http.StatusOK, langfuse.ListOrganizationsResponse{Organizations: organizations})
+}
+
+func (s *Server) createOrganization(w http.ResponseWriter, r *http.Request) {
+	var request langfuse.CreateOrganizationRequest
+	if !readJSON(w, r, &request) {
+		return
+	}
+
+	organization := &langfuse.Organization{ID: s.newID("org"), Name: request.Name, Metadata: request.Metadata}
+	s.organizations[organization.ID] = organization
+	s.memberships[organization.ID] = make(map[string]*langfuse.OrganizationMembership)
+	writeJSON(w, http.StatusCreated, organization)
+}
+
+func (s *Server) getOrganization(w http.ResponseWriter, r *http.Request) {
+	organization, ok := s.organizations[r.PathValue("organizationID")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Organization not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, organization)
+}
+
+func (s *Server) updateOrganization(w http.ResponseWriter, r *http.Request) {
+	organization, ok := s.organizations[r.PathValue("organizationID")]
+	if !ok {
+		writeError(w, http.StatusNotFound, "Organization not found")
+		return
+	}
+
+	var request langfuse.UpdateOrganizationRequest
+	if !readJSON(w, r, &request) {
+		return
+	}
+	organization.Name = request.Name
+	if request.Metadata != nil {
+		organization.Metadata = request.Metadata
+	}
+	writeJSON(w, http.StatusOK, organization)
+}
+
+func (s *Server) deleteOrganization(w http.ResponseWriter, r *http.Request) {
+	organizationID := r.PathValue("organizationID")
+	if _, ok := s.organizations[organizationID]; !ok {
+		writeError(w, http.StatusNotFound, "Organization not found")
+		return
+	}
+	for _, project := range s.projects {
+		if project.organizationID == organizationID {
+			writeError(w, http.StatusBadRequest, "Cannot delete organization with existing projects")
+			return
+		}
+	}
+
+	delete(s.organizations, organizationID)
+	delete(s.memberships, organizationID)
+	for id, key := range s.organizationApiKeys {
+		if key.organizationID == organizationID {
+			delete(s.organizationApiKeys, id)
+		}
+	}
+	writeJSON(w, http.StatusOK, map[string]bool{"success": true})
+}
+
+func (s *Server) listOrganizationApiKeys(w http.ResponseWriter, r *http.Request) {
+	organizationID := r.PathValue("organizationID")
+	if _, ok := s.organizations[organizationID]; !ok {
+		writeError(w, http.StatusNotFound, "Organization not found")
+		return
+	}
+
+	apiKeys := []langfuse.OrganizationApiKey{}
+	for _, key := range s.organizationApiKeys {
+		if key.organizationID == organizationID {
+			listed := key.OrganizationApiKey
+			listed.DisplaySecretKey = maskSecretKey(listed.SecretKey)
+			listed.SecretKey = ""
+			apiKeys = append(apiKeys, listed)
+		}
+	}
+	sort.Slice(apiKeys, func(i, j int) bool { return apiKeys[i].ID < apiKeys[j].ID })
+	writeJSON(w, http.StatusOK, map[string]any{"apiKeys": apiKeys})
+}
+
+func (s *Server) createOrganizationApiKey(w http.ResponseWriter, r *http.Request) {
+	organizationID := r.PathValue("organizationID")
+	if _, ok := s.organizations[organizationID]; !ok {
+		writeError(w, http.StatusNotFound, "Organization not found")
+		return
+	}
+
+	id := s.newID("oak")
+	now := time.Now().UTC()
+	key := &organizationApiKey{
+		OrganizationApiKey: langfuse.OrganizationApiKey{ID: id, PublicKey: "pk-lf-" + id, SecretKey: "sk-lf-" + id, CreatedAt: &now},
+		organizationID:     organizationID,
+	}
+	s.organizationApiKeys[id] = key
+	writeJSON(w, http.StatusCreated, key.OrganizationApiKey)
+}
+
+func (s *Server) deleteOrganizationApiKey(w http.ResponseWriter, r *http.Request) {
+	key, ok := s.organizationApiKeys[r.PathValue("apiKeyID")]
+	if !ok || key.organizationID != r.PathValue("organizationID") {
+		writeError(w, http.StatusNotFound, "API key not found")
+		return
+	}
+	delete(s.organizationApiKeys, key.ID)
+	writeJSON(w, http.StatusOK, map[string]bool{"success": true})
+}
+
+func (s *Server) listProjects(w http.ResponseWriter, r *http.Request) {
+	projects := []*langfuse.Project{}
+	for _, project := range s.projects {
+		if project.organizationID == r.PathValue("organizationID") {
+			projects = append(projects, &project.Project)
+		}
+	}
+	sort.Slice(projects, func(i, j int) bool { return projects[i].ID < projects[j].ID })
+	writeJSON(w, http.StatusOK, map[string]any{"projects": projects})
+}
+
+func (s *Server) createProject(w http.ResponseWriter, r *http.Request) {
+	var request langfuse.CreateProjectRequest
+	if !readJSON(w, r, &request) {
+		return
+	}
+
+	p := &project{
+		Project:        langfuse.Project{ID: s.newID("proj"), Name: request.Name, RetentionDays: retentionDays(request.RetentionDays), Metadata: request.Metadata},
+		organizationID: r.PathValue("organizationID"),
+	}
+	s.projects[p.ID] = p
+	writeJSON(w, http.StatusCreated, p.Project)
+}
+
+// projectOf returns the project if it belongs to the organization of the calling key.
+func (s *Server) projectOf(w http.ResponseWriter, r *http.Request) (*project, bool) {
+	p, ok := s.projects[r.PathValue("projectID")]
+	if !ok || p.organizationID != r.PathValue("organizationID") {
+		writeError(w, http.StatusNotFound, "Project not found")
+		return nil, false
+	}
+	return p, true
+}
+
+func (s *Server) updateProject(w http.ResponseWriter, r *http.Request) {
+	p, ok := s.projectOf(w, r)
+	if !ok {
+		return
+	}
+
+	var request langfuse.UpdateProjectRequest
+	if !readJSON(w, r, &request) {
+		return
+	}
+	p.Name = request.Name
+	if request.RetentionDays != nil {
+		p.RetentionDays = retentionDays(*request.RetentionDays)
+	}
+	if request.Metadata != nil {
+		p.Metadata = request.Metadata
+	}
+	writeJSON(w, http.StatusOK, p.Project)
+}
+
+// retentionDays returns the retention Langfuse reports for a requested retention: none for 0,
+// which keeps data indefinitely.
+func retentionDays(requested int32) *int32 {
+	if requested == 0 {
+		return nil
+	}
+	return &requested
+}
+
+func (s *Server) deleteProject(w http.ResponseWriter, r *http.Request) {
+	p, ok := s.projectOf(w, r)
+	if !ok {
+		return
+	}
+
+	delete(s.projects, p.ID)
+	for id, key := range s.projectApiKeys {
+		if key.projectID == p.ID {
+			delete(s.projectApiKeys, id)
+		}
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"success": true, "message": "Project deleted"})
+}
+
+func (s *Server) listProjectApiKeys(w http.ResponseWriter, r *http.Request) {
+	p, ok := s.projectOf(w, r)
+	if !ok {
+		return
+	}
+
+	apiKeys := []langfuse.ProjectApiKey{}
+	for _, key := range s.projectApiKeys {
+		if key.projectID == p.ID {
+			listed := key.ProjectApiKey
+			listed.DisplaySecretKey = maskSecretKey(listed.SecretKey)
+			listed.SecretKey = ""
+			apiKeys = append(apiKeys, listed)
+		}
+	}
+	sort.Slice(apiKeys, func(i, j int) bool { return apiKeys[i].ID < apiKeys[j].ID })
+	writeJSON(w, http.StatusOK, map[string]any{"apiKeys": apiKeys})
+}
+
+func (s *Server) createProjectApiKey(w http.ResponseWriter, r *http.Request) {
+	p, ok := s.projectOf(w, r)
+	if !ok {
+		return
+	}
+
+	id := s.newID("pak")
+	now := time.Now().UTC()
+	key := &projectApiKey{
+		ProjectApiKey: langfuse.ProjectApiKey{ID: id, PublicKey: "pk-lf-" + id, SecretKey: "sk-lf-" + id, CreatedAt: &now},
+		projectID:     p.ID,
+	}
+	s.projectApiKeys[id] = key
+	writeJSON(w, http.StatusCreated, key.ProjectApiKey)
+}
+
+func (s *Server) deleteProjectApiKey(w http.ResponseWriter, r *http.Request) {
+	p, ok := s.projectOf(w, r)
+	if !ok {
+		return
+	}
+
+	key, ok := s.projectApiKeys[r.PathValue("apiKeyID")]
+	if !ok || key.projectID != p.ID {
+		writeError(w, http.StatusNotFound, "API key not found")
+		return
+	}
+	delete(s.projectApiKeys, key.ID)
+	writeJSON(w, http.StatusOK, map[string]bool{"success": true})
+}
+
+func (s *Server) listMemberships(w http.ResponseWriter, r *http.Request) {
+	query := langfuse.MembershipQuery{Roles: r.URL.Query()["role"]}
+	memberships := []langfuse.OrganizationMembership{}
+	for _, membership := range s.memberships[r.PathValue("organizationID")] {
+		if query.Matches(*membership) {
+			memberships = append(memberships, *membership)
+		}
+	}
+	sort.Slice(memberships, func(i, j int) bool { return memberships[i].UserID < memberships[j].UserID })
+	writeJSON(w, http.StatusOK, map[string]any{"memberships": memberships})
+}
+
+func (s *Server) updateMembership(w http.ResponseWriter, r *http.Request) {
+	var request langfuse.UpdateMembershipRequest
+	if !readJSON(w, r, &request) {
+		return
+	}
+
+	membership, ok := s.memberships[r.PathValue("organizationID")][request.UserID]
+	if !ok {
+		writeError(w, http.StatusNotFound, "User is not a member of the organization")
+		return
+	}
+	membership.Role = request.Role
+	writeJSON(w, http.StatusOK, membership)
+}
+
+func (s *Server) removeMembership(w http.ResponseWriter, r *http.Request) {
+	var request struct {
+		UserID string `json:"userId"`
+	}
+	if !readJSON(w, r, &request) {
+		return
+	}
+
+	memberships := s.memberships[r.PathValue("organizationID")]
+	if _, ok := memberships[request.UserID]; !ok {
+		writeError(w, http.StatusNotFound, "User is not a member of the organization")
+		return
+	}
+	delete(memberships, request.UserID)
+	writeJSON(w, http.StatusOK, map[string]any{"success": true, "message": "Membership deleted"})
+}
+
+var scimUserNameFilter = regexp.MustCompile(`^userName eq "(.*)"$`)
+
+// listSCIMUsers lists the users of the organization of the calling key as a SCIM ListResponse,
+// supporting only the `userName eq "..."` filter.
+func (s *Server) listSCIMUsers(w http.ResponseWriter, r *http.Request) {
+	var userName string
+	if filter := r.URL.Query().Get("filter"); filter != "" {
+		match := scimUserNameFilter.FindStringSubmatch(filter)
+		if match == nil {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("Unsupported filter: %s", filter))
+			return
+		}
+		userName = match[1]
+	}
+
+	users := []langfuse.SCIMUserResponse{}
+	for _, membership := range s.memberships[r.PathValue("organizationID")] {
+		if userName != "" && !strings.EqualFold(membership.Email, userName) {
+			continue
+		}
+		users = append(users, langfuse.SCIMUserResponse{
+			ID:          membership.UserID,
+			UserName:    membership.Email,
+			Active:      membership.Status == "ACTIVE",
+			DisplayName: membership.Username,
+		})
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"schemas":      []string{"urn:ietf:params:scim:api:messages:2.0:ListResponse"},
+		"totalResults": len(users),
+		"Resources":    users,
+	})
+}
+
+// createSCIMUser creates a user and adds it to the organization of the calling key with role
+// NONE, mirroring Langfuse's SCIM endpoint.
+func (s *Server) createSCIMUser(w http.ResponseWriter, r *http.Request) {
+	var request langfuse.SCIMUserRequest
+	if !readJSON(w, r, &request) {
+		return
+	}
+	if _, ok := s.users[request.UserName]; ok {
+		writeError(w, http.StatusConflict, "User already exists")
+		return
+	}
+
+	userID := s.newID("user")
+	s.users[request.UserName] = userID
+	username := request.DisplayName
+	if username == "" {
+		username = strings.Split(request.UserName, "@")[0]
+	}
+	s.memberships[r.PathValue("organizationID")][userID] = &langfuse.OrganizationMembership{
+		ID:       s.newID("mem"),
+		Email:    request.UserName,
+		Role:     "NONE",
+		Status:   "ACTIVE",
+		UserID:   userID,
+		Username: username,
+	}
+
+	writeJSON(w, http.StatusCreated, langfuse.SCIMUserResponse{
+		ID:          userID,
+		UserName:    request.UserName,
+		Emails:      request.Emails,
+		Active:      true,
+		DisplayName: request.DisplayName,
+		ExternalID:  request.ExternalID,
+	})
+}
+
+// ingest stores the traces of trace-create events; other event types are accepted and dropped.
+func (s *Server) ingest(w http.ResponseWriter, r *http.Request) {
+	var request struct {
+		Batch []struct {
+			ID   string          `json:"id"`
+			Type string          `json:"type"`
+			Body json.RawMessage `json:"body"`
+		} `json:"batch"`
+	}
+	if !readJSON(w, r, &request) {
+		return
+	}
+
+	response := langfuse.IngestionResponse{Successes: []langfuse.IngestionResult{}, Errors: []langfuse.IngestionResult{}}
+	for _, event := range request.Batch {
+		if event.Type == "trace-create" {
+			var trace langfuse.Trace
+			if err := json.Unmarshal(event.Body, &trace); err != nil || trace.ID == "" {
+				response.Errors = append(response.Errors, langfuse.IngestionResult{ID: event.ID, Status: http.StatusBadRequest, Message: "Invalid trace"})
+				continue
+			}
+			trace.ProjectID = r.PathValue("projectID")
+			if trace.Environment == "" {
+				trace.Environment = "default"
+			}
+			s.traces[trace.ID] = &trace
+		}
+		response.Successes = append(response.Successes, langfuse.IngestionResult{ID: event.ID, Status: http.StatusCreated})
+	}
+	writeJSON(w, http.StatusMultiStatus, response)
+}
+
+func (s *Server) getTrace(w http.ResponseWriter, r *http.Request) {
+	trace, ok := s.traces[r.PathValue("traceID")]
+	if !ok || trace.ProjectID != r.PathValue("projectID") {
+		writeError(w, http.StatusNotFound, "Trace not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, trace)
+}
+
+// queryMetrics supports count metrics of the traces view, optionally grouped by environment. The
+// fake does not store observations, so the observations view is always empty.
+func (s *Server) queryMetrics(w http.ResponseWriter, r *http.Request) {
+	var query langfuse.MetricsQuery
+	if err := json.Unmarshal([]byte(r.URL.Query().Get("query")), &query); err != nil {
+		writeError(w, http.StatusBadRequest, "Invalid query")
+		return
+	}
+	for _, metric := range query.Metrics {
+		if metric.Measure != "count" || metric.Aggregation != "count" {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("Unsupported metric %s_%s", metric.Aggregation, metric.Measure))
+			return
+		}
+	}
+	byEnvironment := len(query.Dimensions) == 1 && query.Dimensions[0].Field == "environment"
+	if len(query.Dimensions) > 0 && !byEnvironment {
+		writeError(w, http.StatusBadRequest, "Unsupported dimensions")
+		return
+	}
+
+	counts := make(map[string]int)
+	if query.View == langfuse.MetricsViewTraces {
+		for _, trace := range s.traces {
+			if trace.ProjectID != r.PathValue("projectID") || trace.Timestamp == nil ||
+				trace.Timestamp.Before(query.FromTimestamp) || !trace.Timestamp.Before(query.ToTimestamp) {
+				continue
+			}
+			environment := ""
+			if byEnvironment {
+				environment = trace.Environment
+			}
+			counts[environment]++
+		}
+	}
+
+	response := langfuse.MetricsResponse{Data: []map[string]any{}}
+	for environment, count := range counts {
+		row := map[string]any{"count_count": strconv.Itoa(count)}
+		if byEnvironment {
+			row["environment"] = environment
+		}
+		response.Data = append(response.Data, row)
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+// createScore creates or, when the ID already exists, overwrites a score, as Langfuse does.
+func (s *Server) createScore(w http.ResponseWriter, r *http.Request) {
+	var request langfuse.CreateScoreRequest
+	if !readJSON(w, r, &request) {
+		return
+	}
+	if request.Name == "" || request.Value == nil {
+		writeError(w, http.StatusBadRequest, "name and value are required")
+		return
+	}
+
+	id := request.ID
+	if id == "" {
+		id = s.newID("score")
+	}
+	created := &score{
+		Score: langfuse.Score{
+			ID:            id,
+			Name:          request.Name,
+			DataType:      request.DataType,
+			Source:        "API",
+			TraceID:       request.TraceID,
+			SessionID:     request.SessionID,
+			ObservationID: request.ObservationID,
+			Comment:       request.Comment,
+			ConfigID:      request.ConfigID,
+		},
+		projectID: r.PathValue("projectID"),
+	}
+	switch value := request.Value.(type) {
+	case float64:
+		created.Value = &value
+		if created.DataType == "" {
+			created.DataType = langfuse.ScoreDataTypeNumeric
+		}
+	case string:
+		created.StringValue = value
+		if created.DataType == "" {
+			created.DataType = langfuse.ScoreDataTypeCategorical
+		}
+	default:
+		writeError(w, http.StatusBadRequest, "value must be a number or a string")
+		return
+	}
+
+	s.scores[id] = created
+	writeJSON(w, http.StatusOK, map[string]string{"id": id})
+}
+
+func (s *Server) getScore(w http.ResponseWriter, r *http.Request) {
+	score, ok := s.scores[r.PathValue("scoreID")]
+	if !ok || score.projectID != r.PathValue("projectID") {
+		writeError(w, http.StatusNotFound, "Score not found")
+		return
+	}
+	writeJSON(w, http.StatusOK, score.Score)
+}
+
+func (s *Server) deleteScore(w http.ResponseWriter, r *http.Request) {
+	score, ok := s.scores[r.PathValue("scoreID")]
+	if !ok || score.projectID != r.PathValue("projectID") {
+		writeError(w, http.StatusNotFound, "Score not found")
+		return
+	}
+	delete(s.scores, score.ID)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// createPrompt adds a version to a prompt, creating the prompt if needed. As in Langfuse, the new
+// version takes the latest label and tags are shared by all versions of a prompt.
+func (s *Server) createPrompt(w http.ResponseWriter, r *http.Request) {
+	var request langfuse.Prompt
+	if !readJSON(w, r, &request) {
+		return
+	}
+	if request.Name == "" || request.Prompt == nil {
+		writeError(w, http.StatusBadRequest, "name and prompt are required")
+		return
+	}
+
+	key := promptKey{projectID: r.PathValue("projectID"), name: request.Name}
+	versions := s.prompts[key]
+	created := request
+	created.Version = len(versions) + 1
+	if created.Type == "" {
+		created.Type = "text"
+	}
+	if created.Tags == nil {
+		created.Tags = []string{}
+	}
+	created.Labels = nil
+	for _, version := range versions {
+		version.Tags = created.Tags
+	}
+	s.prompts[key] = append(versions, &created)
+	s.setPromptLabels(key, &created, append(request.Labels, langfuse.PromptLabelLatest))
+
+	writeJSON(w, http.StatusCreated, created)
+}
+
+func (s *Server) getPrompt(w http.ResponseWriter, r *http.Request) {
+	versions := s.prompts[promptKey{projectID: r.PathValue("projectID"), name: r.PathValue("promptName")}]
+	label := r.URL.Query().Get("label")
+	version := r.URL.Query().Get("version")
+	if label == "" && version == "" {
+		label = "production"
+	}
+	for _, prompt := range versions {
+		if version != "" && strconv.Itoa(prompt.Version) == version || version == "" && slices.Contains(prompt.Labels, label) {
+			writeJSON(w, http.StatusOK, prompt)
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, "Prompt not found")
+}
+
+func (s *Server) deletePrompt(w http.ResponseWriter, r *http.Request) {
+	key := promptKey{projectID: r.PathValue("projectID"), name: r.PathValue("promptName")}
+	if _, ok := s.prompts[key]; !ok {
+		writeError(w, http.StatusNotFound, "Prompt not found")
+		return
+	}
+	delete(s.prompts, key)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) updatePromptLabels(w http.ResponseWriter, r *http.Request) {
+	var request struct {
+		NewLabels []string `json:"newLabels"`
+	}
+	if !readJSON(w, r, &request) {
+		return
+	}
+	if slices.Contains(request.NewLabels, langfuse.PromptLabelLatest) {
+		writeError(w, http.StatusBadRequest, "The latest label is reserved and managed by Langfuse")
+		return
+	}
+
+	key := promptKey{projectID: r.PathValue("projectID"), name: r.PathValue("promptName")}
+	for _, prompt := range s.prompts[key] {
+		if strconv.Itoa(prompt.Version) != r.PathValue("version") {
+			continue
+		}
+		labels := request.NewLabels
+		if slices.Contains(prompt.Labels, langfuse.PromptLabelLatest) {
+			labels = append(labels, langfuse.PromptLabelLatest)
+		}
+		s.setPromptLabels(key, prompt, labels)
+		writeJSON(w, http.StatusOK, prompt)
+		return
+	}
+	writeError(w, http.StatusNotFound, "Prompt version not found")
+}
+
+// setPromptLabels replaces the labels of prompt and removes them from its other versions, since a
+// label is held by at most one version.
+func (s *Server) setPromptLabels(key promptKey, prompt *langfuse.Prompt, labels []string) {
+	labels = slices.Compact(slices.Sorted(slices.Values(labels)))
+	for _, other := range s.prompts[key] {
+		if other != prompt {
+			other.Labels = slices.DeleteFunc(other.Labels, func(label string) bool { return slices.Contains(labels, label) })
+		}
+	}
+	prompt.Labels = labels
+}
+
+func maskSecretKey(secretKey string) string {
+	if len(secretKey) <= 10 {
+		return "..."
+	}
+	return secretKey[:6] + "..." + secretKey[len(secretKey)-4:]
+}
+
+func readJSON(w http.ResponseWriter, r *http.Request, target any) bool {
+	if err := json.NewDecoder(r.Body).Decode(target); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid request body: %v", err))
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]string{"message": message})
+}
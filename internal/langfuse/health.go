@@ -0,0 +1,32 @@
+package langfuse
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+type HealthResponse struct {
+	Status  string `json:"status"`
+	Version string `json:"version"`
+}
+
+// GetHealth calls the unauthenticated health endpoint, which also reports the version of the instance.
+func GetHealth(ctx context.Context, httpClient *http.Client, host string) (*HealthResponse, error) {
+	req, err := buildBaseRequest(ctx, http.MethodGet, buildURL(host, "api/public/health"), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+
+	var health HealthResponse
+	if err := decodeResponse(resp, &health); err != nil {
+		return nil, err
+	}
+
+	return &health, nil
+}
@@ -0,0 +1,40 @@
+package langfuse
+
+import "sync"
+
+// IssuedSecrets holds secrets Langfuse returns only once, at creation, for resources that do not
+// write them to state, until they are taken. It is safe for concurrent use.
+type IssuedSecrets struct {
+	mu      sync.Mutex
+	secrets map[string]string
+}
+
+// Store keeps the secret of the object with the given ID.
+func (s *IssuedSecrets) Store(id, secret string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.secrets == nil {
+		s.secrets = make(map[string]string)
+	}
+	s.secrets[id] = secret
+}
+
+// Take returns the secret of the object with the given ID and forgets it, so that it is handed out
+// at most once.
+func (s *IssuedSecrets) Take(id string) (string, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	secret, ok := s.secrets[id]
+	delete(s.secrets, id)
+	return secret, ok
+}
+
+// Delete forgets the secret of the object with the given ID, e.g. because the object was deleted.
+func (s *IssuedSecrets) Delete(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.secrets, id)
+}
@@ -0,0 +1,24 @@
+package langfuse
+
+import "testing"
+
+func TestIssuedSecrets(t *testing.T) {
+	var secrets IssuedSecrets
+	if _, ok := secrets.Take("pak-1"); ok {
+		t.Fatal("expected no secret before one is stored")
+	}
+
+	secrets.Store("pak-1", "sk-lf-1")
+	secrets.Store("pak-2", "sk-lf-2")
+	if secret, ok := secrets.Take("pak-1"); !ok || secret != "sk-lf-1" {
+		t.Errorf("unexpected secret %q, %t", secret, ok)
+	}
+	if _, ok := secrets.Take("pak-1"); ok {
+		t.Error("expected a taken secret to be forgotten")
+	}
+
+	secrets.Delete("pak-2")
+	if _, ok := secrets.Take("pak-2"); ok {
+		t.Error("expected a deleted secret to be forgotten")
+	}
+}
@@ -0,0 +1,104 @@
+package langfuse
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultKeyCreationConcurrency is the number of API key creation calls allowed in flight per
+// project. Self-hosted instances occasionally fail with 500s when several keys are created for
+// the same project at once, so creation is serialized unless configured otherwise.
+const DefaultKeyCreationConcurrency = 1
+
+// keyCreationAttempts is the number of times a key creation that failed with a conflict is tried.
+const keyCreationAttempts = 3
+
+// keyCreationRetryDelay is the wait before the first retry; it doubles with every further retry.
+var keyCreationRetryDelay = 500 * time.Millisecond
+
+// keyCreationLimiter bounds the number of concurrent key creation calls per scope (project ID).
+// A nil limiter or a limit of zero or less does not restrict anything.
+type keyCreationLimiter struct {
+	limit int
+
+	mu    sync.Mutex
+	slots map[string]chan struct{}
+}
+
+func newKeyCreationLimiter(limit int) *keyCreationLimiter {
+	return &keyCreationLimiter{limit: limit, slots: make(map[string]chan struct{})}
+}
+
+// acquire blocks until a creation slot for scope is free or ctx is done. The returned function
+// releases the slot.
+func (l *keyCreationLimiter) acquire(ctx context.Context, scope string) (func(), error) {
+	if l == nil || l.limit <= 0 {
+		return func() {}, nil
+	}
+
+	l.mu.Lock()
+	slots, ok := l.slots[scope]
+	if !ok {
+		slots = make(chan struct{}, l.limit)
+		l.slots[scope] = slots
+	}
+	l.mu.Unlock()
+
+	select {
+	case slots <- struct{}{}:
+		return func() { <-slots }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// isKeyCreationConflict reports whether a key creation failed because it raced with another
+// creation: a 409, or a 500 caused by a unique constraint violation in the Langfuse database.
+// Other failures are not retried because the key may already have been created.
+func isKeyCreationConflict(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	switch apiErr.StatusCode {
+	case http.StatusConflict:
+		return true
+	case http.StatusInternalServerError:
+		body := strings.ToLower(apiErr.Body)
+		return strings.Contains(body, "unique constraint") || strings.Contains(body, "p2002") ||
+			strings.Contains(body, "deadlock") || strings.Contains(body, "could not serialize")
+	default:
+		return false
+	}
+}
+
+// createKeyWithRetry runs create while holding a creation slot for scope and retries it with
+// exponential backoff as long as it fails with a conflict.
+func createKeyWithRetry[T any](ctx context.Context, limiter *keyCreationLimiter, scope string, create func() (T, error)) (T, error) {
+	var zero T
+
+	release, err := limiter.acquire(ctx, scope)
+	if err != nil {
+		return zero, err
+	}
+	defer release()
+
+	delay := keyCreationRetryDelay
+	for attempt := 1; ; attempt++ {
+		result, err := create()
+		if err == nil || attempt == keyCreationAttempts || !isKeyCreationConflict(err) {
+			return result, err
+		}
+
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return zero, ctx.Err()
+		}
+		delay *= 2
+	}
+}
@@ -0,0 +1,106 @@
+package langfuse
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCreateProjectApiKeyBoundsConcurrencyPerProject(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			seen := maxInFlight.Load()
+			if current <= seen || maxInFlight.CompareAndSwap(seen, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"key","publicKey":"pk-lf-1","secretKey":"sk-lf-1"}`))
+	}))
+	defer server.Close()
+
+	clientFactory := NewClientFactory(server.URL, "admin")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			client := clientFactory.NewOrganizationClient("pk-org", "sk-org")
+			if _, err := client.CreateProjectApiKey(context.Background(), "proj-1"); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := maxInFlight.Load(); got != DefaultKeyCreationConcurrency {
+		t.Errorf("expected at most %d concurrent creations, got %d", DefaultKeyCreationConcurrency, got)
+	}
+}
+
+func TestCreateProjectApiKeyRetriesConflicts(t *testing.T) {
+	keyCreationRetryDelay = time.Millisecond
+
+	testCases := []struct {
+		name          string
+		status        int
+		body          string
+		expectedCalls int32
+		expectError   bool
+	}{
+		{
+			name:          "conflict",
+			status:        http.StatusConflict,
+			body:          `{"message":"conflict"}`,
+			expectedCalls: 2,
+		},
+		{
+			name:          "unique constraint violation",
+			status:        http.StatusInternalServerError,
+			body:          `{"message":"Unique constraint failed on the fields: (public_key)"}`,
+			expectedCalls: 2,
+		},
+		{
+			name:          "other server error",
+			status:        http.StatusInternalServerError,
+			body:          `{"message":"Internal Server Error"}`,
+			expectedCalls: 1,
+			expectError:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var calls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if calls.Add(1) == 1 {
+					w.WriteHeader(tc.status)
+					_, _ = w.Write([]byte(tc.body))
+					return
+				}
+				_, _ = w.Write([]byte(`{"id":"key","publicKey":"pk-lf-1","secretKey":"sk-lf-1"}`))
+			}))
+			defer server.Close()
+
+			client := NewClientFactory(server.URL, "admin").NewOrganizationClient("pk-org", "sk-org")
+			_, err := client.CreateProjectApiKey(context.Background(), "proj-1")
+
+			if (err != nil) != tc.expectError {
+				t.Fatalf("unexpected error result: %v", err)
+			}
+			if got := calls.Load(); got != tc.expectedCalls {
+				t.Errorf("expected %d calls, got %d", tc.expectedCalls, got)
+			}
+		})
+	}
+}
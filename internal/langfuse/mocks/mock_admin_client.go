@@ -123,6 +123,21 @@ func (mr *MockAdminClientMockRecorder) GetOrganizationApiKey(arg0, arg1, arg2 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationApiKey", reflect.TypeOf((*MockAdminClient)(nil).GetOrganizationApiKey), arg0, arg1, arg2)
 }
 
+// ListOrganizationApiKeys mocks base method.
+func (m *MockAdminClient) ListOrganizationApiKeys(arg0 context.Context, arg1 string) ([]langfuse.OrganizationApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrganizationApiKeys", arg0, arg1)
+	ret0, _ := ret[0].([]langfuse.OrganizationApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrganizationApiKeys indicates an expected call of ListOrganizationApiKeys.
+func (mr *MockAdminClientMockRecorder) ListOrganizationApiKeys(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrganizationApiKeys", reflect.TypeOf((*MockAdminClient)(nil).ListOrganizationApiKeys), arg0, arg1)
+}
+
 // ListOrganizations mocks base method.
 func (m *MockAdminClient) ListOrganizations(arg0 context.Context) ([]*langfuse.Organization, error) {
 	m.ctrl.T.Helper()
@@ -1,6 +1,8 @@
 package mocks
 
 import (
+	"context"
+
 	langfuse "github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -8,15 +10,48 @@ import (
 type mockClientFactory struct {
 	AdminClient        *MockAdminClient
 	OrganizationClient *MockOrganizationClient
+	ProjectClient      *MockProjectClient
+	Credentials        map[string]langfuse.OrganizationCredentials
+	DefaultCredentials *langfuse.OrganizationCredentials
+	ProjectCredentials *langfuse.ProjectCredentials
+	Version            string
+	BaseURL            string
+	RateLimit          *langfuse.RateLimitUsage
+	AuditFailures      []string
+	NoAdminAPIKey      bool
+	SensitiveSummary   bool
+	SkipListRefresh    bool
+	TemplatedHeaders   bool
+	NoRetentionWarning bool
+	ManagedBy          map[string]string
+	StateKey           []byte
+	ApiKeys            *langfuse.ProjectApiKeyRegistry
+	Secrets            *langfuse.IssuedSecrets
+	// Hosts records the hosts passed to ForHost, in order.
+	Hosts []string
+	// Clock overrides the runtime dependencies; unset fields use langfuse.DefaultRuntime.
+	Deps langfuse.Runtime
 }
 
 func NewMockClientFactory(ctrl *gomock.Controller) *mockClientFactory {
 	return &mockClientFactory{
 		AdminClient:        NewMockAdminClient(ctrl),
 		OrganizationClient: NewMockOrganizationClient(ctrl),
+		ProjectClient:      NewMockProjectClient(ctrl),
+		Credentials:        map[string]langfuse.OrganizationCredentials{},
+		ApiKeys:            &langfuse.ProjectApiKeyRegistry{},
+		Secrets:            &langfuse.IssuedSecrets{},
 	}
 }
 
+func (cf *mockClientFactory) Host() string {
+	return cf.BaseURL
+}
+
+func (cf *mockClientFactory) HasAdminAPIKey() bool {
+	return !cf.NoAdminAPIKey
+}
+
 func (cf *mockClientFactory) NewAdminClient() langfuse.AdminClient {
 	return cf.AdminClient
 }
@@ -24,3 +59,102 @@ func (cf *mockClientFactory) NewAdminClient() langfuse.AdminClient {
 func (cf *mockClientFactory) NewOrganizationClient(publicKey, privateKey string) langfuse.OrganizationClient {
 	return cf.OrganizationClient
 }
+
+func (cf *mockClientFactory) NewProjectClient(publicKey, secretKey string) langfuse.ProjectClient {
+	return cf.ProjectClient
+}
+
+func (cf *mockClientFactory) OrganizationCredentials(name string) (langfuse.OrganizationCredentials, bool) {
+	credentials, ok := cf.Credentials[name]
+	return credentials, ok
+}
+
+func (cf *mockClientFactory) DefaultOrganizationCredentials() (langfuse.OrganizationCredentials, bool) {
+	if cf.DefaultCredentials == nil {
+		return langfuse.OrganizationCredentials{}, false
+	}
+	return *cf.DefaultCredentials, true
+}
+
+func (cf *mockClientFactory) DefaultProjectCredentials() (langfuse.ProjectCredentials, bool) {
+	if cf.ProjectCredentials == nil {
+		return langfuse.ProjectCredentials{}, false
+	}
+	return *cf.ProjectCredentials, true
+}
+
+func (cf *mockClientFactory) InstanceVersion(ctx context.Context) (string, error) {
+	return cf.Version, nil
+}
+
+func (cf *mockClientFactory) Runtime() langfuse.Runtime {
+	runtime := langfuse.DefaultRuntime()
+	if cf.Deps.Now != nil {
+		runtime.Now = cf.Deps.Now
+	}
+	if cf.Deps.After != nil {
+		runtime.After = cf.Deps.After
+	}
+	if cf.Deps.NewID != nil {
+		runtime.NewID = cf.Deps.NewID
+	}
+	return runtime
+}
+
+func (cf *mockClientFactory) FastRefresh() bool {
+	return cf.SkipListRefresh
+}
+
+func (cf *mockClientFactory) HasRequestHeaders() bool {
+	return cf.TemplatedHeaders
+}
+
+func (cf *mockClientFactory) SensitiveStateSummary() bool {
+	return cf.SensitiveSummary
+}
+
+func (cf *mockClientFactory) RetentionWarning() bool {
+	return !cf.NoRetentionWarning
+}
+
+func (cf *mockClientFactory) ManagedByMetadata() map[string]string {
+	return cf.ManagedBy
+}
+
+func (cf *mockClientFactory) StateEncryptionKey() []byte {
+	return cf.StateKey
+}
+
+func (cf *mockClientFactory) ProjectApiKeys() *langfuse.ProjectApiKeyRegistry {
+	return cf.ApiKeys
+}
+
+func (cf *mockClientFactory) IssuedSecrets() *langfuse.IssuedSecrets {
+	return cf.Secrets
+}
+
+func (cf *mockClientFactory) AuditLogFailures() []string {
+	failures := cf.AuditFailures
+	cf.AuditFailures = nil
+	return failures
+}
+
+func (cf *mockClientFactory) RateLimitWarning() (langfuse.RateLimitUsage, bool) {
+	if cf.RateLimit == nil {
+		return langfuse.RateLimitUsage{}, false
+	}
+	usage := *cf.RateLimit
+	cf.RateLimit = nil
+	return usage, true
+}
+
+// ForHost returns a copy of the factory for host that shares the mock clients, without the admin
+// API key and the default organization credentials.
+func (cf *mockClientFactory) ForHost(host string) langfuse.ClientFactory {
+	cf.Hosts = append(cf.Hosts, host)
+	other := *cf
+	other.BaseURL = host
+	other.NoAdminAPIKey = true
+	other.DefaultCredentials = nil
+	return &other
+}
@@ -153,6 +153,36 @@ func (mr *MockOrganizationClientMockRecorder) GetProjectApiKey(arg0, arg1, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectApiKey", reflect.TypeOf((*MockOrganizationClient)(nil).GetProjectApiKey), arg0, arg1, arg2)
 }
 
+// GetSCIMUserByEmail mocks base method.
+func (m *MockOrganizationClient) GetSCIMUserByEmail(arg0 context.Context, arg1 string) (*langfuse.SCIMUserResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSCIMUserByEmail", arg0, arg1)
+	ret0, _ := ret[0].(*langfuse.SCIMUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSCIMUserByEmail indicates an expected call of GetSCIMUserByEmail.
+func (mr *MockOrganizationClientMockRecorder) GetSCIMUserByEmail(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSCIMUserByEmail", reflect.TypeOf((*MockOrganizationClient)(nil).GetSCIMUserByEmail), arg0, arg1)
+}
+
+// ListAuditLogs mocks base method.
+func (m *MockOrganizationClient) ListAuditLogs(arg0 context.Context, arg1 *langfuse.AuditLogQuery) ([]langfuse.AuditLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuditLogs", arg0, arg1)
+	ret0, _ := ret[0].([]langfuse.AuditLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditLogs indicates an expected call of ListAuditLogs.
+func (mr *MockOrganizationClientMockRecorder) ListAuditLogs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditLogs", reflect.TypeOf((*MockOrganizationClient)(nil).ListAuditLogs), arg0, arg1)
+}
+
 // ListMemberships mocks base method.
 func (m *MockOrganizationClient) ListMemberships(arg0 context.Context) ([]langfuse.OrganizationMembership, error) {
 	m.ctrl.T.Helper()
@@ -168,6 +198,21 @@ func (mr *MockOrganizationClientMockRecorder) ListMemberships(arg0 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMemberships", reflect.TypeOf((*MockOrganizationClient)(nil).ListMemberships), arg0)
 }
 
+// ListProjectApiKeys mocks base method.
+func (m *MockOrganizationClient) ListProjectApiKeys(arg0 context.Context, arg1 string) ([]langfuse.ProjectApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjectApiKeys", arg0, arg1)
+	ret0, _ := ret[0].([]langfuse.ProjectApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectApiKeys indicates an expected call of ListProjectApiKeys.
+func (mr *MockOrganizationClientMockRecorder) ListProjectApiKeys(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectApiKeys", reflect.TypeOf((*MockOrganizationClient)(nil).ListProjectApiKeys), arg0, arg1)
+}
+
 // ListProjects mocks base method.
 func (m *MockOrganizationClient) ListProjects(arg0 context.Context) ([]*langfuse.Project, error) {
 	m.ctrl.T.Helper()
@@ -183,6 +228,36 @@ func (mr *MockOrganizationClientMockRecorder) ListProjects(arg0 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjects", reflect.TypeOf((*MockOrganizationClient)(nil).ListProjects), arg0)
 }
 
+// QueryMemberships mocks base method.
+func (m *MockOrganizationClient) QueryMemberships(arg0 context.Context, arg1 *langfuse.MembershipQuery) ([]langfuse.OrganizationMembership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryMemberships", arg0, arg1)
+	ret0, _ := ret[0].([]langfuse.OrganizationMembership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryMemberships indicates an expected call of QueryMemberships.
+func (mr *MockOrganizationClientMockRecorder) QueryMemberships(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryMemberships", reflect.TypeOf((*MockOrganizationClient)(nil).QueryMemberships), arg0, arg1)
+}
+
+// QueueMembershipUpdate mocks base method.
+func (m *MockOrganizationClient) QueueMembershipUpdate(arg0 context.Context, arg1 langfuse.UpdateMembershipRequest) (*langfuse.OrganizationMembership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueueMembershipUpdate", arg0, arg1)
+	ret0, _ := ret[0].(*langfuse.OrganizationMembership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueueMembershipUpdate indicates an expected call of QueueMembershipUpdate.
+func (mr *MockOrganizationClientMockRecorder) QueueMembershipUpdate(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueueMembershipUpdate", reflect.TypeOf((*MockOrganizationClient)(nil).QueueMembershipUpdate), arg0, arg1)
+}
+
 // RemoveMember mocks base method.
 func (m *MockOrganizationClient) RemoveMember(arg0 context.Context, arg1 string) error {
 	m.ctrl.T.Helper()
@@ -212,6 +287,21 @@ func (mr *MockOrganizationClientMockRecorder) UpdateMembership(arg0, arg1, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMembership", reflect.TypeOf((*MockOrganizationClient)(nil).UpdateMembership), arg0, arg1, arg2)
 }
 
+// UpdateMemberships mocks base method.
+func (m *MockOrganizationClient) UpdateMemberships(arg0 context.Context, arg1 []langfuse.UpdateMembershipRequest) ([]langfuse.OrganizationMembership, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMemberships", arg0, arg1)
+	ret0, _ := ret[0].([]langfuse.OrganizationMembership)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMemberships indicates an expected call of UpdateMemberships.
+func (mr *MockOrganizationClientMockRecorder) UpdateMemberships(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMemberships", reflect.TypeOf((*MockOrganizationClient)(nil).UpdateMemberships), arg0, arg1)
+}
+
 // UpdateProject mocks base method.
 func (m *MockOrganizationClient) UpdateProject(arg0 context.Context, arg1 string, arg2 *langfuse.UpdateProjectRequest) (*langfuse.Project, error) {
 	m.ctrl.T.Helper()
@@ -2,15 +2,24 @@ package langfuse
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"slices"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 )
 
 type Project struct {
-	ID            string            `json:"id"`
-	Name          string            `json:"name"`
-	RetentionDays int32             `json:"retentionDays"`
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// RetentionDays is nil when the response does not report the retention, as project listings of
+	// many instances do, or reports none.
+	RetentionDays *int32            `json:"retentionDays"`
 	Metadata      map[string]string `json:"metadata"`
 }
 
@@ -18,6 +27,12 @@ type ProjectApiKey struct {
 	ID        string `json:"id"`
 	PublicKey string `json:"publicKey"`
 	SecretKey string `json:"secretKey"`
+	// The fields below are only returned when listing keys; the secret key is then masked.
+	DisplaySecretKey string     `json:"displaySecretKey,omitempty"`
+	Note             string     `json:"note,omitempty"`
+	CreatedAt        *time.Time `json:"createdAt,omitempty"`
+	ExpiresAt        *time.Time `json:"expiresAt,omitempty"`
+	LastUsedAt       *time.Time `json:"lastUsedAt,omitempty"`
 }
 
 type CreateProjectRequest struct {
@@ -26,10 +41,24 @@ type CreateProjectRequest struct {
 	Metadata      map[string]string `json:"metadata,omitempty"`
 }
 
+// UpdateProjectRequest changes a project. Langfuse requires the name; RetentionDays and Metadata
+// are only sent when set, so an update leaves the fields it does not change alone. A non-nil empty
+// Metadata removes all metadata.
 type UpdateProjectRequest struct {
 	Name          string            `json:"name"`
-	RetentionDays int32             `json:"retention"`
-	Metadata      map[string]string `json:"metadata,omitempty"`
+	RetentionDays *int32            `json:"retention,omitempty"`
+	Metadata      map[string]string `json:"metadata"`
+}
+
+func (r UpdateProjectRequest) MarshalJSON() ([]byte, error) {
+	type request UpdateProjectRequest
+	if r.Metadata != nil {
+		return json.Marshal(request(r))
+	}
+	return json.Marshal(struct {
+		Name          string `json:"name"`
+		RetentionDays *int32 `json:"retention,omitempty"`
+	}{Name: r.Name, RetentionDays: r.RetentionDays})
 }
 
 type listProjectsResponse struct {
@@ -38,6 +67,16 @@ type listProjectsResponse struct {
 
 type listProjectApiKeysResponse struct {
 	ApiKeys []ProjectApiKey `json:"apiKeys"`
+	Meta    *paginationMeta `json:"meta,omitempty"`
+}
+
+// paginationMeta describes the page returned by a paginated list endpoint. Endpoints that return
+// everything at once omit it.
+type paginationMeta struct {
+	Page       int `json:"page"`
+	Limit      int `json:"limit"`
+	TotalItems int `json:"totalItems"`
+	TotalPages int `json:"totalPages"`
 }
 
 type deleteProjectResponse struct {
@@ -49,6 +88,12 @@ type deleteProjectApiKeyResponse struct {
 	Success bool `json:"success"`
 }
 
+// Membership statuses reported by Langfuse.
+const (
+	MembershipStatusActive        = "ACTIVE"
+	MembershipStatusPendingInvite = "PENDING_INVITE"
+)
+
 type OrganizationMembership struct {
 	ID       string `json:"id"`
 	Email    string `json:"email"`
@@ -66,6 +111,10 @@ type SCIMUserRequest struct {
 	} `json:"emails"`
 	Password string `json:"password,omitempty"`
 	Active   bool   `json:"active"`
+	// DisplayName and ExternalID let an identity provider that later takes over provisioning
+	// recognize the user; externalId is the identifier the IdP correlates on.
+	DisplayName string `json:"displayName,omitempty"`
+	ExternalID  string `json:"externalId,omitempty"`
 }
 
 type SCIMUserResponse struct {
@@ -75,7 +124,15 @@ type SCIMUserResponse struct {
 		Value   string `json:"value"`
 		Primary bool   `json:"primary"`
 	} `json:"emails"`
-	Active bool `json:"active"`
+	Active      bool   `json:"active"`
+	DisplayName string `json:"displayName,omitempty"`
+	ExternalID  string `json:"externalId,omitempty"`
+}
+
+// listSCIMUsersResponse is a SCIM ListResponse of users.
+type listSCIMUsersResponse struct {
+	TotalResults int                `json:"totalResults"`
+	Resources    []SCIMUserResponse `json:"Resources"`
 }
 
 type UpdateMembershipRequest struct {
@@ -86,6 +143,61 @@ type UpdateMembershipRequest struct {
 
 type listMembershipsResponse struct {
 	Memberships []OrganizationMembership `json:"memberships"`
+	Meta        *paginationMeta          `json:"meta,omitempty"`
+}
+
+// MembershipQuery filters the memberships of an organization. An empty Roles does not filter.
+type MembershipQuery struct {
+	Roles []string
+}
+
+// Matches reports whether membership passes the filters of q.
+func (q *MembershipQuery) Matches(membership OrganizationMembership) bool {
+	return len(q.Roles) == 0 || slices.Contains(q.Roles, membership.Role)
+}
+
+// AuditLog is an entry of the organization audit log. The actor is a user, with UserID set, or an
+// API key, with ApiKeyID set. Before and After hold the JSON encoded resource, when recorded.
+type AuditLog struct {
+	ID           string    `json:"id"`
+	CreatedAt    time.Time `json:"createdAt"`
+	Type         string    `json:"type"`
+	UserID       string    `json:"userId,omitempty"`
+	ApiKeyID     string    `json:"apiKeyId,omitempty"`
+	ProjectID    string    `json:"projectId,omitempty"`
+	ResourceType string    `json:"resourceType"`
+	ResourceID   string    `json:"resourceId"`
+	Action       string    `json:"action"`
+	Before       string    `json:"before,omitempty"`
+	After        string    `json:"after,omitempty"`
+}
+
+// AuditLogQuery filters the audit log. Empty fields and zero times do not filter; From is
+// inclusive, To exclusive.
+type AuditLogQuery struct {
+	Actor  string
+	Action string
+	From   time.Time
+	To     time.Time
+}
+
+// Matches reports whether entry passes the filters of q.
+func (q *AuditLogQuery) Matches(entry AuditLog) bool {
+	if q.Actor != "" && q.Actor != entry.UserID && q.Actor != entry.ApiKeyID {
+		return false
+	}
+	if q.Action != "" && q.Action != entry.Action {
+		return false
+	}
+	if !q.From.IsZero() && entry.CreatedAt.Before(q.From) {
+		return false
+	}
+	return q.To.IsZero() || entry.CreatedAt.Before(q.To)
+}
+
+type listAuditLogsResponse struct {
+	Data []AuditLog      `json:"data"`
+	Meta *paginationMeta `json:"meta,omitempty"`
 }
 
 type removeMemberResponse struct {
@@ -101,21 +213,32 @@ type OrganizationClient interface {
 	CreateProject(ctx context.Context, request *CreateProjectRequest) (*Project, error)
 	UpdateProject(ctx context.Context, projectID string, request *UpdateProjectRequest) (*Project, error)
 	DeleteProject(ctx context.Context, projectID string) error
+	ListProjectApiKeys(ctx context.Context, projectID string) ([]ProjectApiKey, error)
 	GetProjectApiKey(ctx context.Context, projectID string, apiKeyID string) (*ProjectApiKey, error)
 	CreateProjectApiKey(ctx context.Context, projectID string) (*ProjectApiKey, error)
 	DeleteProjectApiKey(ctx context.Context, projectID string, apiKeyID string) error
 	ListMemberships(ctx context.Context) ([]OrganizationMembership, error)
+	QueryMemberships(ctx context.Context, query *MembershipQuery) ([]OrganizationMembership, error)
 	GetMembership(ctx context.Context, membershipID string) (*OrganizationMembership, error)
 	UpdateMembership(ctx context.Context, membershipID string, request *UpdateMembershipRequest) (*OrganizationMembership, error)
+	UpdateMemberships(ctx context.Context, requests []UpdateMembershipRequest) ([]OrganizationMembership, error)
+	QueueMembershipUpdate(ctx context.Context, request UpdateMembershipRequest) (*OrganizationMembership, error)
 	RemoveMember(ctx context.Context, membershipID string) error
 	CreateSCIMUser(ctx context.Context, request *SCIMUserRequest) (*SCIMUserResponse, error)
+	GetSCIMUserByEmail(ctx context.Context, email string) (*SCIMUserResponse, error)
+	ListAuditLogs(ctx context.Context, query *AuditLogQuery) ([]AuditLog, error)
 }
 
 type organizationClientImpl struct {
-	host       string
-	publicKey  string
-	privateKey string
-	httpClient *http.Client
+	host        string
+	publicKey   string
+	privateKey  string
+	httpClient  *http.Client
+	keyCreation *keyCreationLimiter
+	// apiVersion is the API version negotiated by the factory's clients; nil for standalone clients.
+	apiVersion *apiVersionTracker
+	// updates holds the role changes queued with QueueMembershipUpdate until they are sent together.
+	updates membershipUpdateQueue
 }
 
 func NewOrganizationClient(host, publicKey, privateKey string) OrganizationClient {
@@ -141,8 +264,26 @@ func (c *organizationClientImpl) ListProjects(ctx context.Context) ([]*Project,
 	return listProjResp.Projects, nil
 }
 
+// GetProject fetches a project. Instances that negotiated APIVersionNext have the single-project
+// endpoint, which reports the retention. Other instances are read through the project listing,
+// which does not report it on many instances; RetentionDays is then nil.
 func (c *organizationClientImpl) GetProject(ctx context.Context, projectID string) (*Project, error) {
-	// Note: this endpoint does not return `retentionDays`, so the returned value will always be 0
+	if c.apiVersion.supports(APIVersionNext) {
+		resp, err := c.makeRequest(ctx, http.MethodGet, fmt.Sprintf("api/public/projects/%s", projectID), nil)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode == http.StatusNotFound {
+			resp.Body.Close()
+			return nil, fmt.Errorf("cannot find project with ID %s", projectID)
+		}
+		var proj Project
+		if err := decodeResponse(resp, &proj); err != nil {
+			return nil, err
+		}
+		return &proj, nil
+	}
+
 	resp, err := c.makeRequest(ctx, http.MethodGet, "api/public/organizations/projects", nil)
 	if err != nil {
 		return nil, err
@@ -205,26 +346,74 @@ func (c *organizationClientImpl) DeleteProject(ctx context.Context, projectID st
 	return nil
 }
 
+// projectApiKeysPageSize is the number of keys requested per page when listing project API keys.
+const projectApiKeysPageSize = 100
+
+// ListProjectApiKeys returns every API key of a project, following pagination when the server
+// paginates the listing.
+func (c *organizationClientImpl) ListProjectApiKeys(ctx context.Context, projectID string) ([]ProjectApiKey, error) {
+	var apiKeys []ProjectApiKey
+	for page := 1; ; page++ {
+		resp, err := c.makeRequest(ctx, http.MethodGet, fmt.Sprintf("api/public/projects/%s/apiKeys?page=%d&limit=%d", projectID, page, projectApiKeysPageSize), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var listProjApiKeysResp listProjectApiKeysResponse
+		if err := decodeResponse(resp, &listProjApiKeysResp); err != nil {
+			return nil, err
+		}
+		apiKeys = append(apiKeys, listProjApiKeysResp.ApiKeys...)
+
+		meta := listProjApiKeysResp.Meta
+		if meta == nil || page >= meta.TotalPages || len(listProjApiKeysResp.ApiKeys) == 0 {
+			return apiKeys, nil
+		}
+	}
+}
+
+// GetProjectApiKey fetches a project API key by ID. Instances that negotiated APIVersionNext have
+// the by-ID endpoint, so a 404 there means the key does not exist. Other instances may lack it and
+// answer 404 or 405; the key is then looked up in the full key listing.
 func (c *organizationClientImpl) GetProjectApiKey(ctx context.Context, projectID string, apiKeyID string) (*ProjectApiKey, error) {
-	resp, err := c.makeRequest(ctx, http.MethodGet, fmt.Sprintf("api/public/projects/%s/apiKeys", projectID), nil)
+	resp, err := c.makeRequest(ctx, http.MethodGet, fmt.Sprintf("api/public/projects/%s/apiKeys/%s", projectID, apiKeyID), nil)
 	if err != nil {
 		return nil, err
 	}
+	byID := c.apiVersion.supports(APIVersionNext)
+	if byID && resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, fmt.Errorf("cannot find API key with ID %s in project %s: %w", apiKeyID, projectID, ErrNotFound)
+	}
+	if byID || (resp.StatusCode != http.StatusNotFound && resp.StatusCode != http.StatusMethodNotAllowed) {
+		var apiKey ProjectApiKey
+		if err := decodeResponse(resp, &apiKey); err != nil {
+			return nil, err
+		}
+		return &apiKey, nil
+	}
+	resp.Body.Close()
 
-	var listProjApiKeysResp listProjectApiKeysResponse
-	if err := decodeResponse(resp, &listProjApiKeysResp); err != nil {
+	apiKeys, err := c.ListProjectApiKeys(ctx, projectID)
+	if err != nil {
 		return nil, err
 	}
-	for _, key := range listProjApiKeysResp.ApiKeys {
+	for _, key := range apiKeys {
 		if key.ID == apiKeyID {
 			return &key, nil
 		}
 	}
 
-	return nil, fmt.Errorf("cannot find API key with ID %s in project %s", apiKeyID, projectID)
+	return nil, fmt.Errorf("cannot find API key with ID %s in project %s: %w", apiKeyID, projectID, ErrNotFound)
 }
 
 func (c *organizationClientImpl) CreateProjectApiKey(ctx context.Context, projectID string) (*ProjectApiKey, error) {
+	return createKeyWithRetry(ctx, c.keyCreation, projectID, func() (*ProjectApiKey, error) {
+		return c.createProjectApiKey(ctx, projectID)
+	})
+}
+
+func (c *organizationClientImpl) createProjectApiKey(ctx context.Context, projectID string) (*ProjectApiKey, error) {
 	resp, err := c.makeRequest(ctx, http.MethodPost, fmt.Sprintf("api/public/projects/%s/apiKeys", projectID), nil)
 	if err != nil {
 		return nil, err
@@ -255,17 +444,55 @@ func (c *organizationClientImpl) DeleteProjectApiKey(ctx context.Context, projec
 }
 
 func (c *organizationClientImpl) ListMemberships(ctx context.Context) ([]OrganizationMembership, error) {
-	resp, err := c.makeRequest(ctx, http.MethodGet, "api/public/organizations/memberships", nil)
-	if err != nil {
-		return nil, err
-	}
+	return c.QueryMemberships(ctx, &MembershipQuery{})
+}
 
-	var listMembershipsResp listMembershipsResponse
-	if err := decodeResponse(resp, &listMembershipsResp); err != nil {
-		return nil, err
-	}
+// membershipsPageSize is the number of memberships requested per page when listing memberships.
+const membershipsPageSize = 100
+
+// QueryMemberships returns the memberships of the organization that match query, ordered by email
+// and user ID. The roles are sent as filters and pagination is followed; both are applied again
+// locally, as instances that predate them ignore the parameters and return every membership at once.
+func (c *organizationClientImpl) QueryMemberships(ctx context.Context, query *MembershipQuery) ([]OrganizationMembership, error) {
+	params := url.Values{}
+	for _, role := range query.Roles {
+		params.Add("role", role)
+	}
+	params.Set("limit", fmt.Sprint(membershipsPageSize))
+
+	var memberships []OrganizationMembership
+	seen := make(map[string]bool)
+	for page := 1; ; page++ {
+		params.Set("page", fmt.Sprint(page))
+		resp, err := c.makeRequest(ctx, http.MethodGet, "api/public/organizations/memberships?"+params.Encode(), nil)
+		if err != nil {
+			return nil, err
+		}
 
-	return listMembershipsResp.Memberships, nil
+		var listMembershipsResp listMembershipsResponse
+		if err := decodeResponse(resp, &listMembershipsResp); err != nil {
+			return nil, err
+		}
+		for _, membership := range listMembershipsResp.Memberships {
+			// Memberships can shift between pages when members join or leave during the listing.
+			key := membership.UserID + "\x00" + membership.Email
+			if query.Matches(membership) && !seen[key] {
+				seen[key] = true
+				memberships = append(memberships, membership)
+			}
+		}
+
+		meta := listMembershipsResp.Meta
+		if meta == nil || page >= meta.TotalPages || len(listMembershipsResp.Memberships) == 0 {
+			sort.SliceStable(memberships, func(i, j int) bool {
+				if memberships[i].Email != memberships[j].Email {
+					return memberships[i].Email < memberships[j].Email
+				}
+				return memberships[i].UserID < memberships[j].UserID
+			})
+			return memberships, nil
+		}
+	}
 }
 
 func (c *organizationClientImpl) GetMembership(ctx context.Context, membershipID string) (*OrganizationMembership, error) {
@@ -284,25 +511,172 @@ func (c *organizationClientImpl) GetMembership(ctx context.Context, membershipID
 	return nil, fmt.Errorf("cannot find membership with ID %s", membershipID)
 }
 
+// UpdateMembership changes the role of a member. The membership is only looked up to resolve the
+// user ID when request does not set it.
 func (c *organizationClientImpl) UpdateMembership(ctx context.Context, membershipID string, request *UpdateMembershipRequest) (*OrganizationMembership, error) {
-	// Retrieve current membership to get the user ID
-	currentMembership, err := c.GetMembership(ctx, membershipID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get current membership: %w", err)
-	}
-
-	// Prepare update request with user ID
 	userIDToUpdate := request.UserID
 	if userIDToUpdate == "" {
+		currentMembership, err := c.GetMembership(ctx, membershipID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get current membership: %w", err)
+		}
 		userIDToUpdate = currentMembership.UserID
 	}
-	
-	updateRequest := UpdateMembershipRequest{
-		UserID: userIDToUpdate,
-		Role:   request.Role,
+
+	updatedMembership, err := c.putMembership(ctx, UpdateMembershipRequest{UserID: userIDToUpdate, Role: request.Role})
+	if err != nil {
+		return nil, err
+	}
+
+	// The PUT response may not include the membership ID, so preserve it from the original request
+	if updatedMembership.ID == "" {
+		updatedMembership.ID = membershipID
+	}
+
+	return updatedMembership, nil
+}
+
+// membershipUpdateConcurrency is the number of role changes UpdateMemberships sends at once.
+const membershipUpdateConcurrency = 8
+
+// membershipUpdateWindow is how long a role change queued with QueueMembershipUpdate waits for
+// other changes to share its batch.
+const membershipUpdateWindow = 50 * time.Millisecond
+
+// UpdateMemberships applies several role changes, each identifying the member by user ID or
+// email. Members named by email are resolved through a single membership listing shared by all
+// changes, and the changes are sent with bounded concurrency. The result holds the updated
+// memberships in the order of requests; failed changes are left out of it and reported together
+// in the error.
+func (c *organizationClientImpl) UpdateMemberships(ctx context.Context, requests []UpdateMembershipRequest) ([]OrganizationMembership, error) {
+	updated, errs := c.updateMemberships(ctx, requests)
+
+	var result []OrganizationMembership
+	for i, membership := range updated {
+		if membership != nil {
+			result = append(result, *membership)
+		}
+		if errs[i] != nil {
+			member := requests[i].UserID
+			if member == "" {
+				member = requests[i].Email
+			}
+			errs[i] = fmt.Errorf("%s: %w", member, errs[i])
+		}
+	}
+	return result, errors.Join(errs...)
+}
+
+// updateMemberships applies requests and returns the updated membership or the error of each.
+func (c *organizationClientImpl) updateMemberships(ctx context.Context, requests []UpdateMembershipRequest) ([]*OrganizationMembership, []error) {
+	updated := make([]*OrganizationMembership, len(requests))
+	errs := make([]error, len(requests))
+
+	var userIDs map[string]string
+	var listErr error
+	if slices.ContainsFunc(requests, func(request UpdateMembershipRequest) bool { return request.UserID == "" }) {
+		memberships, err := c.ListMemberships(ctx)
+		if err != nil {
+			listErr = fmt.Errorf("failed to list memberships: %w", err)
+		}
+		userIDs = make(map[string]string, len(memberships))
+		for _, membership := range memberships {
+			userIDs[strings.ToLower(membership.Email)] = membership.UserID
+		}
 	}
 
-	resp, err := c.makeRequest(ctx, http.MethodPut, "api/public/organizations/memberships", updateRequest)
+	slots := make(chan struct{}, membershipUpdateConcurrency)
+	var wg sync.WaitGroup
+	for i, request := range requests {
+		userID := request.UserID
+		if userID == "" {
+			if listErr != nil {
+				errs[i] = listErr
+				continue
+			}
+			var ok bool
+			if userID, ok = userIDs[strings.ToLower(request.Email)]; !ok {
+				errs[i] = fmt.Errorf("cannot find membership of %s: %w", request.Email, ErrNotFound)
+				continue
+			}
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case slots <- struct{}{}:
+				defer func() { <-slots }()
+			case <-ctx.Done():
+				errs[i] = ctx.Err()
+				return
+			}
+			updated[i], errs[i] = c.putMembership(ctx, UpdateMembershipRequest{UserID: userID, Role: request.Role})
+		}()
+	}
+	wg.Wait()
+
+	return updated, errs
+}
+
+// membershipUpdateQueue collects the role changes queued on a client until they are sent as one
+// batch.
+type membershipUpdateQueue struct {
+	mu      sync.Mutex
+	pending []*queuedMembershipUpdate
+}
+
+type queuedMembershipUpdate struct {
+	request    UpdateMembershipRequest
+	done       chan struct{}
+	membership *OrganizationMembership
+	err        error
+}
+
+// QueueMembershipUpdate applies a role change together with the changes queued by other callers
+// within membershipUpdateWindow, so that resources reconciling many members in parallel share one
+// membership listing instead of listing the memberships for every member. A member that is not in
+// the organization is reported with an error wrapping ErrNotFound. The change is sent even when
+// ctx is cancelled while it waits for its batch.
+func (c *organizationClientImpl) QueueMembershipUpdate(ctx context.Context, request UpdateMembershipRequest) (*OrganizationMembership, error) {
+	update := &queuedMembershipUpdate{request: request, done: make(chan struct{})}
+
+	c.updates.mu.Lock()
+	c.updates.pending = append(c.updates.pending, update)
+	if len(c.updates.pending) == 1 {
+		// The batch outlives the caller that opened it, so cancelling one change leaves the others alone.
+		batchCtx := context.WithoutCancel(ctx)
+		time.AfterFunc(membershipUpdateWindow, func() { c.flushMembershipUpdates(batchCtx) })
+	}
+	c.updates.mu.Unlock()
+
+	select {
+	case <-update.done:
+		return update.membership, update.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (c *organizationClientImpl) flushMembershipUpdates(ctx context.Context) {
+	c.updates.mu.Lock()
+	batch := c.updates.pending
+	c.updates.pending = nil
+	c.updates.mu.Unlock()
+
+	requests := make([]UpdateMembershipRequest, len(batch))
+	for i, update := range batch {
+		requests[i] = update.request
+	}
+	updated, errs := c.updateMemberships(ctx, requests)
+	for i, update := range batch {
+		update.membership, update.err = updated[i], errs[i]
+		close(update.done)
+	}
+}
+
+func (c *organizationClientImpl) putMembership(ctx context.Context, request UpdateMembershipRequest) (*OrganizationMembership, error) {
+	resp, err := c.makeRequest(ctx, http.MethodPut, "api/public/organizations/memberships", request)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update membership: %w", err)
 	}
@@ -312,11 +686,6 @@ func (c *organizationClientImpl) UpdateMembership(ctx context.Context, membershi
 		return nil, fmt.Errorf("failed to decode membership response: %w", err)
 	}
 
-	// The PUT response may not include the membership ID, so preserve it from the original request
-	if updatedMembership.ID == "" {
-		updatedMembership.ID = membershipID
-	}
-
 	return &updatedMembership, nil
 }
 
@@ -365,6 +734,81 @@ func (c *organizationClientImpl) CreateSCIMUser(ctx context.Context, request *SC
 	return &scimUser, nil
 }
 
+// GetSCIMUserByEmail looks up a user of the organization through the SCIM endpoint. Langfuse uses
+// the email address as SCIM userName, so the lookup filters on userName.
+func (c *organizationClientImpl) GetSCIMUserByEmail(ctx context.Context, email string) (*SCIMUserResponse, error) {
+	filter := url.QueryEscape(fmt.Sprintf("userName eq %q", email))
+	resp, err := c.makeRequest(ctx, http.MethodGet, "api/public/scim/Users?filter="+filter, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var listResp listSCIMUsersResponse
+	if err := decodeResponse(resp, &listResp); err != nil {
+		return nil, fmt.Errorf("failed to decode SCIM user list: %w", err)
+	}
+	for _, user := range listResp.Resources {
+		if strings.EqualFold(user.UserName, email) {
+			return &user, nil
+		}
+	}
+
+	return nil, fmt.Errorf("cannot find SCIM user with email %s: %w", email, ErrNotFound)
+}
+
+// auditLogsPageSize is the number of entries requested per page when listing the audit log.
+const auditLogsPageSize = 100
+
+// ListAuditLogs returns the audit log entries of the organization that match query, oldest first,
+// following pagination. The filters are sent to the server and applied again to the response, so
+// instances that ignore some of them still return matching entries only. Instances without the
+// audit log API answer 404, reported as ErrAuditLogUnavailable.
+func (c *organizationClientImpl) ListAuditLogs(ctx context.Context, query *AuditLogQuery) ([]AuditLog, error) {
+	params := url.Values{}
+	if query.Actor != "" {
+		params.Set("actor", query.Actor)
+	}
+	if query.Action != "" {
+		params.Set("action", query.Action)
+	}
+	if !query.From.IsZero() {
+		params.Set("fromTimestamp", query.From.UTC().Format(time.RFC3339))
+	}
+	if !query.To.IsZero() {
+		params.Set("toTimestamp", query.To.UTC().Format(time.RFC3339))
+	}
+	params.Set("limit", fmt.Sprint(auditLogsPageSize))
+
+	var entries []AuditLog
+	for page := 1; ; page++ {
+		params.Set("page", fmt.Sprint(page))
+		resp, err := c.makeRequest(ctx, http.MethodGet, "api/public/organizations/audit-logs?"+params.Encode(), nil)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode == http.StatusNotFound {
+			resp.Body.Close()
+			return nil, ErrAuditLogUnavailable
+		}
+
+		var listResp listAuditLogsResponse
+		if err := decodeResponse(resp, &listResp); err != nil {
+			return nil, err
+		}
+		for _, entry := range listResp.Data {
+			if query.Matches(entry) {
+				entries = append(entries, entry)
+			}
+		}
+
+		meta := listResp.Meta
+		if meta == nil || page >= meta.TotalPages || len(listResp.Data) == 0 {
+			sort.SliceStable(entries, func(i, j int) bool { return entries[i].CreatedAt.Before(entries[j].CreatedAt) })
+			return entries, nil
+		}
+	}
+}
+
 func (c *organizationClientImpl) makeRequest(ctx context.Context, methodType, apiPath string, body any) (*http.Response, error) {
 	req, err := buildBaseRequest(ctx, methodType, buildURL(c.host, apiPath), body)
 	if err != nil {
@@ -376,6 +820,9 @@ func (c *organizationClientImpl) makeRequest(ctx context.Context, methodType, ap
 	if err != nil {
 		return nil, fmt.Errorf("failed to make request: %w", err)
 	}
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		return nil, newAuthError(resp, CredentialTypeOrganizationKey)
+	}
 
 	return resp, nil
 }
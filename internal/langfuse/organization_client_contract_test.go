@@ -0,0 +1,96 @@
+package langfuse
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestOrganizationClientContract(t *testing.T) {
+	host, httpClient := newCassette(t, "organization_client")
+	client := &organizationClientImpl{
+		host:       host,
+		publicKey:  cassetteEnv("LANGFUSE_ORG_PUBLIC_KEY", "pk-lf-org"),
+		privateKey: cassetteEnv("LANGFUSE_ORG_SECRET_KEY", "sk-lf-org"),
+		httpClient: httpClient,
+	}
+	ctx := context.Background()
+
+	project, err := client.CreateProject(ctx, &CreateProjectRequest{
+		Name:     "contract-test",
+		Metadata: map[string]string{"suite": "contract"},
+	})
+	if err != nil {
+		t.Fatalf("CreateProject: %v", err)
+	}
+
+	// Projects are read through the organization listing, which does not include retentionDays.
+	readProject, err := client.GetProject(ctx, project.ID)
+	if err != nil {
+		t.Fatalf("GetProject: %v", err)
+	}
+	if readProject.Name != "contract-test" || readProject.RetentionDays != nil {
+		t.Errorf("unexpected project: %+v", readProject)
+	}
+
+	apiKey, err := client.CreateProjectApiKey(ctx, project.ID)
+	if err != nil {
+		t.Fatalf("CreateProjectApiKey: %v", err)
+	}
+	apiKeys, err := client.ListProjectApiKeys(ctx, project.ID)
+	if err != nil {
+		t.Fatalf("ListProjectApiKeys: %v", err)
+	}
+	if len(apiKeys) != 1 || apiKeys[0].ID != apiKey.ID || apiKeys[0].SecretKey != "" {
+		t.Errorf("unexpected listed keys: %+v", apiKeys)
+	}
+	if err := client.DeleteProjectApiKey(ctx, project.ID, apiKey.ID); err != nil {
+		t.Fatalf("DeleteProjectApiKey: %v", err)
+	}
+
+	scimRequest := &SCIMUserRequest{UserName: "contract-test@example.com", Active: true}
+	scimRequest.Emails = append(scimRequest.Emails, struct {
+		Value   string `json:"value"`
+		Primary bool   `json:"primary"`
+	}{Value: "contract-test@example.com", Primary: true})
+	user, err := client.CreateSCIMUser(ctx, scimRequest)
+	if err != nil {
+		t.Fatalf("CreateSCIMUser: %v", err)
+	}
+
+	// SCIM-created users join the organization without a role.
+	membership, err := client.GetMembership(ctx, user.ID)
+	if err != nil {
+		t.Fatalf("GetMembership: %v", err)
+	}
+	if membership.Role != "NONE" {
+		t.Errorf("expected a SCIM-created user to have role NONE, got %q", membership.Role)
+	}
+
+	updated, err := client.UpdateMembership(ctx, user.ID, &UpdateMembershipRequest{Role: "VIEWER"})
+	if err != nil {
+		t.Fatalf("UpdateMembership: %v", err)
+	}
+	if updated.Role != "VIEWER" {
+		t.Errorf("unexpected updated membership: %+v", updated)
+	}
+
+	// The API reports success=false together with a "deleted" message; the client treats that as success.
+	if err := client.RemoveMember(ctx, user.ID); err != nil {
+		t.Fatalf("RemoveMember: %v", err)
+	}
+
+	if err := client.DeleteProject(ctx, project.ID); err != nil {
+		t.Fatalf("DeleteProject: %v", err)
+	}
+
+	client.privateKey = "sk-lf-invalid"
+	_, err = client.ListProjects(ctx)
+	var authErr *AuthError
+	if !errors.As(err, &authErr) || !authErr.IsAuthenticationFailure() {
+		t.Fatalf("expected an authentication failure for an invalid key, got %v", err)
+	}
+	if authErr.RequestID == "" {
+		t.Errorf("expected the error to carry the request ID, got %q", authErr.Error())
+	}
+}
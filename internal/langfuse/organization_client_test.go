@@ -0,0 +1,369 @@
+package langfuse
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// projectApiKeysServer serves count keys of project proj-1, paginated with the requested limit.
+// Unless byID is set, the by-ID endpoint answers 405 like instances that only support DELETE there.
+func projectApiKeysServer(t *testing.T, count int, byID bool) *httptest.Server {
+	t.Helper()
+
+	keys := make([]ProjectApiKey, count)
+	for i := range keys {
+		keys[i] = ProjectApiKey{ID: fmt.Sprintf("key-%d", i), PublicKey: fmt.Sprintf("pk-lf-%d", i)}
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/public/projects/proj-1/apiKeys", func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		start := min((page-1)*limit, len(keys))
+		end := min(start+limit, len(keys))
+		totalPages := (len(keys) + limit - 1) / limit
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"apiKeys":%s,"meta":{"page":%d,"limit":%d,"totalItems":%d,"totalPages":%d}}`,
+			mustJSON(t, keys[start:end]), page, limit, len(keys), totalPages)
+	})
+	mux.HandleFunc("GET /api/public/projects/proj-1/apiKeys/{apiKeyID}", func(w http.ResponseWriter, r *http.Request) {
+		if !byID {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		for _, key := range keys {
+			if key.ID == r.PathValue("apiKeyID") {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(mustJSON(t, key)))
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestListProjectApiKeysFollowsPagination(t *testing.T) {
+	server := projectApiKeysServer(t, 2*projectApiKeysPageSize+5, false)
+	client := NewOrganizationClient(server.URL, "pk-org", "sk-org")
+
+	apiKeys, err := client.ListProjectApiKeys(context.Background(), "proj-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(apiKeys) != 2*projectApiKeysPageSize+5 {
+		t.Fatalf("expected %d keys, got %d", 2*projectApiKeysPageSize+5, len(apiKeys))
+	}
+	if last := apiKeys[len(apiKeys)-1].ID; last != fmt.Sprintf("key-%d", 2*projectApiKeysPageSize+4) {
+		t.Errorf("unexpected last key: %s", last)
+	}
+}
+
+func TestGetProjectApiKey(t *testing.T) {
+	testCases := []struct {
+		name     string
+		byID     bool
+		apiKeyID string
+		notFound bool
+	}{
+		{name: "by ID", byID: true, apiKeyID: "key-150"},
+		{name: "by ID, missing", byID: true, apiKeyID: "key-999", notFound: true},
+		{name: "listing beyond the first page", apiKeyID: "key-150"},
+		{name: "listing, missing", apiKeyID: "key-999", notFound: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			server := projectApiKeysServer(t, 2*projectApiKeysPageSize, tc.byID)
+			client := NewOrganizationClient(server.URL, "pk-org", "sk-org")
+
+			apiKey, err := client.GetProjectApiKey(context.Background(), "proj-1", tc.apiKeyID)
+			if tc.notFound {
+				if !errors.Is(err, ErrNotFound) {
+					t.Fatalf("expected ErrNotFound, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if apiKey.ID != tc.apiKeyID {
+				t.Errorf("unexpected key: %+v", apiKey)
+			}
+		})
+	}
+}
+
+func TestListAuditLogs(t *testing.T) {
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	entries := []AuditLog{
+		{ID: "log-3", CreatedAt: start.Add(3 * time.Hour), UserID: "user-1", Action: "delete"},
+		{ID: "log-1", CreatedAt: start.Add(time.Hour), UserID: "user-1", Action: "create"},
+		{ID: "log-2", CreatedAt: start.Add(2 * time.Hour), ApiKeyID: "key-1", Action: "delete"},
+	}
+
+	var queries []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/public/organizations/audit-logs", func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		// The first page holds two entries; filters are ignored, like on instances that do not support them.
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		data := entries[:2]
+		if page == 2 {
+			data = entries[2:]
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"data":%s,"meta":{"page":%d,"limit":2,"totalItems":3,"totalPages":2}}`, mustJSON(t, data), page)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	client := NewOrganizationClient(server.URL, "pk-org", "sk-org")
+
+	logs, err := client.ListAuditLogs(context.Background(), &AuditLogQuery{Action: "delete", From: start.Add(2 * time.Hour)})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(logs) != 2 || logs[0].ID != "log-2" || logs[1].ID != "log-3" {
+		t.Errorf("unexpected entries: %+v", logs)
+	}
+	if len(queries) != 2 || queries[0] != "action=delete&fromTimestamp=2026-03-01T02%3A00%3A00Z&limit=100&page=1" {
+		t.Errorf("unexpected queries: %v", queries)
+	}
+}
+
+func TestListAuditLogsUnavailable(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	t.Cleanup(server.Close)
+	client := NewOrganizationClient(server.URL, "pk-org", "sk-org")
+
+	if _, err := client.ListAuditLogs(context.Background(), &AuditLogQuery{}); !errors.Is(err, ErrAuditLogUnavailable) {
+		t.Fatalf("expected ErrAuditLogUnavailable, got %v", err)
+	}
+}
+
+func TestQueryMemberships(t *testing.T) {
+	pages := [][]OrganizationMembership{
+		{{UserID: "user-3", Email: "carol@example.com", Role: "OWNER"}, {UserID: "user-1", Email: "bob@example.com", Role: "MEMBER"}},
+		// user-3 shifted to the second page while listing.
+		{{UserID: "user-2", Email: "alice@example.com", Role: "ADMIN"}, {UserID: "user-3", Email: "carol@example.com", Role: "OWNER"}},
+	}
+
+	var queries []string
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/public/organizations/memberships", func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		// Filters are ignored, like on instances that do not support them.
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"memberships":%s,"meta":{"page":%d,"limit":2,"totalItems":4,"totalPages":2}}`, mustJSON(t, pages[page-1]), page)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	client := NewOrganizationClient(server.URL, "pk-org", "sk-org")
+
+	memberships, err := client.QueryMemberships(context.Background(), &MembershipQuery{Roles: []string{"OWNER", "ADMIN"}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(memberships) != 2 || memberships[0].UserID != "user-2" || memberships[1].UserID != "user-3" {
+		t.Errorf("unexpected memberships: %+v", memberships)
+	}
+	if len(queries) != 2 || queries[0] != "limit=100&page=1&role=OWNER&role=ADMIN" {
+		t.Errorf("unexpected queries: %v", queries)
+	}
+}
+
+// membershipsServer serves 20 memberships user-0 … user-19 and records the listings and role
+// changes it receives.
+type membershipsServer struct {
+	mu          sync.Mutex
+	lists       int
+	inFlight    int
+	maxInFlight int
+	roles       map[string]string
+}
+
+func newMembershipsServer(t *testing.T) (*membershipsServer, *httptest.Server) {
+	s := &membershipsServer{roles: make(map[string]string)}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/public/organizations/memberships", func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.lists++
+		s.mu.Unlock()
+		memberships := make([]OrganizationMembership, 20)
+		for i := range memberships {
+			memberships[i] = OrganizationMembership{UserID: fmt.Sprintf("user-%d", i), Email: fmt.Sprintf("user-%d@example.com", i), Role: "VIEWER"}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"memberships":%s}`, mustJSON(t, memberships))
+	})
+	mux.HandleFunc("PUT /api/public/organizations/memberships", func(w http.ResponseWriter, r *http.Request) {
+		var request UpdateMembershipRequest
+		_ = json.NewDecoder(r.Body).Decode(&request)
+		s.mu.Lock()
+		s.inFlight++
+		s.maxInFlight = max(s.maxInFlight, s.inFlight)
+		s.roles[request.UserID] = request.Role
+		s.mu.Unlock()
+		time.Sleep(5 * time.Millisecond)
+		s.mu.Lock()
+		s.inFlight--
+		s.mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(mustJSON(t, OrganizationMembership{UserID: request.UserID, Role: request.Role})))
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return s, server
+}
+
+func TestUpdateMemberships(t *testing.T) {
+	s, server := newMembershipsServer(t)
+	client := NewOrganizationClient(server.URL, "pk-org", "sk-org")
+
+	requests := []UpdateMembershipRequest{{Email: "USER-0@example.com", Role: "ADMIN"}, {Email: "user-404@example.com", Role: "ADMIN"}}
+	for i := 1; i < 20; i++ {
+		requests = append(requests, UpdateMembershipRequest{UserID: fmt.Sprintf("user-%d", i), Role: "MEMBER"})
+	}
+
+	updated, err := client.UpdateMemberships(context.Background(), requests)
+	if !errors.Is(err, ErrNotFound) || !strings.Contains(err.Error(), "user-404") {
+		t.Errorf("expected a not found error for user-404, got %v", err)
+	}
+	if len(updated) != 20 || updated[0].UserID != "user-0" || updated[19].UserID != "user-19" {
+		t.Errorf("unexpected updated memberships: %+v", updated)
+	}
+	if s.roles["user-0"] != "ADMIN" || s.roles["user-19"] != "MEMBER" || len(s.roles) != 20 {
+		t.Errorf("unexpected roles: %v", s.roles)
+	}
+	if s.lists != 1 {
+		t.Errorf("expected a single membership listing, got %d", s.lists)
+	}
+	if s.maxInFlight > membershipUpdateConcurrency {
+		t.Errorf("expected at most %d concurrent updates, got %d", membershipUpdateConcurrency, s.maxInFlight)
+	}
+
+	// Changes that all name the member by user ID need no listing.
+	if _, err := client.UpdateMemberships(context.Background(), requests[2:]); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if s.lists != 1 {
+		t.Errorf("expected no further listing, got %d", s.lists)
+	}
+}
+
+func TestQueueMembershipUpdate(t *testing.T) {
+	s, server := newMembershipsServer(t)
+	client := NewOrganizationClient(server.URL, "pk-org", "sk-org")
+
+	var wg sync.WaitGroup
+	errs := make([]error, 20)
+	for i := range errs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var membership *OrganizationMembership
+			membership, errs[i] = client.QueueMembershipUpdate(context.Background(), UpdateMembershipRequest{Email: fmt.Sprintf("user-%d@example.com", i), Role: "ADMIN"})
+			if errs[i] == nil && membership.UserID != fmt.Sprintf("user-%d", i) {
+				errs[i] = fmt.Errorf("unexpected membership %+v", membership)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(s.roles) != 20 {
+		t.Errorf("expected 20 role changes, got %v", s.roles)
+	}
+	if s.lists != 1 {
+		t.Errorf("expected the queued changes to share one membership listing, got %d", s.lists)
+	}
+
+	_, err := client.QueueMembershipUpdate(context.Background(), UpdateMembershipRequest{Email: "user-404@example.com", Role: "ADMIN"})
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
+
+func mustJSON(t *testing.T, v any) string {
+	t.Helper()
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("failed to marshal %T: %v", v, err)
+	}
+	return string(data)
+}
+
+func TestCreateProjectFieldErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"message":"Invalid request data","error":[` +
+			`{"code":"too_small","minimum":3,"path":["retention"],"message":"Number must be greater than or equal to 3"},` +
+			`{"code":"invalid_type","path":["metadata","env"],"message":"Expected string, received number"}]}`))
+	}))
+	t.Cleanup(server.Close)
+	client := NewOrganizationClient(server.URL, "pk-org", "sk-org")
+
+	_, err := client.CreateProject(context.Background(), &CreateProjectRequest{Name: "checkout", RetentionDays: 1})
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected an APIError, got %v", err)
+	}
+	fieldErrors := apiErr.FieldErrors()
+	if len(fieldErrors) != 2 || fieldErrors[0] != (FieldError{Field: "retention", Message: "Number must be greater than or equal to 3"}) || fieldErrors[1].Field != "metadata.env" {
+		t.Errorf("unexpected field errors: %+v", fieldErrors)
+	}
+
+	if fieldErrors := (&APIError{StatusCode: http.StatusBadRequest, Body: "Bad Request"}).FieldErrors(); fieldErrors != nil {
+		t.Errorf("expected no field errors for a plain text body, got %+v", fieldErrors)
+	}
+}
+
+func TestUpdateProjectRequestOmitsUnchangedFields(t *testing.T) {
+	retention := int32(0)
+	testCases := []struct {
+		request UpdateProjectRequest
+		want    string
+	}{
+		{request: UpdateProjectRequest{Name: "checkout"}, want: `{"name":"checkout"}`},
+		{request: UpdateProjectRequest{Name: "checkout", RetentionDays: &retention}, want: `{"name":"checkout","retention":0}`},
+		{request: UpdateProjectRequest{Name: "checkout", Metadata: map[string]string{}}, want: `{"name":"checkout","metadata":{}}`},
+	}
+	for _, tc := range testCases {
+		if got := mustJSON(t, tc.request); got != tc.want {
+			t.Errorf("expected %s, got %s", tc.want, got)
+		}
+	}
+}
+
+func TestUpdateOrganizationRequestOmitsUnchangedMetadata(t *testing.T) {
+	testCases := []struct {
+		request UpdateOrganizationRequest
+		want    string
+	}{
+		{request: UpdateOrganizationRequest{Name: "Acme"}, want: `{"name":"Acme"}`},
+		{request: UpdateOrganizationRequest{Name: "Acme", Metadata: map[string]string{}}, want: `{"name":"Acme","metadata":{}}`},
+	}
+	for _, tc := range testCases {
+		if got := mustJSON(t, tc.request); got != tc.want {
+			t.Errorf("expected %s, got %s", tc.want, got)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package langfuse
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// ErrDuplicateProjectApiKey is wrapped by the errors of ProjectApiKeyRegistry.Claim for a created key
+// whose ID or public key already belongs to another key of the project.
+var ErrDuplicateProjectApiKey = errors.New("duplicate project API key")
+
+// ProjectApiKeyRegistry tracks the API keys of the projects keys are created for, so that a key
+// Langfuse returns can be checked against the project's other keys without listing them after every
+// creation. A project's keys are listed once, before the first key of the run is created for it;
+// the keys created afterwards are claimed in the registry. It is safe for concurrent use.
+type ProjectApiKeyRegistry struct {
+	mu       sync.Mutex
+	projects map[string]*projectApiKeySet
+}
+
+// projectApiKeySet holds the IDs and public keys known to exist in one project.
+type projectApiKeySet struct {
+	mu         sync.Mutex
+	listed     bool
+	ids        map[string]bool
+	publicKeys map[string]bool
+}
+
+func (r *ProjectApiKeyRegistry) project(projectID string) *projectApiKeySet {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.projects == nil {
+		r.projects = make(map[string]*projectApiKeySet)
+	}
+	set, ok := r.projects[projectID]
+	if !ok {
+		set = &projectApiKeySet{ids: make(map[string]bool), publicKeys: make(map[string]bool)}
+		r.projects[projectID] = set
+	}
+	return set
+}
+
+// Prepare lists the keys of a project with list unless they were listed before. It is called
+// before a key is created, so the listing never contains keys created in the run; a failed listing
+// is not remembered and the next caller lists again.
+func (r *ProjectApiKeyRegistry) Prepare(ctx context.Context, projectID string, list func(ctx context.Context, projectID string) ([]ProjectApiKey, error)) error {
+	set := r.project(projectID)
+	set.mu.Lock()
+	defer set.mu.Unlock()
+	if set.listed {
+		return nil
+	}
+
+	apiKeys, err := list(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	for _, apiKey := range apiKeys {
+		set.ids[apiKey.ID] = true
+		set.publicKeys[apiKey.PublicKey] = true
+	}
+	set.listed = true
+	return nil
+}
+
+// Claim records a key created for a project. It fails with an error wrapping
+// ErrDuplicateProjectApiKey when the key's ID or public key is already known, either from the
+// listing or from another claim; sameID then reports whether the ID itself was taken.
+func (r *ProjectApiKeyRegistry) Claim(projectID string, apiKey *ProjectApiKey) (sameID bool, err error) {
+	set := r.project(projectID)
+	set.mu.Lock()
+	defer set.mu.Unlock()
+
+	if set.ids[apiKey.ID] || set.publicKeys[apiKey.PublicKey] {
+		return set.ids[apiKey.ID], fmt.Errorf("key %s (%s) of project %s: %w", apiKey.ID, apiKey.PublicKey, projectID, ErrDuplicateProjectApiKey)
+	}
+	set.ids[apiKey.ID] = true
+	set.publicKeys[apiKey.PublicKey] = true
+	return false, nil
+}
+
+// Release forgets a key that was deleted.
+func (r *ProjectApiKeyRegistry) Release(projectID string, apiKey *ProjectApiKey) {
+	set := r.project(projectID)
+	set.mu.Lock()
+	defer set.mu.Unlock()
+
+	delete(set.ids, apiKey.ID)
+	delete(set.publicKeys, apiKey.PublicKey)
+}
@@ -0,0 +1,54 @@
+package langfuse
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestProjectApiKeyRegistry(t *testing.T) {
+	ctx := context.Background()
+	var registry ProjectApiKeyRegistry
+
+	var listings int
+	listErr := errors.New("connection reset")
+	list := func(ctx context.Context, projectID string) ([]ProjectApiKey, error) {
+		listings++
+		if listErr != nil {
+			return nil, listErr
+		}
+		return []ProjectApiKey{{ID: "pak-existing", PublicKey: "pk-existing"}}, nil
+	}
+
+	if err := registry.Prepare(ctx, "proj-1", list); !errors.Is(err, listErr) {
+		t.Fatalf("expected the listing error, got %v", err)
+	}
+	listErr = nil
+	for range 3 {
+		if err := registry.Prepare(ctx, "proj-1", list); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if listings != 2 {
+		t.Errorf("expected a failed listing to be repeated and a successful one to be reused, got %d listings", listings)
+	}
+
+	created := &ProjectApiKey{ID: "pak-1", PublicKey: "pk-1"}
+	if _, err := registry.Claim("proj-1", created); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sameID, err := registry.Claim("proj-1", created); !errors.Is(err, ErrDuplicateProjectApiKey) || !sameID {
+		t.Errorf("expected a duplicate ID, got sameID=%t err=%v", sameID, err)
+	}
+	if sameID, err := registry.Claim("proj-1", &ProjectApiKey{ID: "pak-2", PublicKey: "pk-existing"}); !errors.Is(err, ErrDuplicateProjectApiKey) || sameID {
+		t.Errorf("expected a duplicate public key, got sameID=%t err=%v", sameID, err)
+	}
+	if _, err := registry.Claim("proj-2", created); err != nil {
+		t.Errorf("expected keys of other projects to be tracked separately, got %v", err)
+	}
+
+	registry.Release("proj-1", created)
+	if _, err := registry.Claim("proj-1", created); err != nil {
+		t.Errorf("expected a released key to be claimable again, got %v", err)
+	}
+}
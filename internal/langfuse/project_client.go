@@ -0,0 +1,435 @@
+package langfuse
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// IngestionEvent is a single event of an ingestion batch.
+type IngestionEvent struct {
+	ID        string    `json:"id"`
+	Type      string    `json:"type"`
+	Timestamp time.Time `json:"timestamp"`
+	Body      any       `json:"body"`
+}
+
+// IngestionTrace is the body of a trace-create ingestion event.
+type IngestionTrace struct {
+	ID        string            `json:"id"`
+	Name      string            `json:"name,omitempty"`
+	Timestamp time.Time         `json:"timestamp"`
+	Metadata  map[string]string `json:"metadata,omitempty"`
+	Tags      []string          `json:"tags,omitempty"`
+	// Environment defaults to "default" on the server. Langfuse has no API to declare environments;
+	// an environment exists once data has been ingested for it.
+	Environment string `json:"environment,omitempty"`
+}
+
+type ingestionRequest struct {
+	Batch []IngestionEvent `json:"batch"`
+}
+
+// IngestionResult is the outcome of a single event of an ingestion batch.
+type IngestionResult struct {
+	ID      string `json:"id"`
+	Status  int    `json:"status"`
+	Message string `json:"message,omitempty"`
+	Error   any    `json:"error,omitempty"`
+}
+
+// IngestionResponse reports which events of an ingestion batch were accepted.
+type IngestionResponse struct {
+	Successes []IngestionResult `json:"successes"`
+	Errors    []IngestionResult `json:"errors"`
+}
+
+// Trace is a trace as returned by the public traces API.
+type Trace struct {
+	ID          string     `json:"id"`
+	Name        string     `json:"name,omitempty"`
+	Timestamp   *time.Time `json:"timestamp,omitempty"`
+	ProjectID   string     `json:"projectId,omitempty"`
+	Environment string     `json:"environment,omitempty"`
+}
+
+// Score data types accepted by the scores API.
+const (
+	ScoreDataTypeNumeric     = "NUMERIC"
+	ScoreDataTypeCategorical = "CATEGORICAL"
+	ScoreDataTypeBoolean     = "BOOLEAN"
+)
+
+// CreateScoreRequest creates a score. Value is a number for numeric and boolean scores and a
+// string for categorical ones.
+type CreateScoreRequest struct {
+	ID            string `json:"id,omitempty"`
+	Name          string `json:"name"`
+	Value         any    `json:"value"`
+	DataType      string `json:"dataType,omitempty"`
+	TraceID       string `json:"traceId,omitempty"`
+	SessionID     string `json:"sessionId,omitempty"`
+	ObservationID string `json:"observationId,omitempty"`
+	Comment       string `json:"comment,omitempty"`
+	ConfigID      string `json:"configId,omitempty"`
+}
+
+type createScoreResponse struct {
+	ID string `json:"id"`
+}
+
+// Score is a score as returned by the public scores API.
+type Score struct {
+	ID            string   `json:"id"`
+	Name          string   `json:"name"`
+	Value         *float64 `json:"value,omitempty"`
+	StringValue   string   `json:"stringValue,omitempty"`
+	DataType      string   `json:"dataType"`
+	Source        string   `json:"source,omitempty"`
+	TraceID       string   `json:"traceId,omitempty"`
+	SessionID     string   `json:"sessionId,omitempty"`
+	ObservationID string   `json:"observationId,omitempty"`
+	Comment       string   `json:"comment,omitempty"`
+	ConfigID      string   `json:"configId,omitempty"`
+}
+
+// PromptLabelLatest is the label Langfuse keeps on the newest version of every prompt. It cannot be set or removed.
+const PromptLabelLatest = "latest"
+
+// Prompt is a prompt version as returned by the public prompts API. Prompt holds a string for text
+// prompts and a list of messages for chat prompts.
+type Prompt struct {
+	Name          string   `json:"name"`
+	Version       int      `json:"version"`
+	Type          string   `json:"type,omitempty"`
+	Prompt        any      `json:"prompt,omitempty"`
+	Config        any      `json:"config,omitempty"`
+	Labels        []string `json:"labels"`
+	Tags          []string `json:"tags"`
+	CommitMessage string   `json:"commitMessage,omitempty"`
+}
+
+// Types of prompts.
+const (
+	PromptTypeText = "text"
+	PromptTypeChat = "chat"
+)
+
+// PromptMessage is a message of a chat prompt.
+type PromptMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// Messages returns the messages of a chat prompt.
+func (p *Prompt) Messages() ([]PromptMessage, error) {
+	encoded, err := json.Marshal(p.Prompt)
+	if err != nil {
+		return nil, err
+	}
+	var messages []PromptMessage
+	if err := json.Unmarshal(encoded, &messages); err != nil {
+		return nil, fmt.Errorf("prompt %s version %d is not a list of chat messages: %w", p.Name, p.Version, err)
+	}
+	return messages, nil
+}
+
+// CreatePromptRequest creates a version of a prompt, and the prompt itself with its first version.
+// Prompt holds a string for text prompts and a []PromptMessage for chat prompts. Tags apply to
+// every version of the prompt.
+type CreatePromptRequest struct {
+	Name          string   `json:"name"`
+	Type          string   `json:"type"`
+	Prompt        any      `json:"prompt"`
+	Config        any      `json:"config,omitempty"`
+	Labels        []string `json:"labels"`
+	Tags          []string `json:"tags"`
+	CommitMessage string   `json:"commitMessage,omitempty"`
+}
+
+type updatePromptLabelsRequest struct {
+	NewLabels []string `json:"newLabels"`
+}
+
+// Views of the metrics API.
+const (
+	MetricsViewTraces       = "traces"
+	MetricsViewObservations = "observations"
+)
+
+// MetricsQuery is a query of the metrics API: the metrics of a view over a time range, grouped by
+// the dimensions.
+type MetricsQuery struct {
+	View          string             `json:"view"`
+	Dimensions    []MetricsDimension `json:"dimensions"`
+	Metrics       []MetricsMetric    `json:"metrics"`
+	Filters       []any              `json:"filters"`
+	FromTimestamp time.Time          `json:"fromTimestamp"`
+	ToTimestamp   time.Time          `json:"toTimestamp"`
+}
+
+// MetricsDimension is a field the metrics are grouped by, e.g. environment.
+type MetricsDimension struct {
+	Field string `json:"field"`
+}
+
+// MetricsMetric is an aggregation of a measure. Its value is returned under the key
+// <aggregation>_<measure>, e.g. count_count.
+type MetricsMetric struct {
+	Measure     string `json:"measure"`
+	Aggregation string `json:"aggregation"`
+}
+
+// MetricsResponse holds one row per combination of dimension values.
+type MetricsResponse struct {
+	Data []map[string]any `json:"data"`
+}
+
+//go:generate mockgen -destination=./mocks/mock_project_client.go -package=mocks github.com/langfuse/terraform-provider-langfuse/internal/langfuse ProjectClient
+
+// ProjectClient calls project-scoped endpoints, authenticated with a project API key pair.
+type ProjectClient interface {
+	CreateTrace(ctx context.Context, trace *IngestionTrace) error
+	GetTrace(ctx context.Context, traceID string) (*Trace, error)
+	CreateScore(ctx context.Context, request *CreateScoreRequest) (string, error)
+	GetScore(ctx context.Context, scoreID string) (*Score, error)
+	DeleteScore(ctx context.Context, scoreID string) error
+	GetPromptVersion(ctx context.Context, name string, version int) (*Prompt, error)
+	GetPromptByLabel(ctx context.Context, name, label string) (*Prompt, error)
+	CreatePrompt(ctx context.Context, request *CreatePromptRequest) (*Prompt, error)
+	UpdatePromptLabels(ctx context.Context, name string, version int, labels []string) (*Prompt, error)
+	DeletePrompt(ctx context.Context, name string) error
+	QueryMetrics(ctx context.Context, query *MetricsQuery) (*MetricsResponse, error)
+}
+
+type projectClientImpl struct {
+	host       string
+	publicKey  string
+	secretKey  string
+	httpClient *http.Client
+	// apiVersion is the API version negotiated by the factory's clients; nil for standalone clients.
+	apiVersion *apiVersionTracker
+}
+
+// CreateTrace sends a trace-create event through the ingestion API. Ingestion is asynchronous:
+// an accepted event is processed later, so the trace may not be readable right away.
+func (c *projectClientImpl) CreateTrace(ctx context.Context, trace *IngestionTrace) error {
+	request := &ingestionRequest{
+		Batch: []IngestionEvent{{
+			ID:        trace.ID,
+			Type:      "trace-create",
+			Timestamp: trace.Timestamp,
+			Body:      trace,
+		}},
+	}
+
+	resp, err := c.makeRequest(ctx, http.MethodPost, "api/public/ingestion", request)
+	if err != nil {
+		return err
+	}
+
+	var ingestionResp IngestionResponse
+	if err := decodeResponse(resp, &ingestionResp); err != nil {
+		return err
+	}
+	if len(ingestionResp.Errors) > 0 {
+		result := ingestionResp.Errors[0]
+		return fmt.Errorf("ingestion of trace %s failed with status %d: %s", trace.ID, result.Status, result.Message)
+	}
+
+	return nil
+}
+
+// GetTrace returns the trace with the given ID. An error wrapping ErrNotFound is returned while the
+// trace does not exist, which includes the time until an ingested trace has been processed.
+func (c *projectClientImpl) GetTrace(ctx context.Context, traceID string) (*Trace, error) {
+	resp, err := c.makeRequest(ctx, http.MethodGet, fmt.Sprintf("api/public/traces/%s", traceID), nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, fmt.Errorf("cannot find trace %s: %w", traceID, ErrNotFound)
+	}
+
+	var trace Trace
+	if err := decodeResponse(resp, &trace); err != nil {
+		return nil, err
+	}
+
+	return &trace, nil
+}
+
+// CreateScore creates a score and returns its ID.
+func (c *projectClientImpl) CreateScore(ctx context.Context, request *CreateScoreRequest) (string, error) {
+	resp, err := c.makeRequest(ctx, http.MethodPost, "api/public/scores", request)
+	if err != nil {
+		return "", err
+	}
+
+	var createResp createScoreResponse
+	if err := decodeResponse(resp, &createResp); err != nil {
+		return "", err
+	}
+
+	return createResp.ID, nil
+}
+
+// GetScore returns the score with the given ID, or an error wrapping ErrNotFound if it does not exist.
+func (c *projectClientImpl) GetScore(ctx context.Context, scoreID string) (*Score, error) {
+	resp, err := c.makeRequest(ctx, http.MethodGet, fmt.Sprintf("api/public/scores/%s", scoreID), nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, fmt.Errorf("cannot find score %s: %w", scoreID, ErrNotFound)
+	}
+
+	var score Score
+	if err := decodeResponse(resp, &score); err != nil {
+		return nil, err
+	}
+
+	return &score, nil
+}
+
+// DeleteScore deletes the score with the given ID. A score that no longer exists is not an error.
+func (c *projectClientImpl) DeleteScore(ctx context.Context, scoreID string) error {
+	resp, err := c.makeRequest(ctx, http.MethodDelete, fmt.Sprintf("api/public/scores/%s", scoreID), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusNotFound {
+		body, _ := io.ReadAll(resp.Body)
+		return newAPIError(resp, body)
+	}
+
+	return nil
+}
+
+// GetPromptVersion returns a version of a prompt, or an error wrapping ErrNotFound if the prompt
+// or the version does not exist.
+func (c *projectClientImpl) GetPromptVersion(ctx context.Context, name string, version int) (*Prompt, error) {
+	return c.getPrompt(ctx, name, url.Values{"version": {strconv.Itoa(version)}})
+}
+
+// GetPromptByLabel returns the version of a prompt that holds label, or an error wrapping
+// ErrNotFound if the prompt does not exist or no version holds the label.
+func (c *projectClientImpl) GetPromptByLabel(ctx context.Context, name, label string) (*Prompt, error) {
+	return c.getPrompt(ctx, name, url.Values{"label": {label}})
+}
+
+func (c *projectClientImpl) getPrompt(ctx context.Context, name string, query url.Values) (*Prompt, error) {
+	resp, err := c.makeRequest(ctx, http.MethodGet, fmt.Sprintf("api/public/v2/prompts/%s?%s", url.PathEscape(name), query.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, fmt.Errorf("cannot find prompt %s (%s): %w", name, query.Encode(), ErrNotFound)
+	}
+
+	var prompt Prompt
+	if err := decodeResponse(resp, &prompt); err != nil {
+		return nil, err
+	}
+
+	return &prompt, nil
+}
+
+// UpdatePromptLabels sets the labels of a prompt version. Labels are unique across the versions of
+// a prompt: Langfuse removes each of them from the version that held it before. The latest label is
+// managed by Langfuse and must not be passed.
+func (c *projectClientImpl) UpdatePromptLabels(ctx context.Context, name string, version int, labels []string) (*Prompt, error) {
+	resp, err := c.makeRequest(ctx, http.MethodPatch, fmt.Sprintf("api/public/v2/prompts/%s/versions/%d", url.PathEscape(name), version),
+		&updatePromptLabelsRequest{NewLabels: labels})
+	if err != nil {
+		return nil, err
+	}
+
+	var prompt Prompt
+	if err := decodeResponse(resp, &prompt); err != nil {
+		return nil, err
+	}
+
+	return &prompt, nil
+}
+
+// CreatePrompt creates a new version of a prompt. Versions are immutable, so every change of the
+// prompt content is a new version.
+func (c *projectClientImpl) CreatePrompt(ctx context.Context, request *CreatePromptRequest) (*Prompt, error) {
+	resp, err := c.makeRequest(ctx, http.MethodPost, "api/public/v2/prompts", request)
+	if err != nil {
+		return nil, err
+	}
+
+	var prompt Prompt
+	if err := decodeResponse(resp, &prompt); err != nil {
+		return nil, err
+	}
+
+	return &prompt, nil
+}
+
+// DeletePrompt deletes a prompt with all its versions. A prompt that no longer exists is not an error.
+func (c *projectClientImpl) DeletePrompt(ctx context.Context, name string) error {
+	resp, err := c.makeRequest(ctx, http.MethodDelete, fmt.Sprintf("api/public/v2/prompts/%s", url.PathEscape(name)), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusNotFound {
+		body, _ := io.ReadAll(resp.Body)
+		return newAPIError(resp, body)
+	}
+
+	return nil
+}
+
+// QueryMetrics runs a query against the metrics API. Aggregates are computed by Langfuse, so large
+// projects are counted without listing their traces.
+func (c *projectClientImpl) QueryMetrics(ctx context.Context, query *MetricsQuery) (*MetricsResponse, error) {
+	encoded, err := json.Marshal(query)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.makeRequest(ctx, http.MethodGet, "api/public/metrics?"+url.Values{"query": {string(encoded)}}.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var metrics MetricsResponse
+	if err := decodeResponse(resp, &metrics); err != nil {
+		return nil, err
+	}
+
+	return &metrics, nil
+}
+
+func (c *projectClientImpl) makeRequest(ctx context.Context, methodType, apiPath string, body any) (*http.Response, error) {
+	req, err := buildBaseRequest(ctx, methodType, buildURL(c.host, apiPath), body)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(c.publicKey, c.secretKey)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make request: %w", err)
+	}
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		return nil, newAuthError(resp, CredentialTypeProjectKey)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,91 @@
+package langfuse
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+)
+
+// RateLimitWarningThreshold is the share of the rate limit above which RateLimitWarning reports usage.
+const RateLimitWarningThreshold = 0.8
+
+// RateLimitUsage is the rate limit state reported by a response.
+type RateLimitUsage struct {
+	Limit     int
+	Remaining int
+}
+
+// Used returns the share of the limit that has been used, between 0 and 1.
+func (u RateLimitUsage) Used() float64 {
+	if u.Limit <= 0 {
+		return 0
+	}
+	return float64(u.Limit-u.Remaining) / float64(u.Limit)
+}
+
+// rateLimitHeaders lists the limit and remaining headers understood, in order of preference.
+var rateLimitHeaders = [][2]string{
+	{"X-RateLimit-Limit", "X-RateLimit-Remaining"},
+	{"RateLimit-Limit", "RateLimit-Remaining"},
+}
+
+func parseRateLimitUsage(resp *http.Response) (RateLimitUsage, bool) {
+	for _, headers := range rateLimitHeaders {
+		limit, err := strconv.Atoi(resp.Header.Get(headers[0]))
+		if err != nil || limit <= 0 {
+			continue
+		}
+		remaining, err := strconv.Atoi(resp.Header.Get(headers[1]))
+		if err != nil || remaining < 0 {
+			continue
+		}
+		return RateLimitUsage{Limit: limit, Remaining: min(remaining, limit)}, true
+	}
+	return RateLimitUsage{}, false
+}
+
+// rateLimitTracker keeps the highest rate limit usage seen across all requests of a factory.
+type rateLimitTracker struct {
+	mu       sync.Mutex
+	peak     RateLimitUsage
+	reported bool
+}
+
+func (t *rateLimitTracker) observe(resp *http.Response) {
+	usage, ok := parseRateLimitUsage(resp)
+	if !ok {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if usage.Used() > t.peak.Used() {
+		t.peak = usage
+	}
+}
+
+// warning returns the peak usage the first time it is called after usage crossed
+// RateLimitWarningThreshold, so that a run reports it only once.
+func (t *rateLimitTracker) warning() (RateLimitUsage, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.reported || t.peak.Used() < RateLimitWarningThreshold {
+		return RateLimitUsage{}, false
+	}
+	t.reported = true
+	return t.peak, true
+}
+
+// rateLimitTransport records the rate limit headers of every response, including retried attempts.
+type rateLimitTransport struct {
+	next    http.RoundTripper
+	tracker *rateLimitTracker
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err == nil {
+		t.tracker.observe(resp)
+	}
+	return resp, err
+}
@@ -0,0 +1,76 @@
+package langfuse
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+func TestRateLimitWarning(t *testing.T) {
+	remaining := 900
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "1000")
+		w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(remaining))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status":"OK","version":"3.0.0"}`))
+	}))
+	defer server.Close()
+
+	cf := NewClientFactory(server.URL, "admin").(*clientFactoryImpl)
+	request := func() {
+		resp, err := cf.httpClient.Get(server.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+
+	request()
+	if _, ok := cf.RateLimitWarning(); ok {
+		t.Fatal("expected no warning at 10% usage")
+	}
+
+	remaining = 80
+	request()
+	remaining = 500
+	request()
+
+	usage, ok := cf.RateLimitWarning()
+	if !ok {
+		t.Fatal("expected a warning at 92% usage")
+	}
+	if usage.Remaining != 80 || usage.Limit != 1000 {
+		t.Errorf("expected the peak usage to be reported, got %+v", usage)
+	}
+	if _, ok := cf.RateLimitWarning(); ok {
+		t.Error("expected the warning to be reported only once")
+	}
+}
+
+func TestParseRateLimitUsage(t *testing.T) {
+	testCases := []struct {
+		name     string
+		headers  map[string]string
+		expected RateLimitUsage
+		ok       bool
+	}{
+		{name: "no headers"},
+		{name: "x-ratelimit headers", headers: map[string]string{"X-RateLimit-Limit": "100", "X-RateLimit-Remaining": "25"}, expected: RateLimitUsage{Limit: 100, Remaining: 25}, ok: true},
+		{name: "draft standard headers", headers: map[string]string{"RateLimit-Limit": "100", "RateLimit-Remaining": "0"}, expected: RateLimitUsage{Limit: 100, Remaining: 0}, ok: true},
+		{name: "invalid values", headers: map[string]string{"X-RateLimit-Limit": "100, 100;w=60", "X-RateLimit-Remaining": "25"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := &http.Response{Header: http.Header{}}
+			for name, value := range tc.headers {
+				resp.Header.Set(name, value)
+			}
+			usage, ok := parseRateLimitUsage(resp)
+			if ok != tc.ok || usage != tc.expected {
+				t.Errorf("got %+v, %t; want %+v, %t", usage, ok, tc.expected, tc.ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package langfuse
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Request attributes that header templates can reference.
+const (
+	RequestAttributeOrganizationID = "organization_id"
+	RequestAttributeProjectID      = "project_id"
+)
+
+// RequestAttributes lists the attributes header templates can reference.
+var RequestAttributes = []string{RequestAttributeOrganizationID, RequestAttributeProjectID}
+
+var headerPlaceholder = regexp.MustCompile(`\$\{([^}]*)\}`)
+
+type requestAttributesKey struct{}
+
+// WithRequestAttributes returns a context whose requests carry the given attributes, e.g. the
+// organization ID of the resource making them, in addition to those already set on ctx. Empty
+// values are ignored.
+func WithRequestAttributes(ctx context.Context, attributes map[string]string) context.Context {
+	merged := make(map[string]string)
+	if existing, ok := ctx.Value(requestAttributesKey{}).(map[string]string); ok {
+		maps.Copy(merged, existing)
+	}
+	for name, value := range attributes {
+		if value != "" {
+			merged[name] = value
+		}
+	}
+	return context.WithValue(ctx, requestAttributesKey{}, merged)
+}
+
+// ValidateHeaderTemplate checks that every ${...} placeholder of a header template names one of
+// RequestAttributes.
+func ValidateHeaderTemplate(template string) error {
+	for _, match := range headerPlaceholder.FindAllStringSubmatch(template, -1) {
+		if !slices.Contains(RequestAttributes, match[1]) {
+			return fmt.Errorf("unknown placeholder ${%s}, expected one of ${%s}", match[1], strings.Join(RequestAttributes, "}, ${"))
+		}
+	}
+	return nil
+}
+
+// headerTransport adds the configured headers to every request. Placeholders in the header values
+// are replaced with the request attributes of the request context; a header whose placeholders
+// cannot all be resolved is left out, so that e.g. a tenant header is only sent by the resources
+// that know their organization.
+type headerTransport struct {
+	next    http.RoundTripper
+	headers map[string]string
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	attributes, _ := req.Context().Value(requestAttributesKey{}).(map[string]string)
+
+	req = req.Clone(req.Context())
+	for name, template := range t.headers {
+		resolved := true
+		value := headerPlaceholder.ReplaceAllStringFunc(template, func(placeholder string) string {
+			attribute, ok := attributes[headerPlaceholder.FindStringSubmatch(placeholder)[1]]
+			resolved = resolved && ok
+			return attribute
+		})
+		if resolved {
+			req.Header.Set(name, value)
+		}
+	}
+	return t.next.RoundTrip(req)
+}
@@ -0,0 +1,62 @@
+package langfuse
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRequestHeaders(t *testing.T) {
+	t.Parallel()
+
+	var received http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Clone()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": "trace-1"}`))
+	}))
+	defer server.Close()
+
+	cf := NewClientFactory(server.URL, "", WithRequestHeaders(map[string]string{
+		"X-Org-Id":     "${organization_id}",
+		"X-Tenant":     "${organization_id}/${project_id}",
+		"X-Gateway-Id": "terraform",
+	}))
+	client := cf.NewProjectClient("pk-lf-1", "sk-lf-1")
+
+	ctx := WithRequestAttributes(context.Background(), map[string]string{RequestAttributeOrganizationID: "org-1", RequestAttributeProjectID: ""})
+	if _, err := client.GetTrace(ctx, "trace-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if received.Get("X-Org-Id") != "org-1" || received.Get("X-Gateway-Id") != "terraform" {
+		t.Errorf("unexpected headers: %v", received)
+	}
+	if _, ok := received["X-Tenant"]; ok {
+		t.Errorf("expected X-Tenant to be left out without a project, got %q", received.Get("X-Tenant"))
+	}
+
+	ctx = WithRequestAttributes(ctx, map[string]string{RequestAttributeProjectID: "proj-1"})
+	if _, err := client.GetTrace(ctx, "trace-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if received.Get("X-Tenant") != "org-1/proj-1" {
+		t.Errorf("unexpected X-Tenant header %q", received.Get("X-Tenant"))
+	}
+}
+
+func TestValidateHeaderTemplate(t *testing.T) {
+	t.Parallel()
+
+	for template, valid := range map[string]bool{
+		"static":                               true,
+		"${organization_id}":                   true,
+		"org=${organization_id};${project_id}": true,
+		"${org_id}":                            false,
+		"${}":                                  false,
+	} {
+		if err := ValidateHeaderTemplate(template); (err == nil) != valid {
+			t.Errorf("ValidateHeaderTemplate(%q) = %v, want valid=%t", template, err, valid)
+		}
+	}
+}
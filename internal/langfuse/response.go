@@ -0,0 +1,92 @@
+package langfuse
+
+import (
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// DefaultMaxResponseSize is the default limit, in bytes, on the decompressed size of a response body.
+const DefaultMaxResponseSize int64 = 64 << 20
+
+// gzipTransport asks for gzip-compressed responses and decompresses them, so that the size limit
+// above it applies to the decompressed body and a small compressed payload cannot expand unchecked.
+type gzipTransport struct {
+	next http.RoundTripper
+}
+
+func (t *gzipTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Header.Get("Accept-Encoding") != "" || req.Method == http.MethodHead {
+		return t.next.RoundTrip(req)
+	}
+	req = req.Clone(req.Context())
+	req.Header.Set("Accept-Encoding", "gzip")
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil || !strings.EqualFold(resp.Header.Get("Content-Encoding"), "gzip") {
+		return resp, err
+	}
+	resp.Body = &gzipBody{body: resp.Body}
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+	return resp, nil
+}
+
+// gzipBody decompresses body lazily, so that reading the header of an empty or broken body
+// surfaces as a read error of the caller rather than of the round trip.
+type gzipBody struct {
+	body   io.ReadCloser
+	reader *gzip.Reader
+	err    error
+}
+
+func (b *gzipBody) Read(p []byte) (int, error) {
+	if b.reader == nil && b.err == nil {
+		b.reader, b.err = gzip.NewReader(b.body)
+	}
+	if b.err != nil {
+		return 0, b.err
+	}
+	return b.reader.Read(p)
+}
+
+func (b *gzipBody) Close() error {
+	return b.body.Close()
+}
+
+// responseSizeTransport fails reads of response bodies larger than limit bytes, protecting plans
+// from pathological list responses of very large instances.
+type responseSizeTransport struct {
+	next  http.RoundTripper
+	limit int64
+}
+
+func (t *responseSizeTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil || t.limit <= 0 {
+		return resp, err
+	}
+	resp.Body = http.MaxBytesReader(nil, resp.Body, t.limit)
+	return resp, nil
+}
+
+// responseTooLargeError rewrites err when reading the body failed because it exceeded the maximum
+// response size, and returns nil otherwise.
+func responseTooLargeError(resp *http.Response, err error) error {
+	var maxBytesErr *http.MaxBytesError
+	if !errors.As(err, &maxBytesErr) {
+		return nil
+	}
+	endpoint := ""
+	if resp.Request != nil {
+		endpoint = fmt.Sprintf(" of %s %s", resp.Request.Method, resp.Request.URL.Path)
+	}
+	return fmt.Errorf("response body%s%s exceeds the maximum response size of %d bytes; "+
+		"raise max_response_size_mb if the instance legitimately returns larger responses: %w",
+		endpoint, requestIDSuffix(requestID(resp)), maxBytesErr.Limit, err)
+}
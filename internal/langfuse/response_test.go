@@ -0,0 +1,56 @@
+package langfuse
+
+import (
+	"compress/gzip"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestResponseCompressionAndSizeLimit(t *testing.T) {
+	body := `{"id":"org-1","name":"` + strings.Repeat("a", 4096) + `"}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Header.Get("Accept-Encoding") != "gzip" {
+			t.Errorf("expected a gzip Accept-Encoding header, got %q", r.Header.Get("Accept-Encoding"))
+			_, _ = w.Write([]byte(body))
+			return
+		}
+		w.Header().Set("Content-Encoding", "gzip")
+		gz := gzip.NewWriter(w)
+		_, _ = gz.Write([]byte(body))
+		_ = gz.Close()
+	}))
+	defer server.Close()
+
+	testCases := []struct {
+		name        string
+		limit       int64
+		expectError bool
+	}{
+		{name: "within the limit", limit: 1 << 20},
+		{name: "no limit", limit: 0},
+		{name: "decompressed body over the limit", limit: 1024, expectError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := NewClientFactory(server.URL, "admin", WithMaxResponseSize(tc.limit)).NewAdminClient()
+			org, err := client.GetOrganization(context.Background(), "org-1")
+			if tc.expectError {
+				if err == nil || !strings.Contains(err.Error(), "exceeds the maximum response size of 1024 bytes") {
+					t.Fatalf("expected a response size error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if org.ID != "org-1" || len(org.Name) != 4096 {
+				t.Errorf("unexpected organization decoded: id=%s name length=%d", org.ID, len(org.Name))
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+package langfuse
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how often a request that failed transiently is sent again.
+type RetryPolicy struct {
+	// MaxRetries is the number of retries after the first attempt; zero disables retries.
+	MaxRetries int
+	// Backoff is the wait before the first retry; it doubles with every further retry up to maxRetryBackoff.
+	Backoff time.Duration
+}
+
+var (
+	// DefaultReadRetryPolicy applies to GET and HEAD requests, which can be repeated safely.
+	DefaultReadRetryPolicy = RetryPolicy{MaxRetries: 3, Backoff: time.Second}
+	// DefaultWriteRetryPolicy applies to every other request. Writes are not retried by default because a
+	// request that timed out may still have been applied, and retrying it could e.g. create a second key.
+	// Writes the server rejected without processing them are retried like reads, see isRejected.
+	DefaultWriteRetryPolicy = RetryPolicy{MaxRetries: 0, Backoff: time.Second}
+)
+
+// maxRetryBackoff caps the wait between two attempts.
+const maxRetryBackoff = 30 * time.Second
+
+// maxRetryAfter is the longest Retry-After the transport waits for. A response asking for a longer
+// pause is returned instead, as retrying earlier would only be rejected again.
+const maxRetryAfter = 2 * time.Minute
+
+// The retry transport reports on responses it gave up on through these headers; the clients copy
+// them into APIError, as the response passes through further transports before reaching them.
+const (
+	retryAttemptsHeader = "X-Langfuse-Provider-Attempts"
+	retryElapsedHeader  = "X-Langfuse-Provider-Elapsed"
+)
+
+// RetryError is returned when every attempt of a request failed with a network error.
+type RetryError struct {
+	Attempts int
+	Elapsed  time.Duration
+	Method   string
+	Path     string
+	Err      error
+}
+
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("gave up after %d attempts over %s: %s %s: %v", e.Attempts, formatElapsed(e.Elapsed), e.Method, e.Path, e.Err)
+}
+
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+// formatElapsed rounds d to seconds, or to milliseconds below one second.
+func formatElapsed(d time.Duration) string {
+	if d >= time.Second {
+		return d.Round(time.Second).String()
+	}
+	return d.Round(time.Millisecond).String()
+}
+
+// retryTransport retries requests that failed with a network error or a status code signalling a
+// temporary condition, using the read policy for GET and HEAD and the write policy otherwise. Writes
+// the server rejected without processing them get as many retries as reads, if the write policy
+// allows fewer. The wait doubles with every retry unless the response says how long to wait in
+// Retry-After.
+type retryTransport struct {
+	next    http.RoundTripper
+	read    RetryPolicy
+	write   RetryPolicy
+	runtime Runtime
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	policy := t.write
+	if req.Method == http.MethodGet || req.Method == http.MethodHead {
+		policy = t.read
+	}
+	// A body that cannot be recreated can only be sent once.
+	replayable := req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+	if !replayable {
+		policy.MaxRetries = 0
+	}
+
+	runtime := t.runtime.withDefaults()
+	start := runtime.Now()
+	backoff := policy.Backoff
+	for attempt := 0; ; attempt++ {
+		attemptReq := req
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq = req.Clone(req.Context())
+			attemptReq.Body = body
+		}
+
+		resp, err := t.next.RoundTrip(attemptReq)
+		wait := backoff
+		maxRetries := policy.MaxRetries
+		if resp != nil {
+			retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After"), runtime.Now())
+			if ok {
+				wait = retryAfter
+			}
+			if replayable && isRejected(resp.StatusCode, ok) {
+				maxRetries = max(maxRetries, t.read.MaxRetries)
+			}
+		}
+		if attempt >= maxRetries || !isRetryable(resp, err) || wait > maxRetryAfter {
+			if attempt == 0 {
+				return resp, err
+			}
+			elapsed := runtime.Now().Sub(start)
+			if err != nil {
+				return nil, &RetryError{Attempts: attempt + 1, Elapsed: elapsed, Method: req.Method, Path: req.URL.Path, Err: err}
+			}
+			resp.Header.Set(retryAttemptsHeader, strconv.Itoa(attempt+1))
+			resp.Header.Set(retryElapsedHeader, elapsed.String())
+			return resp, nil
+		}
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		select {
+		case <-runtime.After(wait):
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+		backoff = min(2*backoff, maxRetryBackoff)
+	}
+}
+
+// isRetryable reports whether an attempt failed in a way that may succeed when repeated: a network
+// error, rate limiting, or a server error. 501 and 505 are left out, as the server will not
+// support the request on the next attempt either.
+func isRetryable(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusNotImplemented, http.StatusHTTPVersionNotSupported:
+		return false
+	default:
+		return resp.StatusCode >= http.StatusInternalServerError
+	}
+}
+
+// isRejected reports whether a response says the server did not process the request, so that
+// repeating it is safe even for writes: rate limiting, or unavailability with a Retry-After. Other
+// server errors may come from a request that was partially applied.
+func isRejected(statusCode int, hasRetryAfter bool) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode == http.StatusServiceUnavailable && hasRetryAfter
+}
+
+// parseRetryAfter parses a Retry-After header, given in seconds or as an HTTP date, into the wait
+// it asks for. A date in the past asks for no wait.
+func parseRetryAfter(header string, now time.Time) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(header); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	date, err := http.ParseTime(header)
+	if err != nil {
+		return 0, false
+	}
+	return max(date.Sub(now), 0), true
+}
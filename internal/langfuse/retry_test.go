@@ -0,0 +1,222 @@
+package langfuse
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryTransport(t *testing.T) {
+	testCases := []struct {
+		name          string
+		method        string
+		status        int
+		retryAfter    string
+		read          RetryPolicy
+		write         RetryPolicy
+		expectedCalls int32
+	}{
+		{name: "read retried until exhausted", method: http.MethodGet, status: http.StatusServiceUnavailable, read: RetryPolicy{MaxRetries: 2, Backoff: time.Millisecond}, expectedCalls: 3},
+		{name: "read not retried on client error", method: http.MethodGet, status: http.StatusNotFound, read: RetryPolicy{MaxRetries: 2, Backoff: time.Millisecond}, expectedCalls: 1},
+		{name: "read retried on internal error", method: http.MethodGet, status: http.StatusInternalServerError, read: RetryPolicy{MaxRetries: 2, Backoff: time.Millisecond}, expectedCalls: 3},
+		{name: "read not retried on not implemented", method: http.MethodGet, status: http.StatusNotImplemented, read: RetryPolicy{MaxRetries: 2, Backoff: time.Millisecond}, expectedCalls: 1},
+		{name: "write uses write policy", method: http.MethodPost, status: http.StatusBadGateway, read: RetryPolicy{MaxRetries: 5, Backoff: time.Millisecond}, write: RetryPolicy{MaxRetries: 1, Backoff: time.Millisecond}, expectedCalls: 2},
+		{name: "rejected write uses read policy", method: http.MethodPost, status: http.StatusTooManyRequests, read: RetryPolicy{MaxRetries: 2, Backoff: time.Millisecond}, write: RetryPolicy{Backoff: time.Millisecond}, expectedCalls: 3},
+		{name: "rejected write keeps larger write policy", method: http.MethodPost, status: http.StatusTooManyRequests, read: RetryPolicy{MaxRetries: 1, Backoff: time.Millisecond}, write: RetryPolicy{MaxRetries: 3, Backoff: time.Millisecond}, expectedCalls: 4},
+		{name: "write retried on unavailable with Retry-After", method: http.MethodPost, status: http.StatusServiceUnavailable, retryAfter: "0", read: RetryPolicy{MaxRetries: 2, Backoff: time.Millisecond}, write: RetryPolicy{Backoff: time.Millisecond}, expectedCalls: 3},
+		{name: "write not retried on unavailable without Retry-After", method: http.MethodPost, status: http.StatusServiceUnavailable, read: RetryPolicy{MaxRetries: 2, Backoff: time.Millisecond}, write: RetryPolicy{Backoff: time.Millisecond}, expectedCalls: 1},
+		{name: "writes not retried by default", method: http.MethodPost, status: http.StatusBadGateway, read: DefaultReadRetryPolicy, write: DefaultWriteRetryPolicy, expectedCalls: 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var calls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls.Add(1)
+				if body, _ := io.ReadAll(r.Body); r.Method == http.MethodPost && string(body) != `{"name":"project"}` {
+					t.Errorf("unexpected body on attempt %d: %q", calls.Load(), body)
+				}
+				if tc.retryAfter != "" {
+					w.Header().Set("Retry-After", tc.retryAfter)
+				}
+				w.WriteHeader(tc.status)
+			}))
+			defer server.Close()
+
+			client := &http.Client{Transport: &retryTransport{next: http.DefaultTransport, read: tc.read, write: tc.write}}
+			var body io.Reader
+			if tc.method == http.MethodPost {
+				body = strings.NewReader(`{"name":"project"}`)
+			}
+			req, err := http.NewRequest(tc.method, server.URL, body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != tc.status {
+				t.Errorf("expected the last response to be returned, got status %d", resp.StatusCode)
+			}
+			if got := calls.Load(); got != tc.expectedCalls {
+				t.Errorf("expected %d calls, got %d", tc.expectedCalls, got)
+			}
+		})
+	}
+}
+
+func TestRetryTransportRetryAfter(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		retryAfter    string
+		expectedWaits []time.Duration
+		expectedCalls int32
+	}{
+		{name: "exponential backoff without header", expectedWaits: []time.Duration{time.Second, 2 * time.Second}, expectedCalls: 3},
+		{name: "seconds", retryAfter: "7", expectedWaits: []time.Duration{7 * time.Second, 7 * time.Second}, expectedCalls: 3},
+		{name: "HTTP date", retryAfter: now.Add(90 * time.Second).Format(http.TimeFormat), expectedWaits: []time.Duration{90 * time.Second, 90 * time.Second}, expectedCalls: 3},
+		{name: "date in the past", retryAfter: now.Add(-time.Minute).Format(http.TimeFormat), expectedWaits: []time.Duration{0, 0}, expectedCalls: 3},
+		{name: "invalid header", retryAfter: "soon", expectedWaits: []time.Duration{time.Second, 2 * time.Second}, expectedCalls: 3},
+		{name: "longer than the maximum", retryAfter: "3600", expectedCalls: 1},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var calls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls.Add(1)
+				if tc.retryAfter != "" {
+					w.Header().Set("Retry-After", tc.retryAfter)
+				}
+				w.WriteHeader(http.StatusTooManyRequests)
+			}))
+			defer server.Close()
+
+			var waits []time.Duration
+			runtime := Runtime{
+				Now: func() time.Time { return now },
+				After: func(d time.Duration) <-chan time.Time {
+					waits = append(waits, d)
+					ch := make(chan time.Time, 1)
+					ch <- now
+					return ch
+				},
+			}
+			read := RetryPolicy{MaxRetries: 2, Backoff: time.Second}
+			client := &http.Client{Transport: &retryTransport{next: http.DefaultTransport, read: read, runtime: runtime}}
+			resp, err := client.Get(server.URL)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			resp.Body.Close()
+
+			if got := calls.Load(); got != tc.expectedCalls {
+				t.Errorf("expected %d calls, got %d", tc.expectedCalls, got)
+			}
+			if len(waits) != len(tc.expectedWaits) {
+				t.Fatalf("expected waits %v, got %v", tc.expectedWaits, waits)
+			}
+			for i := range waits {
+				if waits[i] != tc.expectedWaits[i] {
+					t.Errorf("expected waits %v, got %v", tc.expectedWaits, waits)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestRetryTransportReportsAttempts(t *testing.T) {
+	read := RetryPolicy{MaxRetries: 2, Backoff: time.Millisecond}
+
+	t.Run("status", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		client := &http.Client{Transport: &retryTransport{next: http.DefaultTransport, read: read}}
+		resp, err := client.Get(server.URL + "/api/public/projects")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer resp.Body.Close()
+
+		apiErr := newAPIError(resp, nil)
+		if apiErr.Attempts != 3 || apiErr.Elapsed <= 0 {
+			t.Errorf("unexpected attempts %d over %s", apiErr.Attempts, apiErr.Elapsed)
+		}
+		if !strings.HasPrefix(apiErr.Error(), "gave up after 3 attempts over ") || !strings.Contains(apiErr.Error(), "503 from GET /api/public/projects") {
+			t.Errorf("unexpected message: %s", apiErr.Error())
+		}
+	})
+
+	t.Run("elapsed on the runtime clock", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer server.Close()
+
+		now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+		runtime := Runtime{
+			Now: func() time.Time { return now },
+			After: func(d time.Duration) <-chan time.Time {
+				now = now.Add(d)
+				ch := make(chan time.Time, 1)
+				ch <- now
+				return ch
+			},
+		}
+		policy := RetryPolicy{MaxRetries: 2, Backoff: time.Second}
+		client := &http.Client{Transport: &retryTransport{next: http.DefaultTransport, read: policy, runtime: runtime}}
+		resp, err := client.Get(server.URL)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer resp.Body.Close()
+
+		if apiErr := newAPIError(resp, nil); apiErr.Elapsed != 3*time.Second {
+			t.Errorf("expected the backoff of 1s and 2s to be reported, got %s", apiErr.Elapsed)
+		}
+	})
+
+	t.Run("network error", func(t *testing.T) {
+		server := httptest.NewServer(http.NotFoundHandler())
+		server.Close()
+
+		client := &http.Client{Transport: &retryTransport{next: http.DefaultTransport, read: read}}
+		_, err := client.Get(server.URL)
+
+		var retryErr *RetryError
+		if !errors.As(err, &retryErr) || retryErr.Attempts != 3 {
+			t.Fatalf("expected a RetryError after 3 attempts, got: %v", err)
+		}
+	})
+
+	t.Run("not retried", func(t *testing.T) {
+		server := httptest.NewServer(http.NotFoundHandler())
+		defer server.Close()
+
+		client := &http.Client{Transport: &retryTransport{next: http.DefaultTransport, read: read}}
+		resp, err := client.Get(server.URL)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		defer resp.Body.Close()
+
+		if apiErr := newAPIError(resp, nil); apiErr.Attempts != 0 || strings.HasPrefix(apiErr.Error(), "gave up") {
+			t.Errorf("unexpected retry information on a request sent once: %s", apiErr.Error())
+		}
+	})
+}
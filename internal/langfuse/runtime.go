@@ -0,0 +1,53 @@
+package langfuse
+
+import (
+	"crypto/rand"
+	"fmt"
+	"time"
+)
+
+// Runtime holds the dependencies of resources on their environment, so that time-based behavior
+// (polling deadlines, expiry windows, grace periods) and generated IDs are deterministic in tests.
+type Runtime struct {
+	// Now returns the current time.
+	Now func() time.Time
+	// After waits for the duration to elapse and then sends the current time, like time.After.
+	After func(d time.Duration) <-chan time.Time
+	// NewID returns a new random identifier.
+	NewID func() (string, error)
+}
+
+// DefaultRuntime returns the runtime backed by the system clock and crypto/rand.
+func DefaultRuntime() Runtime {
+	return Runtime{
+		Now:   time.Now,
+		After: time.After,
+		NewID: NewUUID,
+	}
+}
+
+// withDefaults fills the unset dependencies from DefaultRuntime.
+func (rt Runtime) withDefaults() Runtime {
+	defaults := DefaultRuntime()
+	if rt.Now == nil {
+		rt.Now = defaults.Now
+	}
+	if rt.After == nil {
+		rt.After = defaults.After
+	}
+	if rt.NewID == nil {
+		rt.NewID = defaults.NewID
+	}
+	return rt
+}
+
+// NewUUID returns a random UUID (version 4).
+func NewUUID() (string, error) {
+	var id [16]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		return "", err
+	}
+	id[6] = id[6]&0x0f | 0x40
+	id[8] = id[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", id[0:4], id[4:6], id[6:8], id[8:10], id[10:16]), nil
+}
@@ -0,0 +1,47 @@
+package langfuse
+
+import (
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestNewUUID(t *testing.T) {
+	t.Parallel()
+
+	pattern := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+	seen := make(map[string]struct{})
+	for i := 0; i < 100; i++ {
+		id, err := NewUUID()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !pattern.MatchString(id) {
+			t.Fatalf("%q is not a version 4 UUID", id)
+		}
+		if _, ok := seen[id]; ok {
+			t.Fatalf("duplicate UUID %q", id)
+		}
+		seen[id] = struct{}{}
+	}
+}
+
+func TestWithRuntime(t *testing.T) {
+	t.Parallel()
+
+	fixed := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	cf := NewClientFactory("http://localhost:3000", "", WithRuntime(Runtime{
+		Now: func() time.Time { return fixed },
+	}))
+
+	runtime := cf.Runtime()
+	if !runtime.Now().Equal(fixed) {
+		t.Errorf("unexpected time %s, want %s", runtime.Now(), fixed)
+	}
+	if runtime.After == nil || runtime.NewID == nil {
+		t.Fatal("unset runtime dependencies were not defaulted")
+	}
+	if _, err := runtime.NewID(); err != nil {
+		t.Errorf("unexpected error from default NewID: %v", err)
+	}
+}
@@ -0,0 +1,31 @@
+package langfuse
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// ReadOnlyError is returned for any mutating request made while the client factory is read-only.
+type ReadOnlyError struct {
+	Method string
+	Path   string
+}
+
+func (e *ReadOnlyError) Error() string {
+	return fmt.Sprintf("refusing to send %s %s: the provider is configured with read_only = true", e.Method, e.Path)
+}
+
+// readOnlyTransport rejects every request that could mutate data before it leaves the process.
+type readOnlyTransport struct {
+	next http.RoundTripper
+}
+
+func (t *readOnlyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet && req.Method != http.MethodHead {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, &ReadOnlyError{Method: req.Method, Path: req.URL.Path}
+	}
+	return t.next.RoundTrip(req)
+}
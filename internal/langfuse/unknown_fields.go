@@ -0,0 +1,145 @@
+package langfuse
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type unknownFieldWarningsKey struct{}
+
+// unknownFieldsTransport marks every request so that decodeResponse logs a warning when the
+// response contains fields the client types do not model, e.g. after a Langfuse upgrade.
+type unknownFieldsTransport struct {
+	next http.RoundTripper
+}
+
+func (t *unknownFieldsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	return t.next.RoundTrip(req.WithContext(context.WithValue(req.Context(), unknownFieldWarningsKey{}, true)))
+}
+
+func unknownFieldWarningsEnabled(resp *http.Response) bool {
+	if resp.Request == nil {
+		return false
+	}
+	enabled, _ := resp.Request.Context().Value(unknownFieldWarningsKey{}).(bool)
+	return enabled
+}
+
+// warnUnknownFields logs the fields of body that decoding into target silently dropped.
+func warnUnknownFields(resp *http.Response, body []byte, target any) {
+	found := make(map[string]struct{})
+	collectUnknownFields(body, reflect.TypeOf(target), "", found)
+	if len(found) == 0 {
+		return
+	}
+
+	fields := make([]string, 0, len(found))
+	for field := range found {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	tflog.Warn(resp.Request.Context(), "Langfuse API response contains fields the provider does not model", map[string]any{
+		"method": resp.Request.Method,
+		"path":   resp.Request.URL.Path,
+		"fields": fields,
+	})
+}
+
+// collectUnknownFields adds the dotted path of every object key in data that has no matching
+// field in t. Array elements are reported with a "[]" suffix on the array's path. Maps and
+// interfaces accept any key and are not descended into.
+func collectUnknownFields(data []byte, t reflect.Type, prefix string, found map[string]struct{}) {
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil {
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		if t == reflect.TypeOf(time.Time{}) {
+			return
+		}
+		var object map[string]json.RawMessage
+		if err := json.Unmarshal(data, &object); err != nil {
+			return
+		}
+		fields := jsonFields(t)
+		for key, value := range object {
+			path := key
+			if prefix != "" {
+				path = prefix + "." + key
+			}
+			field, ok := lookupJSONField(fields, key)
+			if !ok {
+				found[path] = struct{}{}
+				continue
+			}
+			collectUnknownFields(value, field, path, found)
+		}
+	case reflect.Slice, reflect.Array:
+		var elements []json.RawMessage
+		if err := json.Unmarshal(data, &elements); err != nil {
+			return
+		}
+		for _, element := range elements {
+			collectUnknownFields(element, t.Elem(), prefix+"[]", found)
+		}
+	}
+}
+
+// jsonFields maps the JSON names of the fields encoding/json decodes into struct type t to their types.
+func jsonFields(t reflect.Type) map[string]reflect.Type {
+	fields := make(map[string]reflect.Type)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				for embeddedName, embeddedType := range jsonFields(embedded) {
+					fields[embeddedName] = embeddedType
+				}
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields[name] = field.Type
+	}
+	return fields
+}
+
+// lookupJSONField finds the field for key the way encoding/json does: exact match first, then case-insensitive.
+func lookupJSONField(fields map[string]reflect.Type, key string) (reflect.Type, bool) {
+	if field, ok := fields[key]; ok {
+		return field, true
+	}
+	for name, field := range fields {
+		if strings.EqualFold(name, key) {
+			return field, true
+		}
+	}
+	return nil, false
+}
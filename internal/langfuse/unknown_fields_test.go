@@ -0,0 +1,89 @@
+package langfuse
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestCollectUnknownFields(t *testing.T) {
+	testCases := []struct {
+		name     string
+		body     string
+		target   any
+		expected []string
+	}{
+		{
+			name:   "all fields modeled",
+			body:   `{"id":"proj-1","name":"Project","metadata":{"team":"ml"}}`,
+			target: &Project{},
+		},
+		{
+			name:     "new top-level field",
+			body:     `{"id":"proj-1","name":"Project","environments":["prod"]}`,
+			target:   &Project{},
+			expected: []string{"environments"},
+		},
+		{
+			name:     "new field in list elements",
+			body:     `{"apiKeys":[{"id":"key-1","publicKey":"pk","lastUsedBy":"ci"},{"id":"key-2","publicKey":"pk2","lastUsedBy":null}]}`,
+			target:   &listProjectApiKeysResponse{},
+			expected: []string{"apiKeys[].lastUsedBy"},
+		},
+		{
+			name:   "case-insensitive match",
+			body:   `{"ID":"proj-1","Name":"Project"}`,
+			target: &Project{},
+		},
+		{
+			name:   "timestamps are not descended into",
+			body:   `{"apiKeys":[{"id":"key-1","createdAt":"2024-01-01T00:00:00Z"}]}`,
+			target: &listProjectApiKeysResponse{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			found := make(map[string]struct{})
+			collectUnknownFields([]byte(tc.body), reflect.TypeOf(tc.target), "", found)
+
+			var got []string
+			for field := range found {
+				got = append(got, field)
+			}
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("unexpected unknown fields: got %v, want %v", got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestUnknownFieldWarningsFlag(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status":"OK","version":"3.0.0"}`))
+	}))
+	defer server.Close()
+
+	for _, enabled := range []bool{false, true} {
+		cf := NewClientFactory(server.URL, "admin", WithUnknownFieldWarnings(enabled)).(*clientFactoryImpl)
+
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := cf.httpClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+
+		if got := unknownFieldWarningsEnabled(resp); got != enabled {
+			t.Errorf("WithUnknownFieldWarnings(%t): warnings enabled = %t", enabled, got)
+		}
+	}
+}
@@ -28,19 +28,36 @@ func buildBaseRequest(ctx context.Context, method, url string, body any) (*http.
 	return req, nil
 }
 
+// decodeResponse decodes the JSON body of a successful response into target, streaming it unless
+// unknown field warnings need the raw body.
 func decodeResponse(resp *http.Response, target any) error {
 	defer resp.Body.Close()
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
 		body, _ := io.ReadAll(resp.Body)
-		return fmt.Errorf("request failed with status code %d, response body: %s", resp.StatusCode, string(body))
+		return newAPIError(resp, body)
 	}
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return fmt.Errorf("failed to read response body: %w", err)
+
+	var body []byte
+	reader := io.Reader(resp.Body)
+	if unknownFieldWarningsEnabled(resp) {
+		var err error
+		if body, err = io.ReadAll(resp.Body); err != nil {
+			if tooLarge := responseTooLargeError(resp, err); tooLarge != nil {
+				return tooLarge
+			}
+			return fmt.Errorf("failed to read response body%s: %w", requestIDSuffix(requestID(resp)), err)
+		}
+		reader = bytes.NewReader(body)
 	}
-	if err = json.Unmarshal(body, &target); err != nil {
-		return fmt.Errorf("failed to unmarshal response body: %w", err)
+	if err := json.NewDecoder(reader).Decode(&target); err != nil {
+		if tooLarge := responseTooLargeError(resp, err); tooLarge != nil {
+			return tooLarge
+		}
+		return fmt.Errorf("failed to unmarshal response body%s: %w", requestIDSuffix(requestID(resp)), err)
+	}
+	if body != nil {
+		warnUnknownFields(resp, body, target)
 	}
 
 	return nil
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+)
+
+const adminAPIUnavailableSummary = "Admin API not available"
+
+// isLangfuseCloudHost reports whether host points at Langfuse Cloud, which does not offer the admin API.
+func isLangfuseCloudHost(host string) bool {
+	u, err := url.Parse(host)
+	if err != nil {
+		return false
+	}
+	hostname := strings.ToLower(u.Hostname())
+	return hostname == "langfuse.com" || strings.HasSuffix(hostname, ".langfuse.com")
+}
+
+func adminAPIUnavailableDetail(typeName, host string) string {
+	return fmt.Sprintf("%s is managed through the Langfuse admin API, which only exists on self-hosted instances with ADMIN_API_KEY "+
+		"and an Enterprise license configured. %s does not offer it. On Langfuse Cloud, create organizations and organization API keys "+
+		"in the web UI and pass the organization key pair to langfuse_project, langfuse_project_api_key and "+
+		"langfuse_organization_membership (for example through the provider credentials attribute); those resources work normally.", typeName, host)
+}
+
+// checkAdminAPIAvailable returns an error diagnostic when a resource managed through the admin
+// API is used against Langfuse Cloud, so it fails up front instead of with a generic 404 or 401.
+func checkAdminAPIAvailable(clientFactory langfuse.ClientFactory, typeName string) diag.Diagnostics {
+	diags := checkProviderConfigurationKnown(clientFactory)
+	if diags.HasError() || clientFactory == nil || !isLangfuseCloudHost(clientFactory.Host()) {
+		return diags
+	}
+
+	diags.AddError(adminAPIUnavailableSummary, adminAPIUnavailableDetail(typeName, clientFactory.Host()))
+	return diags
+}
+
+// checkAdminAPIKeyConfigured returns an error diagnostic when a resource or data source managed
+// through the admin API is used without an admin API key. It runs in Configure, so that the error
+// is reported against the addresses using typeName, while configurations that only use
+// organization keys never need an admin key.
+func checkAdminAPIKeyConfigured(clientFactory langfuse.ClientFactory, typeName string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if clientFactory == nil || clientFactory.HasAdminAPIKey() {
+		return diags
+	}
+
+	diags.AddError(
+		"Admin API key required",
+		fmt.Sprintf("%s is managed through the Langfuse admin API, but the provider has no admin API key. Set admin_api_key in the "+
+			"provider block or the LANGFUSE_ADMIN_KEY environment variable to the ADMIN_API_KEY of the instance. Resources that "+
+			"authenticate with an organization key pair (langfuse_project, langfuse_project_api_key, langfuse_organization_membership) "+
+			"do not need it.", typeName),
+	)
+	return diags
+}
+
+// addAdminClientError records a failed admin API call. A 404 from an admin collection endpoint
+// means the instance does not serve the admin API at all (e.g. a custom domain in front of
+// Langfuse Cloud) and is reported as such; everything else goes through addClientError.
+func addAdminClientError(diags *diag.Diagnostics, clientFactory langfuse.ClientFactory, typeName, summary string, err error) {
+	var apiErr *langfuse.APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound && clientFactory != nil {
+		diags.AddError(adminAPIUnavailableSummary, adminAPIUnavailableDetail(typeName, clientFactory.Host())+"\n\n"+err.Error())
+		return
+	}
+	addClientError(diags, summary, err)
+}
+
+// organizationName looks up the name of the organization through the admin API. Organization keys
+// cannot read their own organization, so the name is null without an admin API key, on Langfuse
+// Cloud, or when the lookup fails.
+func organizationName(ctx context.Context, clientFactory langfuse.ClientFactory, organizationID string) types.String {
+	if clientFactory == nil || !clientFactory.HasAdminAPIKey() || isLangfuseCloudHost(clientFactory.Host()) || organizationID == "" {
+		return types.StringNull()
+	}
+
+	org, err := clientFactory.NewAdminClient().GetOrganization(ctx, organizationID)
+	if err != nil {
+		tflog.Debug(ctx, "Could not resolve the organization name", map[string]any{"organization_id": organizationID, "error": err.Error()})
+		return types.StringNull()
+	}
+	return types.StringValue(org.Name)
+}
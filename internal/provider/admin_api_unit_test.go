@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse/mocks"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestIsLangfuseCloudHost(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]bool{
+		"https://cloud.langfuse.com":       true,
+		"https://us.cloud.langfuse.com/":   true,
+		"https://HIPAA.cloud.langfuse.com": true,
+		"https://app.langfuse.com":         true,
+		"http://localhost:3000":            false,
+		"https://langfuse.example.com":     false,
+		"https://notlangfuse.com":          false,
+	}
+
+	for host, expected := range testCases {
+		if got := isLangfuseCloudHost(host); got != expected {
+			t.Errorf("isLangfuseCloudHost(%q) = %t, want %t", host, got, expected)
+		}
+	}
+}
+
+func TestOrganizationResourceOnLangfuseCloud(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	r := NewOrganizationResource().(*organizationResource)
+	clientFactory := mocks.NewMockClientFactory(ctrl)
+	clientFactory.BaseURL = "https://cloud.langfuse.com"
+	r.Configure(ctx, resource.ConfigureRequest{ProviderData: clientFactory}, &resource.ConfigureResponse{})
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	createResp := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, resource.CreateRequest{
+		Config: tfsdk.Config{
+			Raw: buildObjectValue(map[string]tftypes.Value{
+				"id":       tftypes.NewValue(tftypes.String, nil),
+				"name":     tftypes.NewValue(tftypes.String, "Acme Inc"),
+				"metadata": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			}),
+			Schema: schemaResp.Schema,
+		},
+	}, &createResp)
+
+	if !createResp.Diagnostics.HasError() {
+		t.Fatal("expected an error creating an organization on Langfuse Cloud")
+	}
+	if summary := createResp.Diagnostics.Errors()[0].Summary(); summary != adminAPIUnavailableSummary {
+		t.Errorf("unexpected summary %q", summary)
+	}
+}
+
+func TestAddAdminClientError(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	clientFactory := mocks.NewMockClientFactory(ctrl)
+	clientFactory.BaseURL = "https://langfuse.example.com"
+
+	var diags diag.Diagnostics
+	addAdminClientError(&diags, clientFactory, "langfuse_organization", "Error creating organization",
+		&langfuse.APIError{StatusCode: http.StatusNotFound, Method: http.MethodPost, Path: "/api/admin/organizations"})
+	if summary := diags.Errors()[0].Summary(); summary != adminAPIUnavailableSummary {
+		t.Errorf("unexpected summary for 404: %q", summary)
+	}
+
+	diags = nil
+	addAdminClientError(&diags, clientFactory, "langfuse_organization", "Error creating organization",
+		&langfuse.APIError{StatusCode: http.StatusBadRequest, Method: http.MethodPost, Path: "/api/admin/organizations"})
+	if summary := diags.Errors()[0].Summary(); summary != "Error creating organization" {
+		t.Errorf("unexpected summary for 400: %q", summary)
+	}
+}
+
+func TestAdminAPIKeyRequiredOnConfigure(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	clientFactory := mocks.NewMockClientFactory(ctrl)
+	clientFactory.BaseURL = "https://langfuse.example.com"
+	clientFactory.NoAdminAPIKey = true
+
+	var orgResp resource.ConfigureResponse
+	NewOrganizationResource().(*organizationResource).Configure(ctx, resource.ConfigureRequest{ProviderData: clientFactory}, &orgResp)
+	if !orgResp.Diagnostics.HasError() || !strings.Contains(orgResp.Diagnostics.Errors()[0].Detail(), "langfuse_organization ") {
+		t.Errorf("expected an admin API key error naming langfuse_organization, got: %v", orgResp.Diagnostics)
+	}
+
+	var projectResp resource.ConfigureResponse
+	NewProjectResource().(*projectResource).Configure(ctx, resource.ConfigureRequest{ProviderData: clientFactory}, &projectResp)
+	if projectResp.Diagnostics.HasError() {
+		t.Errorf("langfuse_project must not require an admin API key, got: %v", projectResp.Diagnostics)
+	}
+}
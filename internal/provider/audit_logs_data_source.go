@@ -0,0 +1,232 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+)
+
+var _ datasource.DataSource = &auditLogsDataSource{}
+
+func NewAuditLogsDataSource() datasource.DataSource {
+	return &auditLogsDataSource{}
+}
+
+type auditLogsDataSourceModel struct {
+	Actor                  types.String         `tfsdk:"actor"`
+	Action                 types.String         `tfsdk:"action"`
+	From                   types.String         `tfsdk:"from"`
+	To                     types.String         `tfsdk:"to"`
+	Entries                []auditLogEntryModel `tfsdk:"entries"`
+	OrganizationPublicKey  types.String         `tfsdk:"organization_public_key"`
+	OrganizationPrivateKey types.String         `tfsdk:"organization_private_key"`
+	CredentialRef          types.String         `tfsdk:"credential_ref"`
+}
+
+type auditLogEntryModel struct {
+	ID           types.String `tfsdk:"id"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+	ActorType    types.String `tfsdk:"actor_type"`
+	Actor        types.String `tfsdk:"actor"`
+	ProjectID    types.String `tfsdk:"project_id"`
+	ResourceType types.String `tfsdk:"resource_type"`
+	ResourceID   types.String `tfsdk:"resource_id"`
+	Action       types.String `tfsdk:"action"`
+	Before       types.String `tfsdk:"before"`
+	After        types.String `tfsdk:"after"`
+}
+
+type auditLogsDataSource struct {
+	ClientFactory langfuse.ClientFactory
+}
+
+func (d *auditLogsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clientFactory, ok := req.ProviderData.(langfuse.ClientFactory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected langfuse.ClientFactory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.ClientFactory = clientFactory
+}
+
+func (d *auditLogsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit_logs"
+}
+
+func (d *auditLogsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reads the audit log of an organization, e.g. to pull the change records of Terraform-managed objects during an " +
+			"incident review. Requires an instance with audit logging enabled.",
+		Attributes: map[string]schema.Attribute{
+			"actor": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return entries recorded for this user ID or API key ID.",
+			},
+			"action": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return entries with this action, e.g. `create`, `update` or `delete`.",
+			},
+			"from": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return entries recorded at or after this time (RFC 3339).",
+			},
+			"to": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only return entries recorded before this time (RFC 3339). Without it, every read may return new entries.",
+			},
+			"entries": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The matching entries, oldest first.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{Computed: true},
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Time the change was recorded (RFC 3339).",
+						},
+						"actor_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Whether a user (`USER`) or an API key (`API_KEY`) made the change.",
+						},
+						"actor": schema.StringAttribute{
+							Computed:    true,
+							Description: "The user ID or API key ID that made the change.",
+						},
+						"project_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The project the change was made in, or null for organization-level changes.",
+						},
+						"resource_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "Type of the changed object, e.g. `project` or `apiKey`.",
+						},
+						"resource_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "ID of the changed object.",
+						},
+						"action": schema.StringAttribute{Computed: true},
+						"before": schema.StringAttribute{
+							Computed:    true,
+							Description: "JSON encoded object before the change, if recorded.",
+						},
+						"after": schema.StringAttribute{
+							Computed:    true,
+							Description: "JSON encoded object after the change, if recorded.",
+						},
+					},
+				},
+			},
+			"organization_public_key": schema.StringAttribute{
+				Optional:    true,
+				Description: "Organization public key to authenticate the call. Conflicts with `credential_ref`. Defaults to LANGFUSE_ORG_PUBLIC_KEY.",
+			},
+			"organization_private_key": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Organization private key to authenticate the call. Conflicts with `credential_ref`. Defaults to LANGFUSE_ORG_SECRET_KEY.",
+			},
+			"credential_ref": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of organization credentials declared in the provider `credentials` block, used instead of `organization_public_key`/`organization_private_key`.",
+			},
+		},
+	}
+}
+
+func (d *auditLogsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer addRunWarnings(&resp.Diagnostics, d.ClientFactory)
+
+	resp.Diagnostics.Append(checkInstanceVersion(ctx, d.ClientFactory, "langfuse_audit_logs")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data auditLogsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	query := &langfuse.AuditLogQuery{Actor: data.Actor.ValueString(), Action: data.Action.ValueString()}
+	query.From = parseAuditLogTime(&resp.Diagnostics, "from", data.From)
+	query.To = parseAuditLogTime(&resp.Diagnostics, "to", data.To)
+	resp.Diagnostics.Append(validateOrganizationCredentials(data.OrganizationPublicKey, data.OrganizationPrivateKey, data.CredentialRef)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationClient, diags := newOrganizationClient(d.ClientFactory, data.OrganizationPublicKey, data.OrganizationPrivateKey, data.CredentialRef)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entries, err := organizationClient.ListAuditLogs(ctx, query)
+	if errors.Is(err, langfuse.ErrAuditLogUnavailable) {
+		resp.Diagnostics.AddError(
+			"Audit log not available",
+			fmt.Sprintf("%s does not serve the audit log API. Audit logs are only recorded by Langfuse editions that include them, "+
+				"and only once audit logging is enabled for the instance.", d.ClientFactory.Host()),
+		)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error reading audit log", err)
+		return
+	}
+
+	data.Entries = []auditLogEntryModel{}
+	for _, entry := range entries {
+		actor := entry.UserID
+		if actor == "" {
+			actor = entry.ApiKeyID
+		}
+		data.Entries = append(data.Entries, auditLogEntryModel{
+			ID:           types.StringValue(entry.ID),
+			CreatedAt:    types.StringValue(entry.CreatedAt.UTC().Format(time.RFC3339)),
+			ActorType:    stringValueOrNull(entry.Type),
+			Actor:        stringValueOrNull(actor),
+			ProjectID:    stringValueOrNull(entry.ProjectID),
+			ResourceType: types.StringValue(entry.ResourceType),
+			ResourceID:   types.StringValue(entry.ResourceID),
+			Action:       types.StringValue(entry.Action),
+			Before:       stringValueOrNull(entry.Before),
+			After:        stringValueOrNull(entry.After),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// parseAuditLogTime parses an RFC 3339 time filter; null leaves the filter unset.
+func parseAuditLogTime(diags *diag.Diagnostics, attribute string, value types.String) time.Time {
+	if value.IsNull() {
+		return time.Time{}
+	}
+	parsed, err := time.Parse(time.RFC3339, value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root(attribute),
+			"Invalid time",
+			fmt.Sprintf("%s must be an RFC 3339 time such as \"2026-03-01T00:00:00Z\". Got: %s", attribute, value.ValueString()),
+		)
+	}
+	return parsed
+}
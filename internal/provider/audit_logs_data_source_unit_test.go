@@ -0,0 +1,117 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse/mocks"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func auditLogsConfig(ctx context.Context, t *testing.T, d datasource.DataSource, values map[string]tftypes.Value) (datasource.SchemaResponse, tfsdk.Config) {
+	t.Helper()
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	if diags := schemaResp.Schema.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("schema implementation validation failed: %v", diags)
+	}
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values["organization_public_key"] = tftypes.NewValue(tftypes.String, "pk-org")
+	values["organization_private_key"] = tftypes.NewValue(tftypes.String, "sk-org")
+	for name, attributeType := range objectType.AttributeTypes {
+		if _, ok := values[name]; !ok {
+			values[name] = tftypes.NewValue(attributeType, nil)
+		}
+	}
+	return schemaResp, tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+}
+
+func TestAuditLogsDataSourceRead(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	d := NewAuditLogsDataSource().(*auditLogsDataSource)
+	clientFactory := mocks.NewMockClientFactory(ctrl)
+	d.Configure(ctx, datasource.ConfigureRequest{ProviderData: clientFactory}, &datasource.ConfigureResponse{})
+
+	clientFactory.OrganizationClient.EXPECT().ListAuditLogs(ctx, &langfuse.AuditLogQuery{Action: "delete", From: from}).Return([]langfuse.AuditLog{
+		{ID: "log-1", CreatedAt: from.Add(time.Hour), Type: "USER", UserID: "user-1", ProjectID: "proj-1", ResourceType: "project", ResourceID: "proj-1", Action: "delete", Before: `{"name":"checkout"}`},
+		{ID: "log-2", CreatedAt: from.Add(2 * time.Hour), Type: "API_KEY", ApiKeyID: "key-1", ResourceType: "apiKey", ResourceID: "key-2", Action: "delete"},
+	}, nil)
+
+	schemaResp, config := auditLogsConfig(ctx, t, d, map[string]tftypes.Value{
+		"action": tftypes.NewValue(tftypes.String, "delete"),
+		"from":   tftypes.NewValue(tftypes.String, "2026-03-01T00:00:00Z"),
+	})
+	readResp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics from Read: %v", readResp.Diagnostics)
+	}
+
+	var data auditLogsDataSourceModel
+	readResp.Diagnostics.Append(readResp.State.Get(ctx, &data)...)
+	if len(data.Entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(data.Entries))
+	}
+	if data.Entries[0].Actor.ValueString() != "user-1" || data.Entries[0].CreatedAt.ValueString() != "2026-03-01T01:00:00Z" || !data.Entries[0].After.IsNull() {
+		t.Errorf("unexpected first entry: %+v", data.Entries[0])
+	}
+	if data.Entries[1].Actor.ValueString() != "key-1" || data.Entries[1].ActorType.ValueString() != "API_KEY" || !data.Entries[1].ProjectID.IsNull() {
+		t.Errorf("unexpected second entry: %+v", data.Entries[1])
+	}
+}
+
+func TestAuditLogsDataSourceErrors(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("invalid time", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		d := NewAuditLogsDataSource().(*auditLogsDataSource)
+		d.Configure(ctx, datasource.ConfigureRequest{ProviderData: mocks.NewMockClientFactory(ctrl)}, &datasource.ConfigureResponse{})
+
+		schemaResp, config := auditLogsConfig(ctx, t, d, map[string]tftypes.Value{
+			"to": tftypes.NewValue(tftypes.String, "yesterday"),
+		})
+		readResp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+		d.Read(ctx, datasource.ReadRequest{Config: config}, &readResp)
+		if !readResp.Diagnostics.HasError() {
+			t.Fatal("expected an error for an invalid time")
+		}
+	})
+
+	t.Run("unavailable", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		d := NewAuditLogsDataSource().(*auditLogsDataSource)
+		clientFactory := mocks.NewMockClientFactory(ctrl)
+		d.Configure(ctx, datasource.ConfigureRequest{ProviderData: clientFactory}, &datasource.ConfigureResponse{})
+		clientFactory.OrganizationClient.EXPECT().ListAuditLogs(ctx, gomock.Any()).Return(nil, langfuse.ErrAuditLogUnavailable)
+
+		schemaResp, config := auditLogsConfig(ctx, t, d, map[string]tftypes.Value{})
+		readResp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+		d.Read(ctx, datasource.ReadRequest{Config: config}, &readResp)
+		if !readResp.Diagnostics.HasError() || readResp.Diagnostics.Errors()[0].Summary() != "Audit log not available" {
+			t.Fatalf("expected an audit log not available error, got: %v", readResp.Diagnostics)
+		}
+	})
+}
@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+)
+
+// addClientError records a failed Langfuse API call. Read-only refusals, authentication and
+// authorization failures get their own summary so they are not mistaken for problems with the resource itself.
+func addClientError(diags *diag.Diagnostics, summary string, err error) {
+	var readOnlyErr *langfuse.ReadOnlyError
+	if errors.As(err, &readOnlyErr) {
+		diags.AddError(summary+": provider is read-only", readOnlyErr.Error())
+		return
+	}
+
+	var authErr *langfuse.AuthError
+	if errors.As(err, &authErr) {
+		if authErr.IsAuthenticationFailure() {
+			diags.AddError(summary+": authentication failed", err.Error())
+		} else {
+			diags.AddError(summary+": permission denied", err.Error())
+		}
+		return
+	}
+
+	// Say whether the failure is transient, so infrastructure problems can be told apart from
+	// requests Langfuse rejects because of the configuration.
+	var retryErr *langfuse.RetryError
+	var apiErr *langfuse.APIError
+	switch {
+	case errors.As(err, &retryErr), errors.As(err, &apiErr) && apiErr.Attempts > 1 && apiErr.Transient():
+		diags.AddError(summary+": transient error persisted", err.Error()+"\n\nThe request failed temporarily on every attempt, which points to "+
+			"the network, a proxy or the availability of Langfuse rather than to the configuration. Apply again later, or raise the "+
+			"provider retry settings.")
+	case errors.As(err, &apiErr) && apiErr.StatusCode < http.StatusInternalServerError && !apiErr.Transient():
+		diags.AddError(summary, err.Error()+"\n\nLangfuse rejected the request; it was not retried because sending it again cannot succeed.")
+	default:
+		diags.AddError(summary, err.Error())
+	}
+}
+
+// addClientFieldErrors records a failed Langfuse API call like addClientError, but reports the
+// field-level validation errors of a rejected request body on the attributes they belong to, so
+// users see which attribute caused the 400. fields maps request body fields to attribute paths;
+// nested fields such as "metadata.env" are matched by their first element. When a field error
+// cannot be mapped, the whole error is reported as addClientError does.
+func addClientFieldErrors(diags *diag.Diagnostics, summary string, err error, fields map[string]path.Path) {
+	var apiErr *langfuse.APIError
+	if !errors.As(err, &apiErr) {
+		addClientError(diags, summary, err)
+		return
+	}
+	fieldErrors := apiErr.FieldErrors()
+	attributes := make([]path.Path, len(fieldErrors))
+	for i, fieldError := range fieldErrors {
+		attribute, ok := fields[fieldError.Field]
+		if !ok {
+			attribute, ok = fields[strings.SplitN(fieldError.Field, ".", 2)[0]]
+		}
+		if !ok {
+			addClientError(diags, summary, err)
+			return
+		}
+		attributes[i] = attribute
+	}
+	if len(fieldErrors) == 0 {
+		addClientError(diags, summary, err)
+		return
+	}
+
+	requestID := ""
+	if apiErr.RequestID != "" {
+		requestID = fmt.Sprintf(" (request ID: %s)", apiErr.RequestID)
+	}
+	for i, fieldError := range fieldErrors {
+		diags.AddAttributeError(attributes[i], summary+": invalid value",
+			fmt.Sprintf("Langfuse rejected %q: %s%s", fieldError.Field, fieldError.Message, requestID))
+	}
+}
+
+// addRunWarnings adds the warnings the client factory collected while the operation ran.
+// Resources and data sources defer it in their operations.
+func addRunWarnings(diags *diag.Diagnostics, clientFactory langfuse.ClientFactory) {
+	addRateLimitWarning(diags, clientFactory)
+	addAuditLogWarning(diags, clientFactory)
+}
+
+// addRateLimitWarning warns once per provider run when requests have used most of the rate limit,
+// so parallelism can be reduced before requests start failing with 429 Too Many Requests.
+func addRateLimitWarning(diags *diag.Diagnostics, clientFactory langfuse.ClientFactory) {
+	if clientFactory == nil {
+		return
+	}
+	usage, ok := clientFactory.RateLimitWarning()
+	if !ok {
+		return
+	}
+
+	diags.AddWarning(
+		"Langfuse rate limit nearly exhausted",
+		fmt.Sprintf("Requests of this run used %.0f%% of the Langfuse rate limit (%d of %d requests remaining at the peak). "+
+			"Lower the parallelism of Terraform (-parallelism) or the provider's key_creation_concurrency, or ask for a higher limit, "+
+			"before requests start failing with 429 Too Many Requests.", usage.Used()*100, usage.Remaining, usage.Limit),
+	)
+}
+
+// addAuditLogWarning warns about mutations that were sent but could not be recorded in the audit
+// log. The operation itself succeeded, so its result is kept in state.
+func addAuditLogWarning(diags *diag.Diagnostics, clientFactory langfuse.ClientFactory) {
+	if clientFactory == nil {
+		return
+	}
+	failures := clientFactory.AuditLogFailures()
+	if len(failures) == 0 {
+		return
+	}
+
+	diags.AddWarning(
+		"Audit log incomplete",
+		fmt.Sprintf("The following requests were sent to Langfuse but could not be recorded in the audit log:\n\n%s\n\n"+
+			"Their results are kept in state. Check that audit_log_path is writable and add the missing entries by hand if the log is "+
+			"used for compliance.", strings.Join(failures, "\n")),
+	)
+}
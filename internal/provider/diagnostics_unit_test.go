@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse/mocks"
+)
+
+func TestAddClientError(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name            string
+		err             error
+		expectedSummary string
+	}{
+		{
+			name:            "generic error",
+			err:             fmt.Errorf("request failed with status code 500"),
+			expectedSummary: "Error creating project",
+		},
+		{
+			name: "authentication failure",
+			err: &langfuse.AuthError{
+				StatusCode: http.StatusUnauthorized,
+				Method:     http.MethodPost,
+				Path:       "/api/public/projects",
+				Credential: langfuse.CredentialTypeOrganizationKey,
+			},
+			expectedSummary: "Error creating project: authentication failed",
+		},
+		{
+			name: "wrapped authorization failure",
+			err: fmt.Errorf("failed to update membership: %w", &langfuse.AuthError{
+				StatusCode: http.StatusForbidden,
+				Method:     http.MethodPut,
+				Path:       "/api/public/organizations/memberships",
+				Credential: langfuse.CredentialTypeOrganizationKey,
+			}),
+			expectedSummary: "Error creating project: permission denied",
+		},
+		{
+			name: "read-only provider",
+			err: &url.Error{
+				Op:  "Post",
+				URL: "https://cloud.langfuse.com/api/public/projects",
+				Err: &langfuse.ReadOnlyError{Method: http.MethodPost, Path: "/api/public/projects"},
+			},
+			expectedSummary: "Error creating project: provider is read-only",
+		},
+		{
+			name: "retried status",
+			err: &langfuse.APIError{
+				StatusCode: http.StatusServiceUnavailable,
+				Method:     http.MethodPost,
+				Path:       "/api/public/projects",
+				Attempts:   5,
+				Elapsed:    32 * time.Second,
+			},
+			expectedSummary: "Error creating project: transient error persisted",
+		},
+		{
+			name: "retried network error",
+			err: &url.Error{
+				Op:  "Post",
+				URL: "https://cloud.langfuse.com/api/public/projects",
+				Err: &langfuse.RetryError{Attempts: 4, Method: http.MethodPost, Path: "/api/public/projects", Err: errors.New("connection refused")},
+			},
+			expectedSummary: "Error creating project: transient error persisted",
+		},
+		{
+			name: "permanent client error",
+			err: &langfuse.APIError{
+				StatusCode: http.StatusBadRequest,
+				Method:     http.MethodPost,
+				Path:       "/api/public/projects",
+			},
+			expectedSummary: "Error creating project",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			addClientError(&diags, "Error creating project", tc.err)
+
+			if len(diags.Errors()) != 1 {
+				t.Fatalf("expected exactly one error, got %v", diags)
+			}
+			if diags.Errors()[0].Summary() != tc.expectedSummary {
+				t.Errorf("unexpected summary. got %q, want %q", diags.Errors()[0].Summary(), tc.expectedSummary)
+			}
+		})
+	}
+}
+
+func TestAddClientFieldErrors(t *testing.T) {
+	t.Parallel()
+
+	validationErr := func(body string) error {
+		return fmt.Errorf("failed to create project: %w", &langfuse.APIError{StatusCode: http.StatusBadRequest, Body: body, RequestID: "req-1"})
+	}
+
+	t.Run("mapped fields", func(t *testing.T) {
+		var diags diag.Diagnostics
+		addClientFieldErrors(&diags, "Error creating project", validationErr(`{"message":"Invalid request data","error":[`+
+			`{"path":["retention"],"message":"Number must be greater than or equal to 3"},{"path":["metadata","env"],"message":"Expected string"}]}`), projectRequestFields)
+
+		errs := diags.Errors()
+		if len(errs) != 2 {
+			t.Fatalf("expected 2 errors, got %v", diags)
+		}
+		retention, ok := errs[0].(diag.DiagnosticWithPath)
+		if !ok || !retention.Path().Equal(path.Root("retention_days")) || errs[0].Summary() != "Error creating project: invalid value" ||
+			!strings.Contains(errs[0].Detail(), "greater than or equal to 3") || !strings.Contains(errs[0].Detail(), "req-1") {
+			t.Errorf("unexpected retention error: %v", errs[0])
+		}
+		if metadata, ok := errs[1].(diag.DiagnosticWithPath); !ok || !metadata.Path().Equal(path.Root("metadata")) {
+			t.Errorf("unexpected metadata error: %v", errs[1])
+		}
+	})
+
+	t.Run("unmapped field", func(t *testing.T) {
+		var diags diag.Diagnostics
+		addClientFieldErrors(&diags, "Error creating project", validationErr(`{"error":[{"path":["orgId"],"message":"Required"}]}`), projectRequestFields)
+		if len(diags.Errors()) != 1 || diags.Errors()[0].Summary() != "Error creating project" {
+			t.Fatalf("expected a plain client error, got %v", diags)
+		}
+		if _, ok := diags.Errors()[0].(diag.DiagnosticWithPath); ok {
+			t.Errorf("expected no attribute path, got %v", diags.Errors()[0])
+		}
+	})
+
+	t.Run("no field errors", func(t *testing.T) {
+		var diags diag.Diagnostics
+		addClientFieldErrors(&diags, "Error creating project", validationErr("Bad Request"), projectRequestFields)
+		if len(diags.Errors()) != 1 || diags.Errors()[0].Summary() != "Error creating project" {
+			t.Fatalf("expected a plain client error, got %v", diags)
+		}
+	})
+}
+
+func TestAddRateLimitWarning(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	clientFactory := mocks.NewMockClientFactory(ctrl)
+	clientFactory.RateLimit = &langfuse.RateLimitUsage{Limit: 1000, Remaining: 50}
+
+	var diags diag.Diagnostics
+	addRateLimitWarning(&diags, clientFactory)
+	addRateLimitWarning(&diags, clientFactory)
+
+	if diags.WarningsCount() != 1 {
+		t.Fatalf("expected a single warning, got %v", diags)
+	}
+	if !strings.Contains(diags[0].Detail(), "95%") {
+		t.Errorf("expected the usage in the warning detail, got %q", diags[0].Detail())
+	}
+}
+
+func TestAddAuditLogWarning(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	clientFactory := mocks.NewMockClientFactory(ctrl)
+	clientFactory.AuditFailures = []string{"POST /api/public/projects: no space left on device"}
+
+	var diags diag.Diagnostics
+	addRunWarnings(&diags, clientFactory)
+	addRunWarnings(&diags, clientFactory)
+
+	if diags.HasError() || diags.WarningsCount() != 1 || diags[0].Summary() != "Audit log incomplete" {
+		t.Fatalf("expected a single audit log warning, got %v", diags)
+	}
+	if !strings.Contains(diags[0].Detail(), "POST /api/public/projects") {
+		t.Errorf("expected the request in the warning detail, got %q", diags[0].Detail())
+	}
+}
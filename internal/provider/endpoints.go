@@ -0,0 +1,14 @@
+package provider
+
+import "strings"
+
+// ingestionEndpoint returns the REST ingestion endpoint of the Langfuse instance at host.
+func ingestionEndpoint(host string) string {
+	return strings.TrimSuffix(host, "/") + "/api/public/ingestion"
+}
+
+// otlpEndpoint returns the OTLP/HTTP endpoint of the OpenTelemetry ingestion of the Langfuse
+// instance at host, for OTEL_EXPORTER_OTLP_ENDPOINT.
+func otlpEndpoint(host string) string {
+	return strings.TrimSuffix(host, "/") + "/api/public/otel"
+}
@@ -0,0 +1,19 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+)
+
+// skipRefreshLookup reports whether Read may keep the state of a resource that is looked up
+// through list endpoints (API keys, memberships) instead of calling Langfuse. That is the case
+// when the provider sets fast_refresh and the state is complete, i.e. was not just imported.
+func skipRefreshLookup(ctx context.Context, clientFactory langfuse.ClientFactory, typeName string, complete bool) bool {
+	if clientFactory == nil || !clientFactory.FastRefresh() || !complete {
+		return false
+	}
+	tflog.Debug(ctx, "Skipping refresh lookup because fast_refresh is set", map[string]any{"type": typeName})
+	return true
+}
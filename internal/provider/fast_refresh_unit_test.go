@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse/mocks"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestFastRefresh(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	clientFactory := mocks.NewMockClientFactory(ctrl)
+	clientFactory.BaseURL = "http://localhost:3000"
+	clientFactory.SkipListRefresh = true
+
+	stateFor := func(r resource.Resource, values map[string]tftypes.Value) tfsdk.State {
+		var schemaResp resource.SchemaResponse
+		r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+		objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+		for name, attributeType := range objectType.AttributeTypes {
+			if _, ok := values[name]; !ok {
+				values[name] = tftypes.NewValue(attributeType, nil)
+			}
+		}
+		return tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+	}
+
+	// No client expectations are set: any lookup fails the test.
+	resources := map[string]struct {
+		resource resource.Resource
+		values   map[string]tftypes.Value
+	}{
+		"organization API key": {
+			resource: NewOrganizationApiKeyResource(),
+			values: map[string]tftypes.Value{
+				"id":              tftypes.NewValue(tftypes.String, "oak-123"),
+				"organization_id": tftypes.NewValue(tftypes.String, "org-123"),
+				"public_key":      tftypes.NewValue(tftypes.String, "pk-lf-123"),
+			},
+		},
+		"project API key": {
+			resource: NewProjectApiKeyResource(),
+			values: map[string]tftypes.Value{
+				"id":                       tftypes.NewValue(tftypes.String, "key-123"),
+				"project_id":               tftypes.NewValue(tftypes.String, "proj-123"),
+				"public_key":               tftypes.NewValue(tftypes.String, "pk-lf-456"),
+				"secret_key":               tftypes.NewValue(tftypes.String, "sk-lf-456"),
+				"organization_public_key":  tftypes.NewValue(tftypes.String, "pk-org"),
+				"organization_private_key": tftypes.NewValue(tftypes.String, "sk-org"),
+			},
+		},
+		"membership": {
+			resource: NewOrganizationMembershipResource(),
+			values: map[string]tftypes.Value{
+				"id":                       tftypes.NewValue(tftypes.String, "user-123"),
+				"email":                    tftypes.NewValue(tftypes.String, "test@example.com"),
+				"role":                     tftypes.NewValue(tftypes.String, "MEMBER"),
+				"user_id":                  tftypes.NewValue(tftypes.String, "user-123"),
+				"organization_public_key":  tftypes.NewValue(tftypes.String, "pk-org"),
+				"organization_private_key": tftypes.NewValue(tftypes.String, "sk-org"),
+			},
+		},
+	}
+
+	for name, tc := range resources {
+		t.Run(name, func(t *testing.T) {
+			tc.resource.(resource.ResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{ProviderData: clientFactory}, &resource.ConfigureResponse{})
+
+			state := stateFor(tc.resource, tc.values)
+			readResp := resource.ReadResponse{State: state}
+			tc.resource.Read(ctx, resource.ReadRequest{State: state}, &readResp)
+			if readResp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics from Read: %v", readResp.Diagnostics)
+			}
+			if readResp.State.Raw.IsNull() {
+				t.Fatal("expected the resource to stay in state")
+			}
+		})
+	}
+
+	t.Run("imported key is still read", func(t *testing.T) {
+		r := NewOrganizationApiKeyResource().(*organizationApiKeyResource)
+		r.Configure(ctx, resource.ConfigureRequest{ProviderData: clientFactory}, &resource.ConfigureResponse{})
+		clientFactory.AdminClient.EXPECT().GetOrganizationApiKey(ctx, "org-123", "oak-123").Return(&langfuse.OrganizationApiKey{ID: "oak-123", PublicKey: "pk-lf-123"}, nil)
+
+		state := stateFor(r, map[string]tftypes.Value{
+			"id":              tftypes.NewValue(tftypes.String, "oak-123"),
+			"organization_id": tftypes.NewValue(tftypes.String, "org-123"),
+		})
+		readResp := resource.ReadResponse{State: state}
+		r.Read(ctx, resource.ReadRequest{State: state}, &readResp)
+		if readResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics from Read: %v", readResp.Diagnostics)
+		}
+	})
+}
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+)
+
+// hostOverrideAttribute is the host attribute of resources that may live on another Langfuse
+// instance than the provider's, e.g. while a root module manages two installations during a
+// migration. Moving an object between instances means creating it anew, so a change replaces it.
+func hostOverrideAttribute(object string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional: true,
+		Description: fmt.Sprintf("Base URI of the Langfuse instance the %s lives on, when it is not the provider's. The admin API key and "+
+			"LANGFUSE_ORG_PUBLIC_KEY/LANGFUSE_ORG_SECRET_KEY belong to the provider's instance, so set the organization key pair or "+
+			"`credential_ref` as well. Changing it replaces the %s.", object, object),
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// validateHostOverride checks that a host attribute, when set, is an absolute http(s) URL.
+func validateHostOverride(host types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if host.IsNull() || host.IsUnknown() {
+		return diags
+	}
+
+	u, err := url.Parse(host.ValueString())
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		diags.AddAttributeError(
+			path.Root("host"),
+			"Invalid host",
+			fmt.Sprintf("host must be the base URI of a Langfuse instance, such as https://langfuse.example.com, got %q.", host.ValueString()),
+		)
+	}
+	return diags
+}
+
+// clientFactoryForHost returns the client factory for the Langfuse instance a resource lives on: the
+// provider's, or that of the instance named by the resource's host attribute.
+func clientFactoryForHost(clientFactory langfuse.ClientFactory, host types.String) langfuse.ClientFactory {
+	if host.IsNull() || host.IsUnknown() || host.ValueString() == "" {
+		return clientFactory
+	}
+	return clientFactory.ForHost(host.ValueString())
+}
+
+// hostOverridePrivateKey is the private state key under which resources whose computed host
+// normally follows the provider's remember that it was overridden.
+const hostOverridePrivateKey = "host_override"
+
+// privateStateReader is the read side of a resource's private state.
+type privateStateReader interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+// privateHostOverride returns the host recorded under hostOverridePrivateKey, or null when the
+// resource lives on the provider's instance.
+func privateHostOverride(ctx context.Context, private privateStateReader) (types.String, diag.Diagnostics) {
+	data, diags := private.GetKey(ctx, hostOverridePrivateKey)
+	if diags.HasError() || data == nil {
+		return types.StringNull(), diags
+	}
+
+	var host string
+	if err := json.Unmarshal(data, &host); err != nil {
+		diags.AddError("Error reading host override", err.Error())
+		return types.StringNull(), diags
+	}
+	return types.StringValue(host), diags
+}
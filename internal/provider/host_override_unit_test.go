@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type fakePrivateState map[string][]byte
+
+func (p fakePrivateState) GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+func TestValidateHostOverride(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		host    types.String
+		wantErr bool
+	}{
+		{host: types.StringNull()},
+		{host: types.StringUnknown()},
+		{host: types.StringValue("https://langfuse.example.com")},
+		{host: types.StringValue("http://localhost:3000/")},
+		{host: types.StringValue("langfuse.example.com"), wantErr: true},
+		{host: types.StringValue("ftp://langfuse.example.com"), wantErr: true},
+		{host: types.StringValue("https://"), wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		if diags := validateHostOverride(tc.host); diags.HasError() != tc.wantErr {
+			t.Errorf("validateHostOverride(%s): expected error %t, got: %v", tc.host, tc.wantErr, diags)
+		}
+	}
+}
+
+func TestPrivateHostOverride(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	host, diags := privateHostOverride(ctx, fakePrivateState{})
+	if diags.HasError() || !host.IsNull() {
+		t.Errorf("expected no override, got %s: %v", host, diags)
+	}
+
+	host, diags = privateHostOverride(ctx, fakePrivateState{hostOverridePrivateKey: []byte(`"https://langfuse-new.example.com"`)})
+	if diags.HasError() || host.ValueString() != "https://langfuse-new.example.com" {
+		t.Errorf("unexpected override %s: %v", host, diags)
+	}
+
+	if _, diags = privateHostOverride(ctx, fakePrivateState{hostOverridePrivateKey: []byte(`{}`)}); !diags.HasError() {
+		t.Errorf("expected an error for a malformed override")
+	}
+}
@@ -0,0 +1,340 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+)
+
+var _ datasource.DataSource = &importInventoryDataSource{}
+
+func NewImportInventoryDataSource() datasource.DataSource {
+	return &importInventoryDataSource{}
+}
+
+type importInventoryDataSourceModel struct {
+	ID                      types.String                       `tfsdk:"id"`
+	OrganizationCredentials types.Map                          `tfsdk:"organization_credentials"`
+	Organizations           []importInventoryOrganizationModel `tfsdk:"organizations"`
+	OrganizationApiKeys     []importInventoryApiKeyModel       `tfsdk:"organization_api_keys"`
+	Projects                []importInventoryProjectModel      `tfsdk:"projects"`
+	ProjectApiKeys          []importInventoryApiKeyModel       `tfsdk:"project_api_keys"`
+	Memberships             []importInventoryMembershipModel   `tfsdk:"memberships"`
+	ImportBlocks            types.String                       `tfsdk:"import_blocks"`
+}
+
+type importInventoryOrganizationModel struct {
+	ID       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	ImportID types.String `tfsdk:"import_id"`
+}
+
+type importInventoryProjectModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+	ImportID       types.String `tfsdk:"import_id"`
+}
+
+type importInventoryMembershipModel struct {
+	UserID         types.String `tfsdk:"user_id"`
+	Email          types.String `tfsdk:"email"`
+	Role           types.String `tfsdk:"role"`
+	OrganizationID types.String `tfsdk:"organization_id"`
+	ImportID       types.String `tfsdk:"import_id"`
+}
+
+type importInventoryApiKeyModel struct {
+	ID        types.String `tfsdk:"id"`
+	ParentID  types.String `tfsdk:"parent_id"`
+	PublicKey types.String `tfsdk:"public_key"`
+	Note      types.String `tfsdk:"note"`
+}
+
+type importInventoryDataSource struct {
+	ClientFactory langfuse.ClientFactory
+}
+
+func (d *importInventoryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clientFactory, ok := req.ProviderData.(langfuse.ClientFactory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected langfuse.ClientFactory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.ClientFactory = clientFactory
+	resp.Diagnostics.Append(checkAdminAPIKeyConfigured(clientFactory, "langfuse_import_inventory")...)
+}
+
+func (d *importInventoryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_import_inventory"
+}
+
+func (d *importInventoryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := importInventoryAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Computed: true,
+	}
+	attributes["organization_credentials"] = schema.MapAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+		Description: "Map of organization ID to the name of provider-level `credentials` for that organization. " +
+			"Projects, project API keys and memberships are only listed for organizations present in this map.",
+	}
+	attributes["import_blocks"] = schema.StringAttribute{
+		Computed:    true,
+		Description: "Terraform `import` blocks for every importable object, ready to paste into a configuration.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Enumerates an existing Langfuse estate with the admin API key and emits the import IDs accepted by this provider's resources. " +
+			"API keys are listed for reference only: their secrets cannot be read back, so they cannot be imported.",
+		Attributes: attributes,
+	}
+}
+
+// importInventoryAttributes returns the computed object lists of an inventory, shared by the data
+// sources that enumerate the estate.
+func importInventoryAttributes() map[string]schema.Attribute {
+	apiKeyAttributes := map[string]schema.Attribute{
+		"id":         schema.StringAttribute{Computed: true},
+		"parent_id":  schema.StringAttribute{Computed: true, Description: "The organization or project the key belongs to."},
+		"public_key": schema.StringAttribute{Computed: true},
+		"note":       schema.StringAttribute{Computed: true},
+	}
+
+	return map[string]schema.Attribute{
+		"organizations": schema.ListNestedAttribute{
+			Computed: true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id":        schema.StringAttribute{Computed: true},
+					"name":      schema.StringAttribute{Computed: true},
+					"import_id": schema.StringAttribute{Computed: true, Description: "Import ID for `langfuse_organization`."},
+				},
+			},
+		},
+		"organization_api_keys": schema.ListNestedAttribute{
+			Computed:     true,
+			NestedObject: schema.NestedAttributeObject{Attributes: apiKeyAttributes},
+		},
+		"projects": schema.ListNestedAttribute{
+			Computed: true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id":              schema.StringAttribute{Computed: true},
+					"name":            schema.StringAttribute{Computed: true},
+					"organization_id": schema.StringAttribute{Computed: true},
+					"import_id":       schema.StringAttribute{Computed: true, Description: "Import ID for `langfuse_project` (`project_id,organization_id,credential_ref`)."},
+				},
+			},
+		},
+		"project_api_keys": schema.ListNestedAttribute{
+			Computed:     true,
+			NestedObject: schema.NestedAttributeObject{Attributes: apiKeyAttributes},
+		},
+		"memberships": schema.ListNestedAttribute{
+			Computed: true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"user_id":         schema.StringAttribute{Computed: true},
+					"email":           schema.StringAttribute{Computed: true},
+					"role":            schema.StringAttribute{Computed: true},
+					"organization_id": schema.StringAttribute{Computed: true},
+					"import_id":       schema.StringAttribute{Computed: true, Description: "Import ID for `langfuse_organization_membership` (`user_id,credential_ref`)."},
+				},
+			},
+		},
+	}
+}
+
+func (d *importInventoryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	resp.Diagnostics.Append(checkInstanceVersion(ctx, d.ClientFactory, "langfuse_import_inventory")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data importInventoryDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationCredentials := make(map[string]string)
+	if !data.OrganizationCredentials.IsNull() {
+		resp.Diagnostics.Append(data.OrganizationCredentials.ElementsAs(ctx, &organizationCredentials, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	blocks := newImportBlockWriter()
+	resp.Diagnostics.Append(readImportInventory(ctx, d.ClientFactory, organizationCredentials, &data, blocks)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(d.ClientFactory.Host())
+	data.ImportBlocks = types.StringValue(blocks.String())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// readImportInventory lists the organizations and organization API keys of the instance, and the
+// projects, project API keys and memberships of the organizations in organizationCredentials, into
+// data. Import blocks for the importable objects are written to blocks.
+func readImportInventory(ctx context.Context, clientFactory langfuse.ClientFactory, organizationCredentials map[string]string, data *importInventoryDataSourceModel, blocks *importBlockWriter) diag.Diagnostics {
+	diags := checkProviderConfigurationKnown(clientFactory)
+	if diags.HasError() {
+		return diags
+	}
+
+	adminClient := clientFactory.NewAdminClient()
+	organizations, err := adminClient.ListOrganizations(ctx)
+	if err != nil {
+		addClientError(&diags, "Error listing organizations", err)
+		return diags
+	}
+	sort.Slice(organizations, func(i, j int) bool { return organizations[i].ID < organizations[j].ID })
+
+	data.Organizations = []importInventoryOrganizationModel{}
+	data.OrganizationApiKeys = []importInventoryApiKeyModel{}
+	data.Projects = []importInventoryProjectModel{}
+	data.ProjectApiKeys = []importInventoryApiKeyModel{}
+	data.Memberships = []importInventoryMembershipModel{}
+
+	for _, organization := range organizations {
+		data.Organizations = append(data.Organizations, importInventoryOrganizationModel{
+			ID:       types.StringValue(organization.ID),
+			Name:     types.StringValue(organization.Name),
+			ImportID: types.StringValue(organization.ID),
+		})
+		blocks.add("langfuse_organization", organization.Name, organization.ID)
+
+		apiKeys, err := adminClient.ListOrganizationApiKeys(ctx, organization.ID)
+		if err != nil {
+			addClientError(&diags, fmt.Sprintf("Error listing API keys of organization %s", organization.ID), err)
+			return diags
+		}
+		for _, key := range apiKeys {
+			data.OrganizationApiKeys = append(data.OrganizationApiKeys, importInventoryApiKeyModel{
+				ID:        types.StringValue(key.ID),
+				ParentID:  types.StringValue(organization.ID),
+				PublicKey: types.StringValue(key.PublicKey),
+				Note:      types.StringValue(key.Note),
+			})
+		}
+
+		credentialRef, ok := organizationCredentials[organization.ID]
+		if !ok {
+			continue
+		}
+		organizationClient, clientDiags := newOrganizationClient(clientFactory, types.StringNull(), types.StringNull(), types.StringValue(credentialRef))
+		if clientDiags.HasError() {
+			for _, diagnostic := range clientDiags {
+				diags.AddAttributeError(path.Root("organization_credentials").AtMapKey(organization.ID), diagnostic.Summary(), diagnostic.Detail())
+			}
+			return diags
+		}
+
+		projects, err := organizationClient.ListProjects(ctx)
+		if err != nil {
+			addClientError(&diags, fmt.Sprintf("Error listing projects of organization %s", organization.ID), err)
+			return diags
+		}
+		sort.Slice(projects, func(i, j int) bool { return projects[i].ID < projects[j].ID })
+		for _, project := range projects {
+			importID := strings.Join([]string{project.ID, organization.ID, credentialRef}, ",")
+			data.Projects = append(data.Projects, importInventoryProjectModel{
+				ID:             types.StringValue(project.ID),
+				Name:           types.StringValue(project.Name),
+				OrganizationID: types.StringValue(organization.ID),
+				ImportID:       types.StringValue(importID),
+			})
+			blocks.add("langfuse_project", project.Name, importID)
+
+			projectApiKeys, err := organizationClient.ListProjectApiKeys(ctx, project.ID)
+			if err != nil {
+				addClientError(&diags, fmt.Sprintf("Error listing API keys of project %s", project.ID), err)
+				return diags
+			}
+			for _, key := range projectApiKeys {
+				data.ProjectApiKeys = append(data.ProjectApiKeys, importInventoryApiKeyModel{
+					ID:        types.StringValue(key.ID),
+					ParentID:  types.StringValue(project.ID),
+					PublicKey: types.StringValue(key.PublicKey),
+					Note:      types.StringValue(key.Note),
+				})
+			}
+		}
+
+		memberships, err := organizationClient.ListMemberships(ctx)
+		if err != nil {
+			addClientError(&diags, fmt.Sprintf("Error listing memberships of organization %s", organization.ID), err)
+			return diags
+		}
+		for _, membership := range memberships {
+			importID := strings.Join([]string{membership.UserID, credentialRef}, ",")
+			data.Memberships = append(data.Memberships, importInventoryMembershipModel{
+				UserID:         types.StringValue(membership.UserID),
+				Email:          types.StringValue(membership.Email),
+				Role:           types.StringValue(membership.Role),
+				OrganizationID: types.StringValue(organization.ID),
+				ImportID:       types.StringValue(importID),
+			})
+			blocks.add("langfuse_organization_membership", membership.Email, importID)
+		}
+	}
+
+	return diags
+}
+
+var nonIdentifierCharacters = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// importBlockWriter renders Terraform import blocks, deriving unique resource names from display names.
+type importBlockWriter struct {
+	builder strings.Builder
+	used    map[string]int
+}
+
+func newImportBlockWriter() *importBlockWriter {
+	return &importBlockWriter{used: make(map[string]int)}
+}
+
+func (w *importBlockWriter) add(resourceType, displayName, importID string) {
+	name := strings.Trim(nonIdentifierCharacters.ReplaceAllString(strings.ToLower(displayName), "_"), "_")
+	if name == "" {
+		name = "unnamed"
+	} else if name[0] >= '0' && name[0] <= '9' {
+		name = "r_" + name
+	}
+
+	address := resourceType + "." + name
+	w.used[address]++
+	if count := w.used[address]; count > 1 {
+		address = fmt.Sprintf("%s_%d", address, count)
+	}
+
+	fmt.Fprintf(&w.builder, "import {\n  to = %s\n  id = %q\n}\n\n", address, importID)
+}
+
+func (w *importBlockWriter) String() string {
+	return w.builder.String()
+}
@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse/mocks"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestImportInventoryDataSourceSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	d := NewImportInventoryDataSource()
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics from Schema: %v", schemaResp.Diagnostics)
+	}
+
+	if diags := schemaResp.Schema.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("schema implementation validation failed: %v", diags)
+	}
+}
+
+func TestImportInventoryDataSourceRead(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	d := NewImportInventoryDataSource().(*importInventoryDataSource)
+	clientFactory := mocks.NewMockClientFactory(ctrl)
+	clientFactory.Credentials["acme"] = langfuse.OrganizationCredentials{PublicKey: "pk-org", PrivateKey: "sk-org"}
+	d.Configure(ctx, datasource.ConfigureRequest{ProviderData: clientFactory}, &datasource.ConfigureResponse{})
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+	clientFactory.AdminClient.EXPECT().ListOrganizations(ctx).Return([]*langfuse.Organization{
+		{ID: "org-2", Name: "Other"},
+		{ID: "org-1", Name: "Acme Inc"},
+	}, nil)
+	clientFactory.AdminClient.EXPECT().ListOrganizationApiKeys(ctx, "org-1").Return([]langfuse.OrganizationApiKey{{ID: "oak-1", PublicKey: "pk-lf-1"}}, nil)
+	clientFactory.AdminClient.EXPECT().ListOrganizationApiKeys(ctx, "org-2").Return(nil, nil)
+	clientFactory.OrganizationClient.EXPECT().ListProjects(ctx).Return([]*langfuse.Project{{ID: "proj-1", Name: "Chat QA"}}, nil)
+	clientFactory.OrganizationClient.EXPECT().ListProjectApiKeys(ctx, "proj-1").Return([]langfuse.ProjectApiKey{{ID: "pak-1", PublicKey: "pk-lf-2", Note: "ci"}}, nil)
+	clientFactory.OrganizationClient.EXPECT().ListMemberships(ctx).Return([]langfuse.OrganizationMembership{{UserID: "user-1", Email: "jane@example.com", Role: "ADMIN"}}, nil)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{
+		"organization_credentials": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"org-1": tftypes.NewValue(tftypes.String, "acme"),
+		}),
+	}
+	for name, attributeType := range objectType.AttributeTypes {
+		if _, ok := values[name]; !ok {
+			values[name] = tftypes.NewValue(attributeType, nil)
+		}
+	}
+
+	readResp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics from Read: %v", readResp.Diagnostics)
+	}
+
+	var data importInventoryDataSourceModel
+	readResp.Diagnostics.Append(readResp.State.Get(ctx, &data)...)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics reading state: %v", readResp.Diagnostics)
+	}
+
+	if len(data.Organizations) != 2 || data.Organizations[0].ID.ValueString() != "org-1" {
+		t.Errorf("unexpected organizations: %v", data.Organizations)
+	}
+	if len(data.Projects) != 1 || data.Projects[0].ImportID.ValueString() != "proj-1,org-1,acme" {
+		t.Errorf("unexpected projects: %v", data.Projects)
+	}
+	if len(data.OrganizationApiKeys) != 1 || len(data.ProjectApiKeys) != 1 || len(data.Memberships) != 1 {
+		t.Errorf("unexpected keys or memberships: %v %v %v", data.OrganizationApiKeys, data.ProjectApiKeys, data.Memberships)
+	}
+
+	for _, expected := range []string{
+		"to = langfuse_organization.acme_inc\n  id = \"org-1\"",
+		"to = langfuse_project.chat_qa\n  id = \"proj-1,org-1,acme\"",
+		"to = langfuse_organization_membership.jane_example_com\n  id = \"user-1,acme\"",
+	} {
+		if !strings.Contains(data.ImportBlocks.ValueString(), expected) {
+			t.Errorf("import blocks do not contain %q:\n%s", expected, data.ImportBlocks.ValueString())
+		}
+	}
+}
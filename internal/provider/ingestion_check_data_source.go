@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+)
+
+var _ datasource.DataSource = &ingestionCheckDataSource{}
+
+const (
+	defaultIngestionCheckTraceName = "terraform-ingestion-check"
+	defaultIngestionCheckTimeout   = time.Minute
+	ingestionCheckPollInterval     = 2 * time.Second
+)
+
+func NewIngestionCheckDataSource() datasource.DataSource {
+	return &ingestionCheckDataSource{}
+}
+
+type ingestionCheckDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	PublicKey   types.String `tfsdk:"public_key"`
+	SecretKey   types.String `tfsdk:"secret_key"`
+	TraceName   types.String `tfsdk:"trace_name"`
+	Environment types.String `tfsdk:"environment"`
+	Timeout     types.String `tfsdk:"timeout"`
+	Ingested    types.Bool   `tfsdk:"ingested"`
+}
+
+type ingestionCheckDataSource struct {
+	ClientFactory langfuse.ClientFactory
+}
+
+func (d *ingestionCheckDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clientFactory, ok := req.ProviderData.(langfuse.ClientFactory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected langfuse.ClientFactory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.ClientFactory = clientFactory
+}
+
+func (d *ingestionCheckDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ingestion_check"
+}
+
+func (d *ingestionCheckDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Sends a test trace with a project API key pair and waits until Langfuse has ingested it, failing otherwise. " +
+			"Reference the keys of a `langfuse_project_api_key` to verify during apply that a new project is usable. Every read sends a new trace.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the test trace.",
+			},
+			"public_key": schema.StringAttribute{
+				Required:    true,
+				Description: "Public key of the project API key to ingest with.",
+			},
+			"secret_key": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "Secret key of the project API key to ingest with.",
+			},
+			"trace_name": schema.StringAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Name of the test trace. Defaults to `%s`.", defaultIngestionCheckTraceName),
+			},
+			"environment": schema.StringAttribute{
+				Optional: true,
+				Description: "Environment of the test trace, e.g. `production`. Langfuse has no API to declare environments; they appear once " +
+					"data has been ingested for them, so a check per environment makes the environment names available from day one. Defaults to `default`.",
+			},
+			"timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("How long to wait for the trace to be ingested, as a duration such as `2m`. Defaults to `%s`.", defaultIngestionCheckTimeout),
+			},
+			"ingested": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the trace was ingested. Always true, since the read fails otherwise.",
+			},
+		},
+	}
+}
+
+func (d *ingestionCheckDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	resp.Diagnostics.Append(checkInstanceVersion(ctx, d.ClientFactory, "langfuse_ingestion_check")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data ingestionCheckDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout := defaultIngestionCheckTimeout
+	if !data.Timeout.IsNull() {
+		parsed, err := time.ParseDuration(data.Timeout.ValueString())
+		if err != nil || parsed <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("timeout"),
+				"Invalid timeout",
+				fmt.Sprintf("timeout must be a positive duration such as \"2m\". Got: %s", data.Timeout.ValueString()),
+			)
+			return
+		}
+		timeout = parsed
+	}
+	traceName := defaultIngestionCheckTraceName
+	if !data.TraceName.IsNull() {
+		traceName = data.TraceName.ValueString()
+	}
+
+	runtime := runtimeOf(d.ClientFactory)
+	traceID, err := runtime.NewID()
+	if err != nil {
+		resp.Diagnostics.AddError("Error generating trace ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(checkProviderConfigurationKnown(d.ClientFactory)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectClient := d.ClientFactory.NewProjectClient(data.PublicKey.ValueString(), data.SecretKey.ValueString())
+	err = projectClient.CreateTrace(ctx, &langfuse.IngestionTrace{
+		ID:          traceID,
+		Name:        traceName,
+		Timestamp:   runtime.Now().UTC(),
+		Tags:        []string{"terraform"},
+		Environment: data.Environment.ValueString(),
+	})
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error sending test trace", err)
+		return
+	}
+
+	if err := waitForTrace(ctx, runtime, projectClient, traceID, timeout, ingestionCheckPollInterval); err != nil {
+		addClientError(&resp.Diagnostics, "Test trace was not ingested", err)
+		return
+	}
+
+	data.ID = types.StringValue(traceID)
+	data.Ingested = types.BoolValue(true)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// waitForTrace polls for the trace until it exists, the timeout passes or ctx is done.
+func waitForTrace(ctx context.Context, runtime langfuse.Runtime, client langfuse.ProjectClient, traceID string, timeout, interval time.Duration) error {
+	deadline := runtime.Now().Add(timeout)
+	for {
+		_, err := client.GetTrace(ctx, traceID)
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, langfuse.ErrNotFound) {
+			return err
+		}
+		if runtime.Now().Add(interval).After(deadline) {
+			return fmt.Errorf("trace %s was accepted but not ingested within %s; check the ingestion workers of the instance: %w", traceID, timeout, err)
+		}
+
+		select {
+		case <-runtime.After(interval):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse/mocks"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestIngestionCheckDataSourceRead(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	d := NewIngestionCheckDataSource().(*ingestionCheckDataSource)
+	clientFactory := mocks.NewMockClientFactory(ctrl)
+	d.Configure(ctx, datasource.ConfigureRequest{ProviderData: clientFactory}, &datasource.ConfigureResponse{})
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	if diags := schemaResp.Schema.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("schema implementation validation failed: %v", diags)
+	}
+
+	var traceID string
+	clientFactory.ProjectClient.EXPECT().CreateTrace(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, trace *langfuse.IngestionTrace) error {
+		if trace.Name != defaultIngestionCheckTraceName {
+			t.Errorf("unexpected trace name %q", trace.Name)
+		}
+		traceID = trace.ID
+		return nil
+	})
+	clientFactory.ProjectClient.EXPECT().GetTrace(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, id string) (*langfuse.Trace, error) {
+		return &langfuse.Trace{ID: id}, nil
+	})
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{
+		"public_key": tftypes.NewValue(tftypes.String, "pk-lf-1"),
+		"secret_key": tftypes.NewValue(tftypes.String, "sk-lf-1"),
+	}
+	for name, attributeType := range objectType.AttributeTypes {
+		if _, ok := values[name]; !ok {
+			values[name] = tftypes.NewValue(attributeType, nil)
+		}
+	}
+
+	readResp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics from Read: %v", readResp.Diagnostics)
+	}
+
+	var data ingestionCheckDataSourceModel
+	readResp.Diagnostics.Append(readResp.State.Get(ctx, &data)...)
+	if data.ID.ValueString() != traceID || !data.Ingested.ValueBool() {
+		t.Errorf("unexpected state: id=%s ingested=%s", data.ID, data.Ingested)
+	}
+	if !regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`).MatchString(traceID) {
+		t.Errorf("trace ID %q is not a UUID", traceID)
+	}
+}
+
+func TestWaitForTrace(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	notFound := fmt.Errorf("cannot find trace trace-1: %w", langfuse.ErrNotFound)
+
+	t.Run("ingested after retries", func(t *testing.T) {
+		client := mocks.NewMockProjectClient(ctrl)
+		gomock.InOrder(
+			client.EXPECT().GetTrace(ctx, "trace-1").Return(nil, notFound),
+			client.EXPECT().GetTrace(ctx, "trace-1").Return(nil, notFound),
+			client.EXPECT().GetTrace(ctx, "trace-1").Return(&langfuse.Trace{ID: "trace-1"}, nil),
+		)
+		if err := waitForTrace(ctx, langfuse.DefaultRuntime(), client, "trace-1", time.Second, time.Millisecond); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		client := mocks.NewMockProjectClient(ctrl)
+		client.EXPECT().GetTrace(ctx, "trace-1").Return(nil, notFound).MinTimes(1)
+		if err := waitForTrace(ctx, langfuse.DefaultRuntime(), client, "trace-1", 10*time.Millisecond, 5*time.Millisecond); err == nil {
+			t.Fatal("expected a timeout error")
+		}
+	})
+
+	t.Run("timeout on injected clock", func(t *testing.T) {
+		now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		runtime := langfuse.Runtime{
+			Now: func() time.Time { return now },
+			After: func(d time.Duration) <-chan time.Time {
+				now = now.Add(d)
+				ch := make(chan time.Time, 1)
+				ch <- now
+				return ch
+			},
+		}
+
+		client := mocks.NewMockProjectClient(ctrl)
+		client.EXPECT().GetTrace(ctx, "trace-1").Return(nil, notFound).Times(61) // at 0m, 1m, ..., 60m
+		if err := waitForTrace(ctx, runtime, client, "trace-1", time.Hour, time.Minute); err == nil {
+			t.Fatal("expected a timeout error")
+		}
+	})
+}
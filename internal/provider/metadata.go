@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"maps"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+)
+
+// managedByMetadataKey is the metadata key stamped on organizations and projects managed by
+// Terraform when the provider sets managed_by_metadata. Its entries are stamped as
+// managedByMetadataKey + "-" + name.
+const (
+	managedByMetadataKey   = "managed-by"
+	managedByMetadataValue = "terraform"
+)
+
+// managedByMetadata builds the metadata stamped when managed_by_metadata is set: managed-by plus an
+// entry per setting, with the HCP Terraform run ID as run-id unless one is configured.
+func managedByMetadata(settings map[string]string, runID string) map[string]string {
+	metadata := map[string]string{managedByMetadataKey: managedByMetadataValue}
+	if runID != "" {
+		metadata[managedByMetadataKey+"-run-id"] = runID
+	}
+	for name, value := range settings {
+		metadata[managedByMetadataKey+"-"+name] = value
+	}
+	return metadata
+}
+
+// isManagedByMetadataKey reports whether key is one of the keys managed_by_metadata stamps.
+func isManagedByMetadataKey(key string) bool {
+	return key == managedByMetadataKey || strings.HasPrefix(key, managedByMetadataKey+"-")
+}
+
+// stampManagedByMetadata returns the metadata to send for the declared entries: with the
+// managed-by stamp added when the provider sets managed_by_metadata. Declared entries win.
+func stampManagedByMetadata(clientFactory langfuse.ClientFactory, declared map[string]string) map[string]string {
+	if clientFactory == nil || clientFactory.ManagedByMetadata() == nil {
+		return declared
+	}
+	stamped := maps.Clone(clientFactory.ManagedByMetadata())
+	maps.Copy(stamped, declared)
+	return stamped
+}
+
+// stripManagedByMetadata drops the undeclared managed-by keys from remote metadata, so the stamp
+// never shows up as drift, also after managed_by_metadata was turned off.
+func stripManagedByMetadata(remote, declared map[string]string) map[string]string {
+	stripped := make(map[string]string, len(remote))
+	for key, value := range remote {
+		if _, ok := declared[key]; ok || !isManagedByMetadataKey(key) {
+			stripped[key] = value
+		}
+	}
+	return stripped
+}
+
+// metadataElements returns the entries of a metadata map attribute; null and unknown maps are empty.
+func metadataElements(ctx context.Context, metadata types.Map) (map[string]string, diag.Diagnostics) {
+	elements := make(map[string]string)
+	if metadata.IsNull() || metadata.IsUnknown() {
+		return elements, nil
+	}
+	diags := metadata.ElementsAs(ctx, &elements, false)
+	return elements, diags
+}
+
+// filterManagedMetadata returns the entries of remote whose keys are declared in managed, so that
+// keys added by Langfuse or other tools do not show up as drift.
+func filterManagedMetadata(remote, managed map[string]string) map[string]string {
+	filtered := make(map[string]string)
+	for key, value := range remote {
+		if _, ok := managed[key]; ok {
+			filtered[key] = value
+		}
+	}
+	return filtered
+}
+
+// mergeManagedMetadata computes the metadata to send when only declared keys are managed: the
+// remote entries, minus the keys that were managed before but are no longer declared, plus the
+// declared entries. Keys the provider never managed are preserved.
+func mergeManagedMetadata(remote, previous, desired map[string]string) map[string]string {
+	merged := make(map[string]string)
+	for key, value := range remote {
+		_, wasManaged := previous[key]
+		if _, isManaged := desired[key]; wasManaged && !isManaged {
+			continue
+		}
+		merged[key] = value
+	}
+	for key, value := range desired {
+		merged[key] = value
+	}
+	return merged
+}
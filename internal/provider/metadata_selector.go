@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+)
+
+// metadataRequirement is one comma-separated term of a metadata selector.
+type metadataRequirement struct {
+	key      string
+	value    string
+	operator string
+}
+
+// Operators of metadata selector terms. Existence terms have no value.
+const (
+	selectorEquals       = "="
+	selectorNotEquals    = "!="
+	selectorExists       = "exists"
+	selectorDoesNotExist = "!exists"
+)
+
+// metadataSelector matches metadata against every one of its requirements, like a Kubernetes
+// equality-based label selector: team=ai,env!=dev,critical,!deprecated.
+type metadataSelector []metadataRequirement
+
+// parseMetadataSelector parses a comma-separated list of key=value, key==value, key!=value, key
+// and !key terms. An empty selector matches everything.
+func parseMetadataSelector(selector string) (metadataSelector, error) {
+	var requirements metadataSelector
+	if strings.TrimSpace(selector) == "" {
+		return requirements, nil
+	}
+
+	for _, term := range strings.Split(selector, ",") {
+		term = strings.TrimSpace(term)
+		var requirement metadataRequirement
+		switch {
+		case term == "":
+			return nil, fmt.Errorf("empty term in selector %q", selector)
+		case strings.Contains(term, "!="):
+			requirement.key, requirement.value, _ = strings.Cut(term, "!=")
+			requirement.operator = selectorNotEquals
+		case strings.Contains(term, "=="):
+			requirement.key, requirement.value, _ = strings.Cut(term, "==")
+			requirement.operator = selectorEquals
+		case strings.Contains(term, "="):
+			requirement.key, requirement.value, _ = strings.Cut(term, "=")
+			requirement.operator = selectorEquals
+		case strings.HasPrefix(term, "!"):
+			requirement.key = strings.TrimPrefix(term, "!")
+			requirement.operator = selectorDoesNotExist
+		default:
+			requirement.key = term
+			requirement.operator = selectorExists
+		}
+
+		requirement.key = strings.TrimSpace(requirement.key)
+		requirement.value = strings.TrimSpace(requirement.value)
+		if requirement.key == "" || strings.ContainsAny(requirement.key, "=! ") {
+			return nil, fmt.Errorf("invalid term %q: expected key=value, key!=value, key or !key", term)
+		}
+		if strings.ContainsAny(requirement.value, "=!") {
+			return nil, fmt.Errorf("invalid term %q: values cannot contain \"=\" or \"!\"", term)
+		}
+		requirements = append(requirements, requirement)
+	}
+	return requirements, nil
+}
+
+// Matches reports whether metadata satisfies every requirement of the selector. A key!=value term
+// matches metadata without the key, as in Kubernetes.
+func (s metadataSelector) Matches(metadata map[string]string) bool {
+	for _, requirement := range s {
+		value, ok := metadata[requirement.key]
+		switch requirement.operator {
+		case selectorEquals:
+			if !ok || value != requirement.value {
+				return false
+			}
+		case selectorNotEquals:
+			if ok && value == requirement.value {
+				return false
+			}
+		case selectorExists:
+			if !ok {
+				return false
+			}
+		case selectorDoesNotExist:
+			if ok {
+				return false
+			}
+		}
+	}
+	return true
+}
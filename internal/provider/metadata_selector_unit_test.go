@@ -0,0 +1,43 @@
+package provider
+
+import "testing"
+
+func TestMetadataSelector(t *testing.T) {
+	t.Parallel()
+
+	metadata := map[string]string{"team": "ai", "env": "prod", "critical": ""}
+	testCases := []struct {
+		selector    string
+		want        bool
+		expectError bool
+	}{
+		{selector: "", want: true},
+		{selector: "team=ai,env=prod", want: true},
+		{selector: " team == ai , env=prod ", want: true},
+		{selector: "team=ai,env=dev"},
+		{selector: "env!=dev,owner!=jane", want: true},
+		{selector: "env!=prod"},
+		{selector: "critical,!deprecated", want: true},
+		{selector: "owner"},
+		{selector: "!team"},
+		{selector: "team=ai,", expectError: true},
+		{selector: "=ai", expectError: true},
+		{selector: "team=a=i", expectError: true},
+		{selector: "team name=ai", expectError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.selector, func(t *testing.T) {
+			selector, err := parseMetadataSelector(tc.selector)
+			if (err != nil) != tc.expectError {
+				t.Fatalf("expected error %t, got: %v", tc.expectError, err)
+			}
+			if tc.expectError {
+				return
+			}
+			if got := selector.Matches(metadata); got != tc.want {
+				t.Errorf("expected %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
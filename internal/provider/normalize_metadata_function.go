@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &normalizeMetadataFunction{}
+
+// maxMetadataKeyLength bounds normalized metadata keys, so that generated keys fail in the plan
+// rather than in the API.
+const maxMetadataKeyLength = 128
+
+func NewNormalizeMetadataFunction() function.Function {
+	return &normalizeMetadataFunction{}
+}
+
+type normalizeMetadataFunction struct{}
+
+func (f *normalizeMetadataFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_metadata"
+}
+
+func (f *normalizeMetadataFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Normalizes a metadata map",
+		Description: "Returns metadata with its keys trimmed and lowercased and its values trimmed, ready for the `metadata` of " +
+			"`langfuse_organization` and `langfuse_project`. Fails on null values, on empty keys, on keys longer than 128 characters " +
+			"or containing whitespace or control characters, and on keys that are only distinct by case or surrounding whitespace.",
+		Parameters: []function.Parameter{
+			function.MapParameter{
+				Name:        "metadata",
+				ElementType: types.StringType,
+				Description: "The metadata to normalize, e.g. tags supplied by the caller of a module.",
+			},
+		},
+		Return: function.MapReturn{ElementType: types.StringType},
+	}
+}
+
+func (f *normalizeMetadataFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var metadata types.Map
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &metadata))
+	if resp.Error != nil {
+		return
+	}
+
+	values := make(map[string]*string, len(metadata.Elements()))
+	for key, element := range metadata.Elements() {
+		value, ok := element.(types.String)
+		if !ok || value.IsNull() || value.IsUnknown() {
+			values[key] = nil
+			continue
+		}
+		values[key] = value.ValueStringPointer()
+	}
+
+	normalized, err := normalizeMetadata(values)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	result, diags := types.MapValueFrom(ctx, types.StringType, normalized)
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
+
+// normalizeMetadata trims and lowercases the keys and trims the values of metadata. Nil values
+// stand for null map elements. Entries are checked in key order, so the error is stable.
+func normalizeMetadata(metadata map[string]*string) (map[string]string, error) {
+	keys := make([]string, 0, len(metadata))
+	for key := range metadata {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	normalized := make(map[string]string, len(metadata))
+	originals := make(map[string]string, len(metadata))
+	for _, key := range keys {
+		value := metadata[key]
+		if value == nil {
+			return nil, fmt.Errorf("the value of metadata key %q is null", key)
+		}
+
+		normalizedKey := strings.ToLower(strings.TrimSpace(key))
+		switch {
+		case normalizedKey == "":
+			return nil, fmt.Errorf("metadata key %q is empty", key)
+		case len(normalizedKey) > maxMetadataKeyLength:
+			return nil, fmt.Errorf("metadata key %q is longer than %d characters", key, maxMetadataKeyLength)
+		case strings.IndexFunc(normalizedKey, func(r rune) bool { return unicode.IsSpace(r) || unicode.IsControl(r) }) >= 0:
+			return nil, fmt.Errorf("metadata key %q contains whitespace or control characters", key)
+		}
+		if original, ok := originals[normalizedKey]; ok {
+			return nil, fmt.Errorf("metadata keys %q and %q both normalize to %q", original, key, normalizedKey)
+		}
+
+		originals[normalizedKey] = key
+		normalized[normalizedKey] = strings.TrimSpace(*value)
+	}
+	return normalized, nil
+}
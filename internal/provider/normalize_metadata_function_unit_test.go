@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"context"
+	"maps"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNormalizeMetadataFunction(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := []struct {
+		name        string
+		metadata    map[string]attr.Value
+		want        map[string]string
+		expectError bool
+	}{
+		{
+			name: "normalizes keys and values",
+			metadata: map[string]attr.Value{
+				" Team ":      types.StringValue("  ml  "),
+				"Cost-Center": types.StringValue("42"),
+				"owner":       types.StringValue(""),
+			},
+			want: map[string]string{"team": "ml", "cost-center": "42", "owner": ""},
+		},
+		{name: "empty map", metadata: map[string]attr.Value{}, want: map[string]string{}},
+		{name: "null value", metadata: map[string]attr.Value{"team": types.StringNull()}, expectError: true},
+		{name: "empty key", metadata: map[string]attr.Value{"  ": types.StringValue("ml")}, expectError: true},
+		{name: "whitespace in key", metadata: map[string]attr.Value{"cost center": types.StringValue("42")}, expectError: true},
+		{
+			name:        "colliding keys",
+			metadata:    map[string]attr.Value{"Team": types.StringValue("ml"), "team": types.StringValue("platform")},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{
+				types.MapValueMust(types.StringType, tc.metadata),
+			})}
+			resp := function.RunResponse{Result: function.NewResultData(types.MapUnknown(types.StringType))}
+			NewNormalizeMetadataFunction().Run(ctx, req, &resp)
+
+			if (resp.Error != nil) != tc.expectError {
+				t.Fatalf("expected error %t, got: %v", tc.expectError, resp.Error)
+			}
+			if tc.expectError {
+				return
+			}
+			got := make(map[string]string)
+			if diags := resp.Result.Value().(types.Map).ElementsAs(ctx, &got, false); diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !maps.Equal(got, tc.want) {
+				t.Errorf("expected %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+)
+
+var _ datasource.DataSource = &observabilityConfigDataSource{}
+
+// defaultEnvironment is the environment Langfuse assigns to data ingested without one.
+const defaultEnvironment = "default"
+
+func NewObservabilityConfigDataSource() datasource.DataSource {
+	return &observabilityConfigDataSource{}
+}
+
+type observabilityConfigDataSourceModel struct {
+	ID                     types.String `tfsdk:"id"`
+	ProjectID              types.String `tfsdk:"project_id"`
+	KeyNote                types.String `tfsdk:"key_note"`
+	Environments           types.List   `tfsdk:"environments"`
+	Host                   types.String `tfsdk:"host"`
+	ProjectName            types.String `tfsdk:"project_name"`
+	PublicKey              types.String `tfsdk:"public_key"`
+	OtlpEndpoint           types.String `tfsdk:"otlp_endpoint"`
+	OrganizationPublicKey  types.String `tfsdk:"organization_public_key"`
+	OrganizationPrivateKey types.String `tfsdk:"organization_private_key"`
+	CredentialRef          types.String `tfsdk:"credential_ref"`
+}
+
+type observabilityConfigDataSource struct {
+	ClientFactory langfuse.ClientFactory
+}
+
+func (d *observabilityConfigDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clientFactory, ok := req.ProviderData.(langfuse.ClientFactory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected langfuse.ClientFactory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.ClientFactory = clientFactory
+}
+
+func (d *observabilityConfigDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_observability_config"
+}
+
+func (d *observabilityConfigDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Bundles what an application needs to start tracing into a project: the host, the public key of one of its API keys and its environment names. " +
+			"Meant as the single output a platform module hands to application teams. The secret key is not included, since Langfuse only returns it on creation.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The project ID.",
+			},
+			"project_id": schema.StringAttribute{
+				Required:    true,
+				Description: "ID of the project to trace into.",
+			},
+			"key_note": schema.StringAttribute{
+				Optional: true,
+				Description: "Note of the project API key whose public key is returned. " +
+					"Can be omitted when the project has exactly one API key.",
+			},
+			"environments": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Description: fmt.Sprintf("Environment names the application may use. Langfuse has no API listing environments, so they are passed through as configured. Defaults to `[\"%s\"]`.", defaultEnvironment),
+			},
+			"host": schema.StringAttribute{
+				Computed:    true,
+				Description: "Base URL of the Langfuse instance, for LANGFUSE_HOST.",
+			},
+			"project_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the project.",
+			},
+			"public_key": schema.StringAttribute{
+				Computed:    true,
+				Description: "Public key of the selected project API key, for LANGFUSE_PUBLIC_KEY.",
+			},
+			"otlp_endpoint": schema.StringAttribute{
+				Computed:    true,
+				Description: "OpenTelemetry endpoint of the instance, for OTEL_EXPORTER_OTLP_ENDPOINT.",
+			},
+			"organization_public_key": schema.StringAttribute{
+				Optional:    true,
+				Description: "Organization public key to authenticate the calls. Conflicts with `credential_ref`. Defaults to LANGFUSE_ORG_PUBLIC_KEY.",
+			},
+			"organization_private_key": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Organization private key to authenticate the calls. Conflicts with `credential_ref`. Defaults to LANGFUSE_ORG_SECRET_KEY.",
+			},
+			"credential_ref": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of organization credentials declared in the provider `credentials` block, used instead of `organization_public_key`/`organization_private_key`.",
+			},
+		},
+	}
+}
+
+func (d *observabilityConfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	resp.Diagnostics.Append(checkInstanceVersion(ctx, d.ClientFactory, "langfuse_observability_config")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data observabilityConfigDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateOrganizationCredentials(data.OrganizationPublicKey, data.OrganizationPrivateKey, data.CredentialRef)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationClient, diags := newOrganizationClient(d.ClientFactory, data.OrganizationPublicKey, data.OrganizationPrivateKey, data.CredentialRef)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := data.ProjectID.ValueString()
+	project, err := organizationClient.GetProject(ctx, projectID)
+	if err != nil {
+		addOrganizationClientError(ctx, &resp.Diagnostics, d.ClientFactory, "Error reading project", data.OrganizationPublicKey, "", err)
+		return
+	}
+
+	apiKeys, err := organizationClient.ListProjectApiKeys(ctx, projectID)
+	if err != nil {
+		addOrganizationClientError(ctx, &resp.Diagnostics, d.ClientFactory, "Error listing project API keys", data.OrganizationPublicKey, "", err)
+		return
+	}
+	apiKey, err := selectApiKeyByNote(apiKeys, data.KeyNote)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("key_note"), "Cannot select project API key", fmt.Sprintf("Project %s: %s", projectID, err))
+		return
+	}
+
+	if data.Environments.IsNull() || data.Environments.IsUnknown() {
+		data.Environments = types.ListValueMust(types.StringType, []attr.Value{types.StringValue(defaultEnvironment)})
+	}
+
+	host := d.ClientFactory.Host()
+	data.ID = types.StringValue(project.ID)
+	data.Host = types.StringValue(host)
+	data.ProjectName = types.StringValue(project.Name)
+	data.PublicKey = types.StringValue(apiKey.PublicKey)
+	data.OtlpEndpoint = types.StringValue(otlpEndpoint(host))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// selectApiKeyByNote returns the key whose note matches, or the only key when note is null.
+func selectApiKeyByNote(apiKeys []langfuse.ProjectApiKey, note types.String) (*langfuse.ProjectApiKey, error) {
+	if note.IsNull() {
+		if len(apiKeys) != 1 {
+			return nil, fmt.Errorf("the project has %d API keys; set key_note to choose one", len(apiKeys))
+		}
+		return &apiKeys[0], nil
+	}
+
+	var found *langfuse.ProjectApiKey
+	for i := range apiKeys {
+		if apiKeys[i].Note != note.ValueString() {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("more than one API key has the note %q", note.ValueString())
+		}
+		found = &apiKeys[i]
+	}
+	if found == nil {
+		return nil, fmt.Errorf("no API key has the note %q", note.ValueString())
+	}
+	return found, nil
+}
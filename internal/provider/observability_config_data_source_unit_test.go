@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse/mocks"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestObservabilityConfigDataSourceRead(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	apiKeys := []langfuse.ProjectApiKey{
+		{ID: "key-1", PublicKey: "pk-lf-ci", Note: "ci"},
+		{ID: "key-2", PublicKey: "pk-lf-app", Note: "app"},
+	}
+
+	testCases := []struct {
+		name              string
+		keyNote           any
+		expectedPublicKey string
+		expectError       bool
+	}{
+		{name: "key selected by note", keyNote: "app", expectedPublicKey: "pk-lf-app"},
+		{name: "unknown note", keyNote: "web", expectError: true},
+		{name: "no note with several keys", expectError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := NewObservabilityConfigDataSource().(*observabilityConfigDataSource)
+			clientFactory := mocks.NewMockClientFactory(ctrl)
+			clientFactory.BaseURL = "https://langfuse.example.com/"
+			d.Configure(ctx, datasource.ConfigureRequest{ProviderData: clientFactory}, &datasource.ConfigureResponse{})
+
+			var schemaResp datasource.SchemaResponse
+			d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+			if diags := schemaResp.Schema.ValidateImplementation(ctx); diags.HasError() {
+				t.Fatalf("schema implementation validation failed: %v", diags)
+			}
+
+			clientFactory.OrganizationClient.EXPECT().GetProject(ctx, "proj-1").Return(&langfuse.Project{ID: "proj-1", Name: "checkout"}, nil)
+			clientFactory.OrganizationClient.EXPECT().ListProjectApiKeys(ctx, "proj-1").Return(apiKeys, nil)
+
+			objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+			values := map[string]tftypes.Value{
+				"project_id":               tftypes.NewValue(tftypes.String, "proj-1"),
+				"key_note":                 tftypes.NewValue(tftypes.String, tc.keyNote),
+				"organization_public_key":  tftypes.NewValue(tftypes.String, "pk-org"),
+				"organization_private_key": tftypes.NewValue(tftypes.String, "sk-org"),
+			}
+			for name, attributeType := range objectType.AttributeTypes {
+				if _, ok := values[name]; !ok {
+					values[name] = tftypes.NewValue(attributeType, nil)
+				}
+			}
+
+			readResp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+			d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}}, &readResp)
+			if readResp.Diagnostics.HasError() != tc.expectError {
+				t.Fatalf("expected error: %t, got diagnostics: %v", tc.expectError, readResp.Diagnostics)
+			}
+			if tc.expectError {
+				return
+			}
+
+			var data observabilityConfigDataSourceModel
+			readResp.Diagnostics.Append(readResp.State.Get(ctx, &data)...)
+			var environments []string
+			readResp.Diagnostics.Append(data.Environments.ElementsAs(ctx, &environments, false)...)
+			if data.PublicKey.ValueString() != tc.expectedPublicKey || data.ProjectName.ValueString() != "checkout" ||
+				data.OtlpEndpoint.ValueString() != "https://langfuse.example.com/api/public/otel" ||
+				len(environments) != 1 || environments[0] != defaultEnvironment {
+				t.Errorf("unexpected state: %+v", data)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+)
+
+var _ ephemeral.EphemeralResourceWithConfigure = &organizationApiKeyEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &organizationApiKeyEphemeralResource{}
+
+// organizationApiKeyPrivateKey is the private data key under which Open hands the key to Close.
+const organizationApiKeyPrivateKey = "organization_api_key"
+
+func NewOrganizationApiKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &organizationApiKeyEphemeralResource{}
+}
+
+type organizationApiKeyEphemeralResourceModel struct {
+	OrganizationID types.String `tfsdk:"organization_id"`
+	ID             types.String `tfsdk:"id"`
+	PublicKey      types.String `tfsdk:"public_key"`
+	SecretKey      types.String `tfsdk:"secret_key"`
+	Host           types.String `tfsdk:"host"`
+}
+
+type organizationApiKeyPrivateData struct {
+	OrganizationID string `json:"organization_id"`
+	ID             string `json:"id"`
+}
+
+type organizationApiKeyEphemeralResource struct {
+	AdminClient   langfuse.AdminClient
+	ClientFactory langfuse.ClientFactory
+}
+
+func (r *organizationApiKeyEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.ClientFactory = req.ProviderData.(langfuse.ClientFactory)
+	resp.Diagnostics.Append(checkAdminAPIKeyConfigured(r.ClientFactory, "langfuse_organization_api_key")...)
+	r.AdminClient = r.ClientFactory.NewAdminClient()
+}
+
+func (r *organizationApiKeyEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_api_key"
+}
+
+func (r *organizationApiKeyEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Organization API key that only exists while Terraform runs. The key is created when Terraform opens the " +
+			"ephemeral resource and revoked when it closes it, at the end of the plan or apply, so no organization key outlives the run.",
+		Attributes: map[string]schema.Attribute{
+			"organization_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The Langfuse organization to create the key in.",
+			},
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the key.",
+			},
+			"public_key": schema.StringAttribute{
+				Computed:    true,
+				Description: "The public value of the key.",
+			},
+			"secret_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The secret value of the key.",
+			},
+			"host": schema.StringAttribute{
+				Computed:    true,
+				Description: "Base URI of the Langfuse instance the key belongs to, as configured on the provider.",
+			},
+		},
+	}
+}
+
+func (r *organizationApiKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	defer addRunWarnings(&resp.Diagnostics, r.ClientFactory)
+
+	resp.Diagnostics.Append(checkAdminAPIAvailable(r.ClientFactory, "langfuse_organization_api_key")...)
+	resp.Diagnostics.Append(checkInstanceVersion(ctx, r.ClientFactory, "langfuse_organization_api_key")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data organizationApiKeyEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withRequestAttributes(ctx, r.ClientFactory, data.OrganizationID, types.StringNull())
+
+	orgKey, err := r.AdminClient.CreateOrganizationApiKey(ctx, data.OrganizationID.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error creating organization API key", err)
+		return
+	}
+
+	// Close only receives the private data, so the key to revoke is recorded there. Should that
+	// fail, the key is revoked right away rather than left behind.
+	private, err := json.Marshal(organizationApiKeyPrivateData{OrganizationID: data.OrganizationID.ValueString(), ID: orgKey.ID})
+	if err == nil {
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, organizationApiKeyPrivateKey, private)...)
+	} else {
+		resp.Diagnostics.AddError("Error recording organization API key", err.Error())
+	}
+	if resp.Diagnostics.HasError() {
+		if err := r.AdminClient.DeleteOrganizationApiKey(ctx, data.OrganizationID.ValueString(), orgKey.ID); err != nil {
+			addClientError(&resp.Diagnostics, fmt.Sprintf("Error revoking organization API key %s", orgKey.ID), err)
+		}
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &organizationApiKeyEphemeralResourceModel{
+		OrganizationID: data.OrganizationID,
+		ID:             types.StringValue(orgKey.ID),
+		PublicKey:      types.StringValue(orgKey.PublicKey),
+		SecretKey:      types.StringValue(orgKey.SecretKey),
+		Host:           types.StringValue(r.ClientFactory.Host()),
+	})...)
+}
+
+func (r *organizationApiKeyEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	defer addRunWarnings(&resp.Diagnostics, r.ClientFactory)
+
+	private, diags := req.Private.GetKey(ctx, organizationApiKeyPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || private == nil {
+		return
+	}
+
+	var key organizationApiKeyPrivateData
+	if err := json.Unmarshal(private, &key); err != nil {
+		resp.Diagnostics.AddError("Error reading organization API key", err.Error())
+		return
+	}
+	ctx = withRequestAttributes(ctx, r.ClientFactory, types.StringValue(key.OrganizationID), types.StringNull())
+
+	// A key that is already gone, e.g. revoked in the UI during the run, needs no revocation.
+	err := r.AdminClient.DeleteOrganizationApiKey(ctx, key.OrganizationID, key.ID)
+	if err != nil && !errors.Is(err, langfuse.ErrNotFound) {
+		addClientError(&resp.Diagnostics, fmt.Sprintf("Error revoking organization API key %s", key.ID), err)
+	}
+}
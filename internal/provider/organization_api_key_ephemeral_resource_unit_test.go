@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse/fake"
+)
+
+// TestOrganizationApiKeyEphemeralResourceLifecycle drives the ephemeral resource through the
+// protocol server, which carries the private data from Open to Close, against the mock backend.
+func TestOrganizationApiKeyEphemeralResourceLifecycle(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	p := New("test")()
+	server, err := providerserver.NewProtocol6WithError(p)()
+	if err != nil {
+		t.Fatalf("failed to start provider server: %v", err)
+	}
+
+	var providerSchemaResp provider.SchemaResponse
+	p.Schema(ctx, provider.SchemaRequest{}, &providerSchemaResp)
+	providerConfig := buildProviderConfig(ctx, providerSchemaResp, map[string]tftypes.Value{
+		"mock": tftypes.NewValue(tftypes.Bool, true),
+	})
+	configValue, err := tfprotov6.NewDynamicValue(providerConfig.Raw.Type(), providerConfig.Raw)
+	if err != nil {
+		t.Fatalf("failed to encode provider configuration: %v", err)
+	}
+	configureResp, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{Config: &configValue})
+	if err != nil || len(configureResp.Diagnostics) > 0 {
+		t.Fatalf("unexpected result from ConfigureProvider: %v, %v", configureResp.Diagnostics, err)
+	}
+	defer p.(*langfuseProvider).fakeServer.Close()
+
+	adminClient := langfuse.NewAdminClient(p.(*langfuseProvider).fakeServer.URL, fake.AdminAPIKey)
+	organization, err := adminClient.CreateOrganization(ctx, &langfuse.CreateOrganizationRequest{Name: "Acme Inc"})
+	if err != nil {
+		t.Fatalf("failed to create organization: %v", err)
+	}
+
+	var schemaResp ephemeral.SchemaResponse
+	NewOrganizationApiKeyEphemeralResource().Schema(ctx, ephemeral.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	ephemeralConfig, err := tfprotov6.NewDynamicValue(objectType, tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"organization_id": tftypes.NewValue(tftypes.String, organization.ID),
+		"id":              tftypes.NewValue(tftypes.String, nil),
+		"public_key":      tftypes.NewValue(tftypes.String, nil),
+		"secret_key":      tftypes.NewValue(tftypes.String, nil),
+		"host":            tftypes.NewValue(tftypes.String, nil),
+	}))
+	if err != nil {
+		t.Fatalf("failed to encode ephemeral resource configuration: %v", err)
+	}
+
+	openResp, err := server.OpenEphemeralResource(ctx, &tfprotov6.OpenEphemeralResourceRequest{
+		TypeName: "langfuse_organization_api_key",
+		Config:   &ephemeralConfig,
+	})
+	if err != nil || len(openResp.Diagnostics) > 0 {
+		t.Fatalf("unexpected result from OpenEphemeralResource: %v, %v", openResp.Diagnostics, err)
+	}
+
+	result, err := openResp.Result.Unmarshal(objectType)
+	if err != nil {
+		t.Fatalf("failed to decode ephemeral result: %v", err)
+	}
+	attributes := make(map[string]tftypes.Value)
+	if err := result.As(&attributes); err != nil {
+		t.Fatalf("failed to decode ephemeral result: %v", err)
+	}
+	var keyID, publicKey, secretKey string
+	if err := attributes["id"].As(&keyID); err != nil || keyID == "" {
+		t.Fatalf("expected a key ID, got %q, %v", keyID, err)
+	}
+	_ = attributes["public_key"].As(&publicKey)
+	_ = attributes["secret_key"].As(&secretKey)
+
+	// The key authenticates organization requests while the ephemeral resource is open.
+	organizationClient := langfuse.NewOrganizationClient(p.(*langfuseProvider).fakeServer.URL, publicKey, secretKey)
+	if _, err := organizationClient.ListProjects(ctx); err != nil {
+		t.Fatalf("expected the ephemeral key to authenticate, got %v", err)
+	}
+
+	closeResp, err := server.CloseEphemeralResource(ctx, &tfprotov6.CloseEphemeralResourceRequest{
+		TypeName: "langfuse_organization_api_key",
+		Private:  openResp.Private,
+	})
+	if err != nil || len(closeResp.Diagnostics) > 0 {
+		t.Fatalf("unexpected result from CloseEphemeralResource: %v, %v", closeResp.Diagnostics, err)
+	}
+
+	keys, err := adminClient.ListOrganizationApiKeys(ctx, organization.ID)
+	if err != nil {
+		t.Fatalf("failed to list organization API keys: %v", err)
+	}
+	for _, key := range keys {
+		if key.ID == keyID {
+			t.Errorf("expected key %s to be revoked on Close", keyID)
+		}
+	}
+	if _, err := organizationClient.ListProjects(ctx); err == nil {
+		t.Error("expected the revoked key to be rejected")
+	}
+}
@@ -2,7 +2,11 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -12,6 +16,8 @@ import (
 )
 
 var _ resource.Resource = &organizationApiKeyResource{}
+var _ resource.ResourceWithImportState = &organizationApiKeyResource{}
+var _ resource.ResourceWithModifyPlan = &organizationApiKeyResource{}
 
 func NewOrganizationApiKeyResource() resource.Resource {
 	return &organizationApiKeyResource{}
@@ -22,10 +28,13 @@ type organizationApiKeyResourceModel struct {
 	OrganizationID types.String `tfsdk:"organization_id"`
 	PublicKey      types.String `tfsdk:"public_key"`
 	SecretKey      types.String `tfsdk:"secret_key"`
+	Host           types.String `tfsdk:"host"`
+	Note           types.String `tfsdk:"note"`
 }
 
 type organizationApiKeyResource struct {
-	AdminClient langfuse.AdminClient
+	AdminClient   langfuse.AdminClient
+	ClientFactory langfuse.ClientFactory
 }
 
 func (r *organizationApiKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -33,7 +42,9 @@ func (r *organizationApiKeyResource) Configure(ctx context.Context, req resource
 		return
 	}
 
-	r.AdminClient = req.ProviderData.(langfuse.ClientFactory).NewAdminClient()
+	r.ClientFactory = req.ProviderData.(langfuse.ClientFactory)
+	resp.Diagnostics.Append(checkAdminAPIKeyConfigured(r.ClientFactory, "langfuse_organization_api_key")...)
+	r.AdminClient = r.ClientFactory.NewAdminClient()
 }
 
 func (r *organizationApiKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -45,6 +56,9 @@ func (r *organizationApiKeyResource) Schema(ctx context.Context, req resource.Sc
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					preserveStateString(),
+				},
 			},
 			"organization_id": schema.StringAttribute{
 				Required:    true,
@@ -55,12 +69,11 @@ func (r *organizationApiKeyResource) Schema(ctx context.Context, req resource.Sc
 			},
 			"public_key": schema.StringAttribute{
 				Computed:    true,
-				Sensitive:   true,
 				Description: "The public value of the API key (only returned at creation time).",
 				PlanModifiers: []planmodifier.String{
 					// keep the value that is already in state because
 					// Read() will never be able to fetch it again
-					stringplanmodifier.UseStateForUnknown(),
+					preserveStateString(),
 				},
 			},
 			"secret_key": schema.StringAttribute{
@@ -70,24 +83,52 @@ func (r *organizationApiKeyResource) Schema(ctx context.Context, req resource.Sc
 				PlanModifiers: []planmodifier.String{
 					// keep the value that is already in state because
 					// Read() will never be able to fetch it again
-					stringplanmodifier.UseStateForUnknown(),
+					preserveStateString(),
+				},
+			},
+			"host": schema.StringAttribute{
+				Computed:    true,
+				Description: "Base URI of the Langfuse instance the key belongs to, as configured on the provider.",
+				PlanModifiers: []planmodifier.String{
+					preserveStateString(),
+				},
+			},
+			"note": schema.StringAttribute{
+				Computed:    true,
+				Description: "The note of the key as listed by Langfuse, e.g. set in the UI. Null when the key has no note.",
+				PlanModifiers: []planmodifier.String{
+					preserveStateString(),
 				},
 			},
 		},
 	}
 }
 
+// ModifyPlan only reports the sensitive attributes the plan writes to state, when requested.
+func (r *organizationApiKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	addSensitiveStateSummary(ctx, r.ClientFactory, req, resp)
+}
+
 func (r *organizationApiKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer addRunWarnings(&resp.Diagnostics, r.ClientFactory)
+
+	resp.Diagnostics.Append(checkAdminAPIAvailable(r.ClientFactory, "langfuse_organization_api_key")...)
+	resp.Diagnostics.Append(checkInstanceVersion(ctx, r.ClientFactory, "langfuse_organization_api_key")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data organizationApiKeyResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withRequestAttributes(ctx, r.ClientFactory, data.OrganizationID, types.StringNull())
 
 	orgKey, err := r.AdminClient.CreateOrganizationApiKey(ctx, data.OrganizationID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating organization API key", err.Error())
+		addClientError(&resp.Diagnostics, "Error creating organization API key", err)
 		return
 	}
 
@@ -96,23 +137,76 @@ func (r *organizationApiKeyResource) Create(ctx context.Context, req resource.Cr
 		OrganizationID: types.StringValue(data.OrganizationID.ValueString()),
 		PublicKey:      types.StringValue(orgKey.PublicKey),
 		SecretKey:      types.StringValue(orgKey.SecretKey),
+		Host:           types.StringValue(r.ClientFactory.Host()),
+		Note:           stringValueOrNull(orgKey.Note),
 	})...)
 }
 
 func (r *organizationApiKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(checkAdminAPIAvailable(r.ClientFactory, "langfuse_organization_api_key")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data organizationApiKeyResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withRequestAttributes(ctx, r.ClientFactory, data.OrganizationID, types.StringNull())
 
-	_, err := r.AdminClient.GetOrganizationApiKey(ctx, data.OrganizationID.ValueString(), data.ID.ValueString())
+	if skipRefreshLookup(ctx, r.ClientFactory, "langfuse_organization_api_key", !data.OrganizationID.IsNull() && !data.PublicKey.IsNull()) {
+		data.Host = types.StringValue(r.ClientFactory.Host())
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	// The key is looked up in the admin key list by ID, so deletions and rotations done in the UI
+	// are detected on refresh rather than when a resource authenticating with the key fails.
+	// Key responses do not carry the organization, so keys imported by ID alone are first
+	// located by scanning the key lists of all organizations.
+	var apiKey *langfuse.OrganizationApiKey
+	var err error
+	if data.OrganizationID.IsNull() || data.OrganizationID.ValueString() == "" {
+		var orgID string
+		orgID, apiKey, err = findOrganizationApiKey(ctx, r.AdminClient, data.ID.ValueString())
+		data.OrganizationID = types.StringValue(orgID)
+	} else {
+		apiKey, err = r.AdminClient.GetOrganizationApiKey(ctx, data.OrganizationID.ValueString(), data.ID.ValueString())
+	}
+	if errors.Is(err, langfuse.ErrNotFound) {
+		resp.Diagnostics.AddWarning(
+			"Organization API key deleted outside Terraform",
+			fmt.Sprintf("Organization API key %s (%s) no longer exists in organization %s, e.g. because it was deleted or rotated in the Langfuse UI. "+
+				"It is removed from state and will be created again; resources authenticating with it will receive the new key pair.",
+				data.ID.ValueString(), data.PublicKey.ValueString(), data.OrganizationID.ValueString()),
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
+		// Credential and network problems say nothing about whether the key still exists, so
+		// surface them instead of dropping the key from state and planning a replacement.
+		addClientError(&resp.Diagnostics, "Error reading organization API key", err)
+		return
+	}
+	if apiKey.PublicKey != "" && !data.PublicKey.IsNull() && apiKey.PublicKey != data.PublicKey.ValueString() {
+		resp.Diagnostics.AddWarning(
+			"Organization API key rotated outside Terraform",
+			fmt.Sprintf("Organization API key %s now has public key %s instead of %s. The secret key in state is no longer valid, "+
+				"so the key is removed from state and will be created again.", data.ID.ValueString(), apiKey.PublicKey, data.PublicKey.ValueString()),
+		)
 		resp.State.RemoveResource(ctx)
 		return
 	}
 
+	if data.PublicKey.IsNull() && apiKey.PublicKey != "" {
+		data.PublicKey = types.StringValue(apiKey.PublicKey)
+	}
+	data.Note = stringValueOrNull(apiKey.Note)
+	data.Host = types.StringValue(r.ClientFactory.Host())
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -121,18 +215,98 @@ func (r *organizationApiKeyResource) Update(ctx context.Context, req resource.Up
 }
 
 func (r *organizationApiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer addRunWarnings(&resp.Diagnostics, r.ClientFactory)
+
+	resp.Diagnostics.Append(checkAdminAPIAvailable(r.ClientFactory, "langfuse_organization_api_key")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data organizationApiKeyResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withRequestAttributes(ctx, r.ClientFactory, data.OrganizationID, types.StringNull())
 
 	err := r.AdminClient.DeleteOrganizationApiKey(ctx, data.OrganizationID.ValueString(), data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Error deleting organization API key", err.Error())
+		addClientError(&resp.Diagnostics, "Error deleting organization API key", err)
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &organizationApiKeyResourceModel{})...)
 }
+
+// ImportState accepts "<key_id>", "<organization_id>,<key_id>" or "<organization_id>,<key_id>,<secret_key>".
+// Without an organization ID the organization is resolved on the following Read. Langfuse only
+// returns the secret key at creation, so it is null unless passed in the import ID; a passed secret
+// is checked against the masked secret Langfuse lists for the key.
+func (r *organizationApiKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(checkAdminAPIAvailable(r.ClientFactory, "langfuse_organization_api_key")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orgID, keyID, secretKey := "", req.ID, ""
+	switch parts := strings.Split(req.ID, ","); len(parts) {
+	case 1:
+	case 2:
+		orgID, keyID = parts[0], parts[1]
+	case 3:
+		orgID, keyID, secretKey = parts[0], parts[1], parts[2]
+	default:
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected \"<key_id>\", \"<organization_id>,<key_id>\" or \"<organization_id>,<key_id>,<secret_key>\", got %q.", req.ID),
+		)
+		return
+	}
+	if keyID == "" {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("The key ID in import ID %q must not be empty.", req.ID))
+		return
+	}
+
+	if secretKey != "" {
+		apiKey, err := r.AdminClient.GetOrganizationApiKey(ctx, orgID, keyID)
+		if err != nil {
+			addClientError(&resp.Diagnostics, "Error importing organization API key", err)
+			return
+		}
+		if !matchesDisplaySecretKey(apiKey.DisplaySecretKey, secretKey) {
+			resp.Diagnostics.AddError(
+				"Secret key does not match",
+				fmt.Sprintf("The secret key in the import ID does not belong to organization API key %s, whose secret key is %s.", keyID, apiKey.DisplaySecretKey),
+			)
+			return
+		}
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("secret_key"), secretKey)...)
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), keyID)...)
+	if orgID != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("organization_id"), orgID)...)
+	}
+}
+
+// findOrganizationApiKey returns the organization the key belongs to together with the key.
+func findOrganizationApiKey(ctx context.Context, client langfuse.AdminClient, keyID string) (string, *langfuse.OrganizationApiKey, error) {
+	orgs, err := client.ListOrganizations(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	for _, org := range orgs {
+		keys, err := client.ListOrganizationApiKeys(ctx, org.ID)
+		if err != nil {
+			return "", nil, err
+		}
+		for _, key := range keys {
+			if key.ID == keyID {
+				return org.ID, &key, nil
+			}
+		}
+	}
+
+	return "", nil, fmt.Errorf("cannot find API key with ID %s in any organization: %w", keyID, langfuse.ErrNotFound)
+}
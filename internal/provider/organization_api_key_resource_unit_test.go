@@ -2,6 +2,8 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -9,6 +11,7 @@ import (
 	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
 	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse/mocks"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	resschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -69,6 +72,7 @@ func TestOrganizationApiKeyResourceCRUD(t *testing.T) {
 	}
 
 	clientFactory := mocks.NewMockClientFactory(ctrl)
+	clientFactory.BaseURL = "http://localhost:3000"
 
 	var resourceSchema resschema.Schema
 	t.Run("Configure", func(t *testing.T) {
@@ -99,12 +103,19 @@ func TestOrganizationApiKeyResourceCRUD(t *testing.T) {
 			"organization_id": tftypes.NewValue(tftypes.String, orgID),
 			"public_key":      tftypes.NewValue(tftypes.String, nil),
 			"secret_key":      tftypes.NewValue(tftypes.String, nil),
+			"host":            tftypes.NewValue(tftypes.String, nil),
 		}), Schema: resourceSchema}
 		createResp.State.Schema = resourceSchema
 		r.Create(ctx, resource.CreateRequest{Config: createConfig}, &createResp)
 		if createResp.Diagnostics.HasError() {
 			t.Fatalf("unexpected diagnostics from Create: %v", createResp.Diagnostics)
 		}
+
+		var host string
+		createResp.State.GetAttribute(ctx, path.Root("host"), &host)
+		if host != clientFactory.BaseURL {
+			t.Errorf("unexpected host. got %q, want %q", host, clientFactory.BaseURL)
+		}
 	})
 
 	var readResp resource.ReadResponse
@@ -118,6 +129,113 @@ func TestOrganizationApiKeyResourceCRUD(t *testing.T) {
 		}
 	})
 
+	t.Run("Read detects rotation", func(t *testing.T) {
+		clientFactory.AdminClient.EXPECT().GetOrganizationApiKey(ctx, orgID, "oak-123").Return(&langfuse.OrganizationApiKey{ID: "oak-123", PublicKey: "pk-5678"}, nil)
+
+		rotatedResp := resource.ReadResponse{State: readResp.State}
+		r.Read(ctx, resource.ReadRequest{State: readResp.State}, &rotatedResp)
+		if rotatedResp.Diagnostics.HasError() || rotatedResp.Diagnostics.WarningsCount() != 1 {
+			t.Fatalf("expected a single warning from Read, got: %v", rotatedResp.Diagnostics)
+		}
+		if !rotatedResp.State.Raw.IsNull() {
+			t.Errorf("expected the rotated key to be removed from state")
+		}
+	})
+
+	t.Run("Read detects deletion", func(t *testing.T) {
+		clientFactory.AdminClient.EXPECT().GetOrganizationApiKey(ctx, orgID, "oak-123").
+			Return(nil, fmt.Errorf("cannot find API key with ID oak-123 in organization %s: %w", orgID, langfuse.ErrNotFound))
+
+		deletedResp := resource.ReadResponse{State: readResp.State}
+		r.Read(ctx, resource.ReadRequest{State: readResp.State}, &deletedResp)
+		if deletedResp.Diagnostics.HasError() || deletedResp.Diagnostics.WarningsCount() != 1 {
+			t.Fatalf("expected a single warning from Read, got: %v", deletedResp.Diagnostics)
+		}
+		if !deletedResp.State.Raw.IsNull() {
+			t.Errorf("expected the deleted key to be removed from state")
+		}
+	})
+
+	t.Run("Read keeps key on lookup failure", func(t *testing.T) {
+		clientFactory.AdminClient.EXPECT().GetOrganizationApiKey(ctx, orgID, "oak-123").Return(nil, errors.New("connection reset by peer"))
+
+		failedResp := resource.ReadResponse{State: readResp.State}
+		r.Read(ctx, resource.ReadRequest{State: readResp.State}, &failedResp)
+		if !failedResp.Diagnostics.HasError() {
+			t.Fatalf("expected an error from Read")
+		}
+	})
+
+	t.Run("Import resolves organization", func(t *testing.T) {
+		importResp := resource.ImportStateResponse{State: tfsdk.State{Schema: resourceSchema, Raw: tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil)}}
+		r.ImportState(ctx, resource.ImportStateRequest{ID: "oak-123"}, &importResp)
+		if importResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics from ImportState: %v", importResp.Diagnostics)
+		}
+
+		clientFactory.AdminClient.EXPECT().ListOrganizations(ctx).Return([]*langfuse.Organization{{ID: "org-other"}, {ID: orgID}}, nil)
+		clientFactory.AdminClient.EXPECT().ListOrganizationApiKeys(ctx, "org-other").Return([]langfuse.OrganizationApiKey{{ID: "oak-999", PublicKey: "pk-9999"}}, nil)
+		clientFactory.AdminClient.EXPECT().ListOrganizationApiKeys(ctx, orgID).Return([]langfuse.OrganizationApiKey{{ID: "oak-123", PublicKey: "pk-1234", Note: "ci"}}, nil)
+
+		importedResp := resource.ReadResponse{State: importResp.State}
+		r.Read(ctx, resource.ReadRequest{State: importResp.State}, &importedResp)
+		if importedResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics from Read: %v", importedResp.Diagnostics)
+		}
+
+		var data organizationApiKeyResourceModel
+		importedResp.State.Get(ctx, &data)
+		if data.OrganizationID.ValueString() != orgID {
+			t.Errorf("unexpected organization_id. got %q, want %q", data.OrganizationID.ValueString(), orgID)
+		}
+		if data.PublicKey.ValueString() != "pk-1234" || !data.SecretKey.IsNull() {
+			t.Errorf("unexpected key pair after import: %q / %v", data.PublicKey.ValueString(), data.SecretKey)
+		}
+	})
+
+	t.Run("Import with organization", func(t *testing.T) {
+		importResp := resource.ImportStateResponse{State: tfsdk.State{Schema: resourceSchema, Raw: tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil)}}
+		r.ImportState(ctx, resource.ImportStateRequest{ID: orgID + ",oak-123"}, &importResp)
+		if importResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics from ImportState: %v", importResp.Diagnostics)
+		}
+
+		var gotOrgID string
+		importResp.State.GetAttribute(ctx, path.Root("organization_id"), &gotOrgID)
+		if gotOrgID != orgID {
+			t.Errorf("unexpected organization_id. got %q, want %q", gotOrgID, orgID)
+		}
+
+		invalidResp := resource.ImportStateResponse{State: tfsdk.State{Schema: resourceSchema, Raw: tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil)}}
+		r.ImportState(ctx, resource.ImportStateRequest{ID: "a,b,c,d"}, &invalidResp)
+		if !invalidResp.Diagnostics.HasError() {
+			t.Errorf("expected an error for an invalid import ID")
+		}
+	})
+
+	t.Run("Import with secret key", func(t *testing.T) {
+		clientFactory.AdminClient.EXPECT().GetOrganizationApiKey(ctx, orgID, "oak-123").Return(&langfuse.OrganizationApiKey{
+			ID: "oak-123", PublicKey: "pk-1234", DisplaySecretKey: "sk-lf-...5678",
+		}, nil).Times(2)
+
+		importResp := resource.ImportStateResponse{State: tfsdk.State{Schema: resourceSchema, Raw: tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil)}}
+		r.ImportState(ctx, resource.ImportStateRequest{ID: orgID + ",oak-123,sk-lf-12345678"}, &importResp)
+		if importResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics from ImportState: %v", importResp.Diagnostics)
+		}
+		var secretKey string
+		importResp.State.GetAttribute(ctx, path.Root("secret_key"), &secretKey)
+		if secretKey != "sk-lf-12345678" {
+			t.Errorf("unexpected secret_key. got %q", secretKey)
+		}
+
+		mismatchResp := resource.ImportStateResponse{State: tfsdk.State{Schema: resourceSchema, Raw: tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil)}}
+		r.ImportState(ctx, resource.ImportStateRequest{ID: orgID + ",oak-123,sk-lf-87654321"}, &mismatchResp)
+		if !mismatchResp.Diagnostics.HasError() || mismatchResp.Diagnostics.Errors()[0].Summary() != "Secret key does not match" {
+			t.Errorf("expected a secret key mismatch, got: %v", mismatchResp.Diagnostics)
+		}
+	})
+
 	t.Run("Delete", func(t *testing.T) {
 		clientFactory.AdminClient.EXPECT().DeleteOrganizationApiKey(ctx, orgID, "oak-123").Return(nil)
 
@@ -131,20 +249,27 @@ func TestOrganizationApiKeyResourceCRUD(t *testing.T) {
 }
 
 func buildOrgApiKeyObjectValue(values map[string]tftypes.Value) tftypes.Value {
-	return tftypes.NewValue(
-		tftypes.Object{
-			AttributeTypes: map[string]tftypes.Type{
-				"id":              tftypes.String,
-				"organization_id": tftypes.String,
-				"public_key":      tftypes.String,
-				"secret_key":      tftypes.String,
-			},
-			OptionalAttributes: map[string]struct{}{
-				"id":         {},
-				"public_key": {},
-				"secret_key": {},
-			},
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":              tftypes.String,
+			"organization_id": tftypes.String,
+			"public_key":      tftypes.String,
+			"secret_key":      tftypes.String,
+			"host":            tftypes.String,
+			"note":            tftypes.String,
+		},
+		OptionalAttributes: map[string]struct{}{
+			"id":         {},
+			"public_key": {},
+			"secret_key": {},
+			"host":       {},
+			"note":       {},
 		},
-		values,
-	)
+	}
+	for name, attributeType := range objectType.AttributeTypes {
+		if _, ok := values[name]; !ok {
+			values[name] = tftypes.NewValue(attributeType, nil)
+		}
+	}
+	return tftypes.NewValue(objectType, values)
 }
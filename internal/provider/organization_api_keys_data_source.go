@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+)
+
+var _ datasource.DataSource = &organizationApiKeysDataSource{}
+
+func NewOrganizationApiKeysDataSource() datasource.DataSource {
+	return &organizationApiKeysDataSource{}
+}
+
+type organizationApiKeysDataSourceModel struct {
+	OrganizationID types.String                    `tfsdk:"organization_id"`
+	Keys           []organizationApiKeysEntryModel `tfsdk:"keys"`
+	MaxAgeDays     types.Int64                     `tfsdk:"max_age_days"`
+}
+
+type organizationApiKeysEntryModel struct {
+	ID         types.String `tfsdk:"id"`
+	PublicKey  types.String `tfsdk:"public_key"`
+	Note       types.String `tfsdk:"note"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+	LastUsedAt types.String `tfsdk:"last_used_at"`
+	AgeDays    types.Int64  `tfsdk:"age_days"`
+}
+
+type organizationApiKeysDataSource struct {
+	ClientFactory langfuse.ClientFactory
+}
+
+func (d *organizationApiKeysDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clientFactory, ok := req.ProviderData.(langfuse.ClientFactory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected langfuse.ClientFactory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.ClientFactory = clientFactory
+	resp.Diagnostics.Append(checkAdminAPIKeyConfigured(clientFactory, "langfuse_organization_api_keys")...)
+}
+
+func (d *organizationApiKeysDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_api_keys"
+}
+
+func (d *organizationApiKeysDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the API keys of an organization with their ages, so that key rotation policies can be enforced with " +
+			"preconditions such as `max_age_days <= 90`. Requires the admin API key.",
+		Attributes: map[string]schema.Attribute{
+			"organization_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The Langfuse organization whose keys to list.",
+			},
+			"keys": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The API keys of the organization, ordered by ID.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":         schema.StringAttribute{Computed: true},
+						"public_key": schema.StringAttribute{Computed: true},
+						"note":       schema.StringAttribute{Computed: true},
+						"created_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Creation time of the key (RFC 3339).",
+						},
+						"last_used_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "Last time the key was used (RFC 3339), or null if it has not been used.",
+						},
+						"age_days": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of whole days since the key was created, as of the read. Null if the instance does not report creation times.",
+						},
+					},
+				},
+			},
+			"max_age_days": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The largest `age_days` of the keys, i.e. the age of the oldest key. Null if the organization has no keys with a known age.",
+			},
+		},
+	}
+}
+
+func (d *organizationApiKeysDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer addRunWarnings(&resp.Diagnostics, d.ClientFactory)
+
+	resp.Diagnostics.Append(checkAdminAPIAvailable(d.ClientFactory, "langfuse_organization_api_keys")...)
+	resp.Diagnostics.Append(checkInstanceVersion(ctx, d.ClientFactory, "langfuse_organization_api_keys")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data organizationApiKeysDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withRequestAttributes(ctx, d.ClientFactory, data.OrganizationID, types.StringNull())
+
+	apiKeys, err := d.ClientFactory.NewAdminClient().ListOrganizationApiKeys(ctx, data.OrganizationID.ValueString())
+	if err != nil {
+		addClientError(&resp.Diagnostics, fmt.Sprintf("Error listing API keys of organization %s", data.OrganizationID.ValueString()), err)
+		return
+	}
+	sort.Slice(apiKeys, func(i, j int) bool { return apiKeys[i].ID < apiKeys[j].ID })
+
+	now := runtimeOf(d.ClientFactory).Now()
+	data.Keys = []organizationApiKeysEntryModel{}
+	data.MaxAgeDays = types.Int64Null()
+	for _, apiKey := range apiKeys {
+		entry := organizationApiKeysEntryModel{
+			ID:         types.StringValue(apiKey.ID),
+			PublicKey:  types.StringValue(apiKey.PublicKey),
+			Note:       stringValueOrNull(apiKey.Note),
+			CreatedAt:  timeValueOrNull(apiKey.CreatedAt),
+			LastUsedAt: timeValueOrNull(apiKey.LastUsedAt),
+			AgeDays:    types.Int64Null(),
+		}
+		if apiKey.CreatedAt != nil {
+			ageDays := int64(now.Sub(*apiKey.CreatedAt) / (24 * time.Hour))
+			entry.AgeDays = types.Int64Value(ageDays)
+			if data.MaxAgeDays.IsNull() || ageDays > data.MaxAgeDays.ValueInt64() {
+				data.MaxAgeDays = types.Int64Value(ageDays)
+			}
+		}
+		data.Keys = append(data.Keys, entry)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// timeValueOrNull formats t as RFC 3339, or returns null for a time the API did not report.
+func timeValueOrNull(t *time.Time) types.String {
+	if t == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(t.UTC().Format(time.RFC3339))
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse/mocks"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestOrganizationApiKeysDataSourceRead(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	created := func(age time.Duration) *time.Time {
+		t := now.Add(-age)
+		return &t
+	}
+
+	d := NewOrganizationApiKeysDataSource().(*organizationApiKeysDataSource)
+	clientFactory := mocks.NewMockClientFactory(ctrl)
+	clientFactory.Deps = langfuse.Runtime{Now: func() time.Time { return now }}
+	d.Configure(ctx, datasource.ConfigureRequest{ProviderData: clientFactory}, &datasource.ConfigureResponse{})
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	if diags := schemaResp.Schema.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("schema implementation validation failed: %v", diags)
+	}
+
+	clientFactory.AdminClient.EXPECT().ListOrganizationApiKeys(ctx, "org-1").Return([]langfuse.OrganizationApiKey{
+		{ID: "key-3", PublicKey: "pk-lf-3"},
+		{ID: "key-2", PublicKey: "pk-lf-2", CreatedAt: created(120*24*time.Hour + time.Hour), Note: "ci"},
+		{ID: "key-1", PublicKey: "pk-lf-1", CreatedAt: created(23 * time.Hour), LastUsedAt: created(time.Hour)},
+	}, nil)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{
+		"organization_id": tftypes.NewValue(tftypes.String, "org-1"),
+	}
+	for name, attributeType := range objectType.AttributeTypes {
+		if _, ok := values[name]; !ok {
+			values[name] = tftypes.NewValue(attributeType, nil)
+		}
+	}
+
+	readResp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics from Read: %v", readResp.Diagnostics)
+	}
+
+	var data organizationApiKeysDataSourceModel
+	readResp.Diagnostics.Append(readResp.State.Get(ctx, &data)...)
+	if len(data.Keys) != 3 || data.Keys[0].ID.ValueString() != "key-1" || data.Keys[2].ID.ValueString() != "key-3" {
+		t.Fatalf("unexpected keys: %v", data.Keys)
+	}
+	if data.Keys[0].AgeDays.ValueInt64() != 0 || data.Keys[1].AgeDays.ValueInt64() != 120 || !data.Keys[2].AgeDays.IsNull() {
+		t.Errorf("unexpected ages: %s, %s, %s", data.Keys[0].AgeDays, data.Keys[1].AgeDays, data.Keys[2].AgeDays)
+	}
+	if data.Keys[0].LastUsedAt.ValueString() != "2026-03-01T11:00:00Z" || !data.Keys[1].LastUsedAt.IsNull() {
+		t.Errorf("unexpected last used times: %s, %s", data.Keys[0].LastUsedAt, data.Keys[1].LastUsedAt)
+	}
+	if data.MaxAgeDays.ValueInt64() != 120 {
+		t.Errorf("unexpected max_age_days %s", data.MaxAgeDays)
+	}
+}
@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+)
+
+// validateOrganizationCredentials checks that a resource sets at most one of an explicit
+// organization key pair or a credential_ref pointing at provider-level credentials. When
+// neither is set, the provider falls back to its organization_public_key/organization_secret_key
+// or LANGFUSE_ORG_PUBLIC_KEY/LANGFUSE_ORG_SECRET_KEY.
+func validateOrganizationCredentials(publicKey, privateKey, credentialRef types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !credentialRef.IsNull() {
+		if !publicKey.IsNull() || !privateKey.IsNull() {
+			diags.AddAttributeError(
+				path.Root("credential_ref"),
+				"Conflicting organization credentials",
+				"credential_ref cannot be combined with organization_public_key or organization_private_key.",
+			)
+		}
+		return diags
+	}
+
+	if publicKey.IsNull() != privateKey.IsNull() {
+		diags.AddError(
+			"Incomplete organization credentials",
+			"organization_public_key and organization_private_key must be set together.",
+		)
+	}
+
+	return diags
+}
+
+// newOrganizationClient builds an organization client from the key pair set on the resource,
+// from the provider-level credentials referenced by credential_ref, or from the default
+// organization credentials of the provider configuration or the environment.
+func newOrganizationClient(clientFactory langfuse.ClientFactory, publicKey, privateKey, credentialRef types.String) (langfuse.OrganizationClient, diag.Diagnostics) {
+	diags := checkProviderConfigurationKnown(clientFactory)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if !credentialRef.IsNull() && credentialRef.ValueString() != "" {
+		credentials, ok := clientFactory.OrganizationCredentials(credentialRef.ValueString())
+		if !ok {
+			diags.AddAttributeError(
+				path.Root("credential_ref"),
+				"Unknown organization credentials",
+				fmt.Sprintf("No credentials named %q are declared in the provider configuration.", credentialRef.ValueString()),
+			)
+			return nil, diags
+		}
+		return clientFactory.NewOrganizationClient(credentials.PublicKey, credentials.PrivateKey), diags
+	}
+
+	if publicKey.ValueString() != "" && privateKey.ValueString() != "" {
+		return clientFactory.NewOrganizationClient(publicKey.ValueString(), privateKey.ValueString()), diags
+	}
+
+	credentials, ok := clientFactory.DefaultOrganizationCredentials()
+	if !ok {
+		diags.AddError(
+			"Missing organization credentials",
+			"Set organization_public_key and organization_private_key, reference provider-level credentials with credential_ref, "+
+				"set organization_public_key and organization_secret_key in the provider configuration, "+
+				"or export LANGFUSE_ORG_PUBLIC_KEY and LANGFUSE_ORG_SECRET_KEY.",
+		)
+		return nil, diags
+	}
+
+	return clientFactory.NewOrganizationClient(credentials.PublicKey, credentials.PrivateKey), diags
+}
+
+// addOrganizationClientError records a failed organization API call. When an explicit organization
+// key pair, typically taken from a langfuse_organization_api_key, is rejected with a 401, the admin
+// API is asked whether the key still exists. A key that is gone was rotated or deleted out of band;
+// that is reported with remediation steps instead of a generic authentication failure. Whenever the
+// lookup is not possible (no admin API, other credentials in use) this falls back to addClientError.
+func addOrganizationClientError(ctx context.Context, diags *diag.Diagnostics, clientFactory langfuse.ClientFactory, summary string, publicKey types.String, organizationID string, err error) {
+	var authErr *langfuse.AuthError
+	if !errors.As(err, &authErr) || !authErr.IsAuthenticationFailure() || authErr.Credential != langfuse.CredentialTypeOrganizationKey ||
+		publicKey.ValueString() == "" || clientFactory == nil || !clientFactory.HasAdminAPIKey() || isLangfuseCloudHost(clientFactory.Host()) {
+		addClientError(diags, summary, err)
+		return
+	}
+
+	exists, lookupErr := organizationApiKeyExists(ctx, clientFactory.NewAdminClient(), publicKey.ValueString(), organizationID)
+	if lookupErr != nil || exists {
+		if lookupErr != nil {
+			tflog.Debug(ctx, "Could not check whether the organization API key still exists", map[string]any{"error": lookupErr.Error()})
+		}
+		addClientError(diags, summary, err)
+		return
+	}
+
+	diags.AddAttributeError(
+		path.Root("organization_public_key"),
+		summary+": organization key has been rotated",
+		fmt.Sprintf("The organization API key %s no longer exists in Langfuse; it was rotated or deleted outside of this configuration.\n\n"+
+			"If the key comes from a langfuse_organization_api_key resource, run terraform apply with -target on that resource so it "+
+			"is recreated, then apply again so this resource picks up the new key pair. Otherwise set organization_public_key and "+
+			"organization_private_key to a current key pair.\n\n%s", publicKey.ValueString(), err.Error()),
+	)
+}
+
+// organizationApiKeyExists looks up an organization API key by public key, in organizationID or,
+// when that is empty, in every organization of the instance.
+func organizationApiKeyExists(ctx context.Context, adminClient langfuse.AdminClient, publicKey, organizationID string) (bool, error) {
+	organizationIDs := []string{organizationID}
+	if organizationID == "" {
+		organizations, err := adminClient.ListOrganizations(ctx)
+		if err != nil {
+			return false, err
+		}
+		organizationIDs = organizationIDs[:0]
+		for _, organization := range organizations {
+			organizationIDs = append(organizationIDs, organization.ID)
+		}
+	}
+
+	for _, id := range organizationIDs {
+		apiKeys, err := adminClient.ListOrganizationApiKeys(ctx, id)
+		if err != nil {
+			return false, err
+		}
+		for _, apiKey := range apiKeys {
+			if apiKey.PublicKey == publicKey {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
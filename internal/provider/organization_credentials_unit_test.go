@@ -0,0 +1,231 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse/mocks"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateOrganizationCredentials(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		publicKey     types.String
+		privateKey    types.String
+		credentialRef types.String
+		expectError   bool
+	}{
+		{
+			name:          "key pair",
+			publicKey:     types.StringValue("pk-123"),
+			privateKey:    types.StringValue("sk-123"),
+			credentialRef: types.StringNull(),
+		},
+		{
+			name:          "credential ref",
+			publicKey:     types.StringNull(),
+			privateKey:    types.StringNull(),
+			credentialRef: types.StringValue("prod-org"),
+		},
+		{
+			name:          "unknown keys",
+			publicKey:     types.StringUnknown(),
+			privateKey:    types.StringUnknown(),
+			credentialRef: types.StringNull(),
+		},
+		{
+			name:          "both key pair and credential ref",
+			publicKey:     types.StringValue("pk-123"),
+			privateKey:    types.StringValue("sk-123"),
+			credentialRef: types.StringValue("prod-org"),
+			expectError:   true,
+		},
+		{
+			name:          "only public key",
+			publicKey:     types.StringValue("pk-123"),
+			privateKey:    types.StringNull(),
+			credentialRef: types.StringNull(),
+			expectError:   true,
+		},
+		{
+			name:          "nothing set",
+			publicKey:     types.StringNull(),
+			privateKey:    types.StringNull(),
+			credentialRef: types.StringNull(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			diags := validateOrganizationCredentials(tc.publicKey, tc.privateKey, tc.credentialRef)
+			if diags.HasError() != tc.expectError {
+				t.Fatalf("unexpected validation result. got error=%t, want error=%t: %v", diags.HasError(), tc.expectError, diags)
+			}
+		})
+	}
+}
+
+func TestNewOrganizationClientCredentialRef(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	clientFactory := mocks.NewMockClientFactory(ctrl)
+	clientFactory.Credentials["prod-org"] = langfuse.OrganizationCredentials{PublicKey: "pk-123", PrivateKey: "sk-123"}
+
+	t.Run("Known reference", func(t *testing.T) {
+		client, diags := newOrganizationClient(clientFactory, types.StringNull(), types.StringNull(), types.StringValue("prod-org"))
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if client == nil {
+			t.Fatal("expected an organization client")
+		}
+	})
+
+	t.Run("Unknown reference", func(t *testing.T) {
+		_, diags := newOrganizationClient(clientFactory, types.StringNull(), types.StringNull(), types.StringValue("staging-org"))
+		if !diags.HasError() {
+			t.Fatal("expected an error for an undeclared credential reference")
+		}
+		if diags.Errors()[0].Summary() != "Unknown organization credentials" {
+			t.Errorf("unexpected error summary: %q", diags.Errors()[0].Summary())
+		}
+	})
+}
+
+func TestNewOrganizationClientDefaultCredentials(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	clientFactory := mocks.NewMockClientFactory(ctrl)
+
+	t.Run("Without default credentials", func(t *testing.T) {
+		_, diags := newOrganizationClient(clientFactory, types.StringNull(), types.StringNull(), types.StringNull())
+		if !diags.HasError() {
+			t.Fatal("expected an error when no credentials are available")
+		}
+		if diags.Errors()[0].Summary() != "Missing organization credentials" {
+			t.Errorf("unexpected error summary: %q", diags.Errors()[0].Summary())
+		}
+	})
+
+	t.Run("With default credentials", func(t *testing.T) {
+		clientFactory.DefaultCredentials = &langfuse.OrganizationCredentials{PublicKey: "pk-env", PrivateKey: "sk-env"}
+
+		client, diags := newOrganizationClient(clientFactory, types.StringNull(), types.StringNull(), types.StringNull())
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if client == nil {
+			t.Fatal("expected an organization client")
+		}
+	})
+}
+
+func TestAddOrganizationClientErrorRotatedKey(t *testing.T) {
+	t.Parallel()
+
+	unauthorized := &langfuse.AuthError{StatusCode: 401, Method: "GET", Path: "/api/public/projects/project-123", Credential: langfuse.CredentialTypeOrganizationKey}
+
+	testCases := []struct {
+		name           string
+		setup          func(adminClient *mocks.MockAdminClient)
+		publicKey      types.String
+		organizationID string
+		err            error
+		expectSummary  string
+	}{
+		{
+			name: "key gone from organization",
+			setup: func(adminClient *mocks.MockAdminClient) {
+				adminClient.EXPECT().ListOrganizationApiKeys(context.Background(), "org-123").
+					Return([]langfuse.OrganizationApiKey{{ID: "key-2", PublicKey: "pk-new"}}, nil)
+			},
+			publicKey:      types.StringValue("pk-old"),
+			organizationID: "org-123",
+			err:            unauthorized,
+			expectSummary:  "Error reading project: organization key has been rotated",
+		},
+		{
+			name: "key gone from every organization",
+			setup: func(adminClient *mocks.MockAdminClient) {
+				adminClient.EXPECT().ListOrganizations(context.Background()).
+					Return([]*langfuse.Organization{{ID: "org-123"}, {ID: "org-456"}}, nil)
+				adminClient.EXPECT().ListOrganizationApiKeys(context.Background(), "org-123").Return(nil, nil)
+				adminClient.EXPECT().ListOrganizationApiKeys(context.Background(), "org-456").Return(nil, nil)
+			},
+			publicKey:     types.StringValue("pk-old"),
+			err:           unauthorized,
+			expectSummary: "Error reading project: organization key has been rotated",
+		},
+		{
+			name: "key still exists",
+			setup: func(adminClient *mocks.MockAdminClient) {
+				adminClient.EXPECT().ListOrganizationApiKeys(context.Background(), "org-123").
+					Return([]langfuse.OrganizationApiKey{{ID: "key-1", PublicKey: "pk-old"}}, nil)
+			},
+			publicKey:      types.StringValue("pk-old"),
+			organizationID: "org-123",
+			err:            unauthorized,
+			expectSummary:  "Error reading project: authentication failed",
+		},
+		{
+			name: "admin API unavailable",
+			setup: func(adminClient *mocks.MockAdminClient) {
+				adminClient.EXPECT().ListOrganizationApiKeys(context.Background(), "org-123").
+					Return(nil, &langfuse.AuthError{StatusCode: 401, Credential: langfuse.CredentialTypeAdminKey})
+			},
+			publicKey:      types.StringValue("pk-old"),
+			organizationID: "org-123",
+			err:            unauthorized,
+			expectSummary:  "Error reading project: authentication failed",
+		},
+		{
+			name:           "credentials from provider configuration",
+			setup:          func(adminClient *mocks.MockAdminClient) {},
+			publicKey:      types.StringNull(),
+			organizationID: "org-123",
+			err:            unauthorized,
+			expectSummary:  "Error reading project: authentication failed",
+		},
+		{
+			name:           "forbidden",
+			setup:          func(adminClient *mocks.MockAdminClient) {},
+			publicKey:      types.StringValue("pk-old"),
+			organizationID: "org-123",
+			err:            &langfuse.AuthError{StatusCode: 403, Credential: langfuse.CredentialTypeOrganizationKey},
+			expectSummary:  "Error reading project: permission denied",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			clientFactory := mocks.NewMockClientFactory(ctrl)
+			tc.setup(clientFactory.AdminClient)
+
+			var diags diag.Diagnostics
+			addOrganizationClientError(context.Background(), &diags, clientFactory, "Error reading project", tc.publicKey, tc.organizationID, tc.err)
+			if len(diags.Errors()) != 1 {
+				t.Fatalf("expected exactly one error, got %v", diags)
+			}
+			if got := diags.Errors()[0].Summary(); got != tc.expectSummary {
+				t.Errorf("unexpected summary: got %q, want %q", got, tc.expectSummary)
+			}
+		})
+	}
+}
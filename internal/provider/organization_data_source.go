@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+)
+
+var _ datasource.DataSource = &organizationDataSource{}
+
+func NewOrganizationDataSource() datasource.DataSource {
+	return &organizationDataSource{}
+}
+
+type organizationDataSourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Metadata types.Map    `tfsdk:"metadata"`
+}
+
+type organizationDataSource struct {
+	ClientFactory langfuse.ClientFactory
+}
+
+func (d *organizationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clientFactory, ok := req.ProviderData.(langfuse.ClientFactory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected langfuse.ClientFactory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.ClientFactory = clientFactory
+	resp.Diagnostics.Append(checkAdminAPIKeyConfigured(clientFactory, "langfuse_organization")...)
+}
+
+func (d *organizationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization"
+}
+
+func (d *organizationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up an existing organization by name or ID through the admin API, so that modules can consume organization IDs " +
+			"without hardcoding them. Requires the provider's admin API key.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the organization. Exactly one of `id` and `name` must be set.",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the organization. Exactly one of `id` and `name` must be set; the name must match a single organization.",
+			},
+			"metadata": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Metadata of the organization.",
+			},
+		},
+	}
+}
+
+func (d *organizationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer addRunWarnings(&resp.Diagnostics, d.ClientFactory)
+
+	resp.Diagnostics.Append(checkInstanceVersion(ctx, d.ClientFactory, "langfuse_organization")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data organizationDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withRequestAttributes(ctx, d.ClientFactory, data.ID, types.StringNull())
+
+	if data.ID.IsNull() == data.Name.IsNull() {
+		resp.Diagnostics.AddError(
+			"Invalid organization lookup",
+			"Exactly one of id and name must be set to look up an organization.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(checkAdminAPIAvailable(d.ClientFactory, "langfuse_organization")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizations, err := d.ClientFactory.NewAdminClient().ListOrganizations(ctx)
+	if err != nil {
+		addAdminClientError(&resp.Diagnostics, d.ClientFactory, "langfuse_organization", "Error listing organizations", err)
+		return
+	}
+
+	var matches []*langfuse.Organization
+	for _, organization := range organizations {
+		if organization.ID == data.ID.ValueString() || !data.Name.IsNull() && organization.Name == data.Name.ValueString() {
+			matches = append(matches, organization)
+		}
+	}
+
+	lookup := "ID " + data.ID.ValueString()
+	if !data.Name.IsNull() {
+		lookup = "name " + data.Name.ValueString()
+	}
+	switch len(matches) {
+	case 0:
+		resp.Diagnostics.AddError(
+			"Organization not found",
+			fmt.Sprintf("The instance has no organization with %s.", lookup),
+		)
+		return
+	case 1:
+	default:
+		resp.Diagnostics.AddError(
+			"Ambiguous organization name",
+			fmt.Sprintf("%d organizations have the %s. Look the organization up by id instead.", len(matches), lookup),
+		)
+		return
+	}
+
+	organization := matches[0]
+	data.ID = types.StringValue(organization.ID)
+	data.Name = types.StringValue(organization.Name)
+
+	metadata := organization.Metadata
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+	metadataMap, diags := types.MapValueFrom(ctx, types.StringType, metadata)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Metadata = metadataMap
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
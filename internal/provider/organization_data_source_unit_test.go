@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse/mocks"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestOrganizationDataSourceRead(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	organizations := []*langfuse.Organization{
+		{ID: "org-1", Name: "Acme Inc", Metadata: map[string]string{"cost_center": "42"}},
+		{ID: "org-2", Name: "Shadow"},
+		{ID: "org-3", Name: "Shadow"},
+	}
+
+	testCases := []struct {
+		name          string
+		values        map[string]tftypes.Value
+		wantID        string
+		expectedError string
+	}{
+		{name: "by name", values: map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, "Acme Inc")}, wantID: "org-1"},
+		{name: "by ID", values: map[string]tftypes.Value{"id": tftypes.NewValue(tftypes.String, "org-2")}, wantID: "org-2"},
+		{name: "not found", values: map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, "Globex")}, expectedError: "Organization not found"},
+		{name: "ambiguous name", values: map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, "Shadow")}, expectedError: "Ambiguous organization name"},
+		{name: "neither ID nor name", values: map[string]tftypes.Value{}, expectedError: "Invalid organization lookup"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := NewOrganizationDataSource().(*organizationDataSource)
+			clientFactory := mocks.NewMockClientFactory(ctrl)
+			d.Configure(ctx, datasource.ConfigureRequest{ProviderData: clientFactory}, &datasource.ConfigureResponse{})
+			clientFactory.AdminClient.EXPECT().ListOrganizations(gomock.Any()).Return(organizations, nil).AnyTimes()
+
+			var schemaResp datasource.SchemaResponse
+			d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+			if diags := schemaResp.Schema.ValidateImplementation(ctx); diags.HasError() {
+				t.Fatalf("schema implementation validation failed: %v", diags)
+			}
+			objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+			for name, attributeType := range objectType.AttributeTypes {
+				if _, ok := tc.values[name]; !ok {
+					tc.values[name] = tftypes.NewValue(attributeType, nil)
+				}
+			}
+			config := tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, tc.values)}
+
+			readResp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+			d.Read(ctx, datasource.ReadRequest{Config: config}, &readResp)
+
+			if tc.expectedError != "" {
+				if !readResp.Diagnostics.HasError() || readResp.Diagnostics.Errors()[0].Summary() != tc.expectedError {
+					t.Fatalf("expected %q, got: %v", tc.expectedError, readResp.Diagnostics)
+				}
+				return
+			}
+			if readResp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics from Read: %v", readResp.Diagnostics)
+			}
+
+			var data organizationDataSourceModel
+			readResp.Diagnostics.Append(readResp.State.Get(ctx, &data)...)
+			if data.ID.ValueString() != tc.wantID {
+				t.Errorf("expected organization %s, got %+v", tc.wantID, data)
+			}
+			if tc.wantID == "org-1" && (data.Name.ValueString() != "Acme Inc" || data.Metadata.Elements()["cost_center"].String() != `"42"`) {
+				t.Errorf("unexpected organization attributes: %+v", data)
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+)
+
+const organizationDeletionNotConfirmed = "Organization deletion not confirmed"
+
+// organizationDestroyCounts are the objects Langfuse deletes along with an organization.
+type organizationDestroyCounts struct {
+	Projects            int
+	ProjectAPIKeys      int
+	OrganizationAPIKeys int
+	Memberships         int
+}
+
+func (c organizationDestroyCounts) String() string {
+	return fmt.Sprintf("%d projects, %d project API keys, %d organization API keys and %d memberships",
+		c.Projects, c.ProjectAPIKeys, c.OrganizationAPIKeys, c.Memberships)
+}
+
+// errNoOrganizationCredentials is returned by countOrganizationObjects when no existing key pair of
+// the organization is available and creating a temporary one is not allowed.
+var errNoOrganizationCredentials = errors.New("no organization key pair of the organization is available: set credential_ref, " +
+	"or the provider organization_public_key and organization_secret_key (or LANGFUSE_ORG_PUBLIC_KEY and LANGFUSE_ORG_SECRET_KEY)")
+
+// countOrganizationObjects counts the projects, API keys and memberships of an organization. The
+// admin API cannot list the projects and memberships of an organization, so they are listed with
+// credentials, an existing key pair of the organization. Without one, and only when temporaryKey is
+// set, a temporary organization API key is created for the lookup and deleted again, like the
+// acceptance test sweepers do. The temporary key is not counted.
+func countOrganizationObjects(ctx context.Context, clientFactory langfuse.ClientFactory, organizationID string, credentials *langfuse.OrganizationCredentials, temporaryKey bool) (organizationDestroyCounts, error) {
+	var counts organizationDestroyCounts
+	adminClient := clientFactory.NewAdminClient()
+
+	organizationKeys, err := adminClient.ListOrganizationApiKeys(ctx, organizationID)
+	if err != nil {
+		return counts, fmt.Errorf("listing organization API keys: %w", err)
+	}
+	counts.OrganizationAPIKeys = len(organizationKeys)
+
+	// Key pairs of other organizations would count the objects of those instead.
+	if credentials != nil && !slices.ContainsFunc(organizationKeys, func(key langfuse.OrganizationApiKey) bool {
+		return key.PublicKey == credentials.PublicKey
+	}) {
+		credentials = nil
+	}
+	if credentials != nil {
+		err := countOrganizationClientObjects(ctx, clientFactory.NewOrganizationClient(credentials.PublicKey, credentials.PrivateKey), &counts)
+		return counts, err
+	}
+	if !temporaryKey {
+		return counts, errNoOrganizationCredentials
+	}
+
+	apiKey, err := adminClient.CreateOrganizationApiKey(ctx, organizationID)
+	if err != nil {
+		return counts, fmt.Errorf("creating a temporary organization API key: %w", err)
+	}
+	countErr := countOrganizationClientObjects(ctx, clientFactory.NewOrganizationClient(apiKey.PublicKey, apiKey.SecretKey), &counts)
+	if err := adminClient.DeleteOrganizationApiKey(ctx, organizationID, apiKey.ID); err != nil {
+		countErr = errors.Join(countErr, fmt.Errorf("deleting temporary organization API key %s: %w", apiKey.ID, err))
+	}
+	return counts, countErr
+}
+
+// countOrganizationClientObjects counts the projects, project API keys and memberships visible to
+// an organization client.
+func countOrganizationClientObjects(ctx context.Context, organizationClient langfuse.OrganizationClient, counts *organizationDestroyCounts) error {
+	projects, err := organizationClient.ListProjects(ctx)
+	if err != nil {
+		return fmt.Errorf("listing projects: %w", err)
+	}
+	counts.Projects = len(projects)
+	for _, project := range projects {
+		projectKeys, err := organizationClient.ListProjectApiKeys(ctx, project.ID)
+		if err != nil {
+			return fmt.Errorf("listing API keys of project %s: %w", project.ID, err)
+		}
+		counts.ProjectAPIKeys += len(projectKeys)
+	}
+	memberships, err := organizationClient.ListMemberships(ctx)
+	if err != nil {
+		return fmt.Errorf("listing memberships: %w", err)
+	}
+	counts.Memberships = len(memberships)
+	return nil
+}
+
+// destroyCredentials returns the existing organization key pair to count the objects of the
+// organization with: the provider credentials named by credential_ref, or the default
+// organization credentials.
+func (m *organizationResourceModel) destroyCredentials(clientFactory langfuse.ClientFactory) *langfuse.OrganizationCredentials {
+	if name := m.CredentialRef.ValueString(); name != "" {
+		if credentials, ok := clientFactory.OrganizationCredentials(name); ok {
+			return &credentials
+		}
+		return nil
+	}
+	if credentials, ok := clientFactory.DefaultOrganizationCredentials(); ok {
+		return &credentials
+	}
+	return nil
+}
+
+// hasDestroyThresholds reports whether deleting the organization needs confirmation above some count.
+func (m *organizationResourceModel) hasDestroyThresholds() bool {
+	return !m.DestroyThresholdProjects.IsNull() || !m.DestroyThresholdAPIKeys.IsNull() || !m.DestroyThresholdMembers.IsNull()
+}
+
+// exceededDestroyThresholds returns the threshold attributes the counts exceed. API keys are
+// project and organization API keys together.
+func (m *organizationResourceModel) exceededDestroyThresholds(counts organizationDestroyCounts) []string {
+	var exceeded []string
+	for _, threshold := range []struct {
+		name  string
+		value types.Int64
+		count int
+	}{
+		{"destroy_threshold_projects", m.DestroyThresholdProjects, counts.Projects},
+		{"destroy_threshold_api_keys", m.DestroyThresholdAPIKeys, counts.ProjectAPIKeys + counts.OrganizationAPIKeys},
+		{"destroy_threshold_members", m.DestroyThresholdMembers, counts.Memberships},
+	} {
+		if !threshold.value.IsNull() && int64(threshold.count) > threshold.value.ValueInt64() {
+			exceeded = append(exceeded, fmt.Sprintf("%s (%d)", threshold.name, threshold.value.ValueInt64()))
+		}
+	}
+	return exceeded
+}
+
+// checkOrganizationDestroy reports what deleting the organization in data deletes along with it
+// and fails when the counts exceed a destroy threshold without confirm_destroy. Both settings are
+// read from state, since destroy plans have no configuration. Plans (planning set) only count with
+// existing organization credentials, so that planning never changes the instance; without them the
+// report is skipped and the thresholds are enforced by Delete, which may create a temporary key.
+func checkOrganizationDestroy(ctx context.Context, clientFactory langfuse.ClientFactory, data organizationResourceModel, planning bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+	report := planning && data.DestroyReport.ValueBool()
+	guarded := data.hasDestroyThresholds() && !data.ConfirmDestroy.ValueBool()
+	if clientFactory == nil || !report && !guarded {
+		return diags
+	}
+
+	organization := fmt.Sprintf("%q (%s)", data.Name.ValueString(), data.ID.ValueString())
+	if planning {
+		if known := checkProviderConfigurationKnown(clientFactory); known.HasError() {
+			diags.AddWarning("Organization deletion report unavailable", fmt.Sprintf("Could not count the objects of organization %s: %s",
+				organization, known.Errors()[0].Detail()))
+			return diags
+		}
+	}
+
+	counts, err := countOrganizationObjects(ctx, clientFactory, data.ID.ValueString(), data.destroyCredentials(clientFactory), !planning)
+	if err != nil {
+		switch {
+		case planning:
+			detail := fmt.Sprintf("Could not count the objects of organization %s: %s", organization, err)
+			if guarded {
+				detail += "\n\nThe destroy thresholds are checked when the organization is deleted instead."
+			}
+			diags.AddWarning("Organization deletion report unavailable", detail)
+		case guarded:
+			diags.AddError(
+				organizationDeletionNotConfirmed,
+				fmt.Sprintf("Could not count the objects of organization %s to check its destroy thresholds: %s\n\n"+
+					"Set confirm_destroy = true and apply it before destroying the organization to delete it anyway.", organization, err),
+			)
+		}
+		return diags
+	}
+
+	if report {
+		diags.AddWarning(
+			"Organization deletion report",
+			fmt.Sprintf("Destroying organization %s also deletes its %s.", organization, counts),
+		)
+	}
+	if exceeded := data.exceededDestroyThresholds(counts); guarded && len(exceeded) > 0 {
+		diags.AddError(
+			organizationDeletionNotConfirmed,
+			fmt.Sprintf("Organization %s has %s, more than %s allow without confirmation. Set confirm_destroy = true and apply it "+
+				"before destroying the organization.", organization, counts, strings.Join(exceeded, ", ")),
+		)
+	}
+	return diags
+}
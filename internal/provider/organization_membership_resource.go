@@ -2,20 +2,28 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"slices"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
 )
 
 var _ resource.Resource = &organizationMembershipResource{}
 var _ resource.ResourceWithImportState = &organizationMembershipResource{}
+var _ resource.ResourceWithValidateConfig = &organizationMembershipResource{}
+var _ resource.ResourceWithModifyPlan = &organizationMembershipResource{}
 
 func NewOrganizationMembershipResource() resource.Resource {
 	return &organizationMembershipResource{}
@@ -30,8 +38,87 @@ type organizationMembershipResourceModel struct {
 	Username               types.String `tfsdk:"username"`
 	OrganizationPublicKey  types.String `tfsdk:"organization_public_key"`
 	OrganizationPrivateKey types.String `tfsdk:"organization_private_key"`
+	CredentialRef          types.String `tfsdk:"credential_ref"`
+	WaitForAcceptance      types.String `tfsdk:"wait_for_acceptance"`
+	ExpiresAt              types.String `tfsdk:"expires_at"`
+	DisplayName            types.String `tfsdk:"display_name"`
+	ExternalID             types.String `tfsdk:"external_id"`
+	Permissions            types.Set    `tfsdk:"permissions"`
+	Host                   types.String `tfsdk:"host"`
+
+	UpdateCredentialsInPlace types.Bool `tfsdk:"update_credentials_in_place"`
 }
 
+// Project-level scopes an organization role grants in every project of the organization, unless
+// a project role overrides it, following the access rights Langfuse defines for its roles. Project
+// data is read-only for VIEWER and read-write for MEMBER; ADMIN and OWNER also manage the projects
+// themselves, their members and their API keys.
+var (
+	viewerProjectPermissions = []string{
+		"annotationQueues:read", "comments:read", "dashboards:read", "evalJob:read", "evalTemplate:read", "project:read",
+		"prompts:read", "scoreConfigs:read",
+	}
+	memberProjectPermissions = slices.Concat(viewerProjectPermissions, []string{
+		"annotationQueues:CUD", "batchExports:create", "batchExports:read", "comments:CUD", "dashboards:CUD", "datasets:CUD",
+		"evalJob:CUD", "evalTemplate:CUD", "llmApiKeys:read", "objects:bookmark", "objects:publish", "objects:tag",
+		"projectMembers:read", "prompts:CUD", "scoreConfigs:CUD", "scores:CUD",
+	})
+	adminProjectPermissions = slices.Concat(memberProjectPermissions, []string{
+		"apiKeys:CUD", "apiKeys:read", "integrations:CRUD", "llmApiKeys:create", "llmApiKeys:delete", "llmApiKeys:update",
+		"project:delete", "project:update", "projectMembers:CUD", "traces:delete",
+	})
+)
+
+// membershipRolePermissions maps organization roles to the organization-level permission scopes
+// Langfuse grants them, followed by the project-level scopes they grant by default, so that every
+// role change, including MEMBER to VIEWER, shows a permission delta.
+var membershipRolePermissions = map[string][]string{
+	"OWNER": slices.Concat([]string{
+		"auditLogs:read", "langfuseCloudBilling:CRUD", "organization:CRUD_apiKeys", "organization:delete", "organization:update",
+		"organizationMembers:CUD", "organizationMembers:read", "projects:create", "projects:transfer_org",
+	}, adminProjectPermissions),
+	"ADMIN": slices.Concat([]string{
+		"auditLogs:read", "organization:CRUD_apiKeys", "organization:update", "organizationMembers:CUD", "organizationMembers:read",
+		"projects:create", "projects:transfer_org",
+	}, adminProjectPermissions),
+	"MEMBER": slices.Concat([]string{"organizationMembers:read"}, memberProjectPermissions),
+	"VIEWER": slices.Concat([]string{"organizationMembers:read"}, viewerProjectPermissions),
+}
+
+// membershipPermissions returns the permissions of role; they are unknown while the role is.
+func membershipPermissions(role types.String) types.Set {
+	if role.IsUnknown() {
+		return types.SetUnknown(types.StringType)
+	}
+	permissions := make([]attr.Value, 0, len(membershipRolePermissions[role.ValueString()]))
+	for _, permission := range membershipRolePermissions[role.ValueString()] {
+		permissions = append(permissions, types.StringValue(permission))
+	}
+	return types.SetValueMust(types.StringType, permissions)
+}
+
+// membershipStatusExpired is the status of a membership whose expires_at has passed and whose user
+// has therefore been removed from the organization. Langfuse itself has no such status.
+const membershipStatusExpired = "EXPIRED"
+
+// membershipRequestFields maps the fields of membership requests to the attributes they are set from.
+var membershipRequestFields = map[string]path.Path{
+	"email": path.Root("email"),
+	"role":  path.Root("role"),
+}
+
+// membershipPollInterval is how often a membership is re-read while waiting for acceptance.
+var membershipPollInterval = 10 * time.Second
+
+// scimPropagationTimeout bounds how long the memberships are re-listed until a user created through
+// SCIM shows up; the listing starts scimPropagationBackoff apart and doubles up to
+// scimPropagationMaxBackoff.
+var (
+	scimPropagationTimeout    = 30 * time.Second
+	scimPropagationBackoff    = 500 * time.Millisecond
+	scimPropagationMaxBackoff = 8 * time.Second
+)
+
 type organizationMembershipResource struct {
 	ClientFactory langfuse.ClientFactory
 }
@@ -79,9 +166,39 @@ func (r *organizationMembershipResource) Schema(ctx context.Context, req resourc
 				Description: "The role to assign to the user. Valid values are: ADMIN, MEMBER, VIEWER.",
 				Required:    true,
 			},
-			"status": schema.StringAttribute{
-				Description: "The status of the membership invitation.",
+			"permissions": schema.SetAttribute{
+				Description: "The organization-level permission scopes the role grants, e.g. `organizationMembers:CUD` for `ADMIN` and `OWNER`, followed by " +
+					"the project-level scopes it grants by default in the organization's projects, e.g. `prompts:CUD` for `MEMBER` but only `prompts:read` " +
+					"for `VIEWER`. Derived from `role`, so plans of a role change show the permission delta.",
 				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"status": schema.StringAttribute{
+				Description: "The status of the membership: `PENDING_INVITE` until the user accepted the invitation, then `ACTIVE`. " +
+					"Refreshed on every read, so a plan shows when an invitation has been accepted. `EXPIRED` once `expires_at` has passed and the user has been removed.",
+				Computed: true,
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "Time (RFC 3339) at which the access ends, e.g. for contractors. The first apply after this time removes the user from the " +
+					"organization and keeps the membership in state with status `EXPIRED`; its plan warns about the removal. Moving `expires_at` " +
+					"into the future or removing it invites the user again.",
+				Optional: true,
+			},
+			"wait_for_acceptance": schema.StringAttribute{
+				Description: "When set to a duration such as `30m`, create and update wait until the membership is `ACTIVE`, " +
+					"so resources that need an active user can depend on this one. If the invitation is not accepted in time, " +
+					"the apply fails and the membership is tainted.",
+				Optional: true,
+			},
+			"display_name": schema.StringAttribute{
+				Description: "Display name given to the user when the membership creates them through SCIM. " +
+					"Has no effect on users that already exist, and changing it later does not rename the user.",
+				Optional: true,
+			},
+			"external_id": schema.StringAttribute{
+				Description: "SCIM `externalId` given to the user when the membership creates them, i.e. the identifier your identity provider " +
+					"correlates on when it later takes over provisioning. Has no effect on users that already exist, and changing it later does not update the user.",
+				Optional: true,
 			},
 			"user_id": schema.StringAttribute{
 				Description: "The unique identifier of the user.",
@@ -92,31 +209,187 @@ func (r *organizationMembershipResource) Schema(ctx context.Context, req resourc
 				Computed:    true,
 			},
 			"organization_public_key": schema.StringAttribute{
-				Required:    true,
-				Sensitive:   true,
-				Description: "Organization public key to authenticate the call.",
+				Optional:    true,
+				Description: "Organization public key to authenticate the call. Conflicts with `credential_ref`. Defaults to LANGFUSE_ORG_PUBLIC_KEY.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					requiresReplaceUnlessCredentialsUpdatedInPlace(),
 				},
 			},
 			"organization_private_key": schema.StringAttribute{
-				Required:    true,
+				Optional:    true,
 				Sensitive:   true,
-				Description: "Organization private key to authenticate the call.",
+				Description: "Organization private key to authenticate the call. Conflicts with `credential_ref`. Defaults to LANGFUSE_ORG_SECRET_KEY.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					requiresReplaceUnlessCredentialsUpdatedInPlace(),
+				},
+			},
+			"credential_ref": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of organization credentials declared in the provider `credentials` block, used instead of `organization_public_key`/`organization_private_key`.",
+				PlanModifiers: []planmodifier.String{
+					requiresReplaceUnlessCredentialsUpdatedInPlace(),
 				},
 			},
+			"update_credentials_in_place": schema.BoolAttribute{
+				Optional: true,
+				Description: "When true, changing `organization_public_key`, `organization_private_key` or `credential_ref` updates the " +
+					"credentials in place instead of replacing the membership. The credentials only authenticate API calls, so this is " +
+					"safe whenever the new credentials belong to the same organization. Defaults to false, which removes and re-invites the user.",
+			},
+			"host": hostOverrideAttribute("membership"),
 		},
 	}
 }
 
+func (r *organizationMembershipResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config organizationMembershipResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateOrganizationCredentials(config.OrganizationPublicKey, config.OrganizationPrivateKey, config.CredentialRef)...)
+	resp.Diagnostics.Append(validateHostOverride(config.Host)...)
+
+	if !config.WaitForAcceptance.IsNull() && !config.WaitForAcceptance.IsUnknown() {
+		if _, err := time.ParseDuration(config.WaitForAcceptance.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("wait_for_acceptance"),
+				"Invalid wait_for_acceptance",
+				fmt.Sprintf("wait_for_acceptance must be a duration such as \"30m\" or \"1h\": %v", err),
+			)
+		}
+	}
+
+	if !config.ExpiresAt.IsNull() && !config.ExpiresAt.IsUnknown() {
+		if _, err := time.Parse(time.RFC3339, config.ExpiresAt.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("expires_at"),
+				"Invalid expires_at",
+				fmt.Sprintf("expires_at must be an RFC 3339 time such as \"2026-12-31T23:59:59Z\": %v", err),
+			)
+		}
+	}
+}
+
+// ModifyPlan warns when a credential change is about to replace the membership, because replacing
+// removes the user from the organization and invites them again, when SCIM attributes change that
+// only take effect at user creation, and when expires_at has passed and the user is about to be removed.
+func (r *organizationMembershipResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	addSensitiveStateSummary(ctx, r.ClientFactory, req, resp)
+
+	if !req.Plan.Raw.IsNull() {
+		var role types.String
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("role"), &role)...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("permissions"), membershipPermissions(role))...)
+		r.modifyPlanExpiry(ctx, req, resp)
+	}
+
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan organizationMembershipResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.DisplayName.Equal(state.DisplayName) || !plan.ExternalID.Equal(state.ExternalID) {
+		resp.Diagnostics.AddWarning(
+			"SCIM attributes only apply when the user is created",
+			fmt.Sprintf("display_name and external_id are only sent when the membership creates %s through SCIM. "+
+				"The new values are stored in state, but the existing user in Langfuse is not changed.", state.Email.ValueString()),
+		)
+	}
+
+	if plan.UpdateCredentialsInPlace.ValueBool() {
+		return
+	}
+
+	var changed []string
+	if !plan.OrganizationPublicKey.Equal(state.OrganizationPublicKey) {
+		changed = append(changed, "organization_public_key")
+	}
+	if !plan.OrganizationPrivateKey.Equal(state.OrganizationPrivateKey) {
+		changed = append(changed, "organization_private_key")
+	}
+	if !plan.CredentialRef.Equal(state.CredentialRef) {
+		changed = append(changed, "credential_ref")
+	}
+	if len(changed) == 0 {
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Credential change replaces organization membership",
+		fmt.Sprintf("%s of the membership of %s changed, which replaces the membership: the user is removed from the organization "+
+			"and invited again, and has to accept the new invitation (status PENDING_INVITE) before regaining access. "+
+			"The credentials are only used to authenticate API calls; set update_credentials_in_place = true to switch "+
+			"credentials without touching the membership.", strings.Join(changed, ", "), state.Email.ValueString()),
+	)
+}
+
+// modifyPlanExpiry plans the removal of a membership whose expires_at has passed by planning status
+// EXPIRED, and plans a new invitation when the expiry of an expired membership is lifted.
+func (r *organizationMembershipResource) modifyPlanExpiry(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var expiresAt types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("expires_at"), &expiresAt)...)
+	if resp.Diagnostics.HasError() || expiresAt.IsUnknown() {
+		return
+	}
+	expired := false
+	if !expiresAt.IsNull() {
+		expiry, err := time.Parse(time.RFC3339, expiresAt.ValueString())
+		expired = err == nil && !runtimeOf(r.ClientFactory).Now().Before(expiry)
+	}
+
+	if req.State.Raw.IsNull() {
+		if expired {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("expires_at"),
+				"Membership already expired",
+				fmt.Sprintf("expires_at %s has passed, so the membership would be removed right after it is created. Move expires_at into the future or remove the resource.", expiresAt.ValueString()),
+			)
+		}
+		return
+	}
+
+	var email, status types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("email"), &email)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("status"), &status)...)
+	switch {
+	case status.ValueString() == membershipStatusExpired && !expired:
+		// The user was removed at expiry; lifting the expiry invites them again.
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("expires_at"))
+	case status.ValueString() == membershipStatusExpired:
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("status"), membershipStatusExpired)...)
+	case expired:
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("status"), membershipStatusExpired)...)
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("expires_at"),
+			"Organization membership expired",
+			fmt.Sprintf("The access of %s expired at %s. This apply removes the user from the organization; the membership stays in state "+
+				"with status EXPIRED. Move expires_at into the future to invite the user again.", email.ValueString(), expiresAt.ValueString()),
+		)
+	}
+}
+
 func (r *organizationMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer addRunWarnings(&resp.Diagnostics, r.ClientFactory)
+
 	var plan organizationMembershipResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	clientFactory := clientFactoryForHost(r.ClientFactory, plan.Host)
+
+	resp.Diagnostics.Append(checkInstanceVersion(ctx, clientFactory, "langfuse_organization_membership")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Validate role is one of the allowed values
 	validRoles := []string{"OWNER","ADMIN", "MEMBER", "VIEWER"}
@@ -136,30 +409,29 @@ func (r *organizationMembershipResource) Create(ctx context.Context, req resourc
 		return
 	}
 
-	organizationClient := r.ClientFactory.NewOrganizationClient(plan.OrganizationPublicKey.ValueString(), plan.OrganizationPrivateKey.ValueString())
+	organizationClient, diags := newOrganizationClient(clientFactory, plan.OrganizationPublicKey, plan.OrganizationPrivateKey, plan.CredentialRef)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	email := plan.Email.ValueString()
 
-	// Check if the user already exists in the organization
-	memberships, err := organizationClient.ListMemberships(ctx)
-	if err != nil {
-		resp.Diagnostics.AddError("Error listing current memberships", err.Error())
+	// A user that already belongs to the organization only needs a role change. It is queued with the
+	// changes of the other memberships created in parallel, which share one membership listing.
+	membership, err := organizationClient.QueueMembershipUpdate(ctx, langfuse.UpdateMembershipRequest{Email: email, Role: role})
+	if err != nil && !errors.Is(err, langfuse.ErrNotFound) {
+		addClientFieldErrors(&resp.Diagnostics, "Error updating membership role", err, membershipRequestFields)
 		return
 	}
 
-	var existingMembership *langfuse.OrganizationMembership
-	for i := range memberships {
-		if memberships[i].Email == email {
-			existingMembership = &memberships[i]
-			break
-		}
-	}
-
 	// If user doesn't exist in organization, create them via SCIM
-	if existingMembership == nil {
+	if err != nil {
 		scimRequest := &langfuse.SCIMUserRequest{
-			UserName: email,
-			Active:   true,
+			UserName:    email,
+			Active:      true,
+			DisplayName: plan.DisplayName.ValueString(),
+			ExternalID:  plan.ExternalID.ValueString(),
 			Emails: []struct {
 				Value   string `json:"value"`
 				Primary bool   `json:"primary"`
@@ -180,26 +452,10 @@ func (r *organizationMembershipResource) Create(ctx context.Context, req resourc
 			return
 		}
 
-		// Refresh membership list to find the newly created user membership
-		memberships, err := organizationClient.ListMemberships(ctx)
-		if err != nil {
-			resp.Diagnostics.AddError("Error listing memberships after SCIM user creation", err.Error())
-			return
-		}
-
-		var newMembership *langfuse.OrganizationMembership
-		for i := range memberships {
-			if memberships[i].UserID == scimUser.ID {
-				newMembership = &memberships[i]
-				break
-			}
-		}
-
-		if newMembership == nil {
-			resp.Diagnostics.AddError(
-				"Error finding new membership",
-				fmt.Sprintf("User was created via SCIM but membership not found in organization. UserID: %s", scimUser.ID),
-			)
+		// Wait for the newly created user to show up in the memberships
+		newMembership, diags := r.waitForSCIMMembership(ctx, organizationClient, scimUser.ID)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
 			return
 		}
 
@@ -209,51 +465,33 @@ func (r *organizationMembershipResource) Create(ctx context.Context, req resourc
 			Role:   role,
 		}
 
-		membership, err := organizationClient.UpdateMembership(ctx, newMembership.ID, updateRequest)
+		membership, err = organizationClient.UpdateMembership(ctx, newMembership.ID, updateRequest)
 		if err != nil {
-			resp.Diagnostics.AddError("Error updating membership role", err.Error())
+			addClientFieldErrors(&resp.Diagnostics, "Error updating membership role", err, membershipRequestFields)
 			return
 		}
+	}
 
-		// The API may not return membership ID, so use UserID as the resource ID
-		membershipID := membership.ID
-		if membershipID == "" {
-			membershipID = membership.UserID
-		}
-
-		plan.ID = types.StringValue(membershipID)
-		plan.Email = types.StringValue(membership.Email)
-		plan.Role = types.StringValue(membership.Role)
-		plan.Status = types.StringValue(membership.Status)
-		plan.UserID = types.StringValue(membership.UserID)
-		plan.Username = types.StringValue(membership.Username)
-	} else {
-		// User already exists in organization, update their role
-		updateRequest := &langfuse.UpdateMembershipRequest{
-			UserID: existingMembership.UserID,
-			Role:   role,
-		}
-
-		membership, err := organizationClient.UpdateMembership(ctx, existingMembership.ID, updateRequest)
-		if err != nil {
-			resp.Diagnostics.AddError("Error updating membership role", err.Error())
-			return
-		}
+	// The API may not return membership ID, so use UserID as the resource ID
+	membershipID := membership.ID
+	if membershipID == "" {
+		membershipID = membership.UserID
+	}
 
-		// The API may not return membership ID, so use UserID as the resource ID
-		membershipID := membership.ID
-		if membershipID == "" {
-			membershipID = membership.UserID
-		}
+	plan.ID = types.StringValue(membershipID)
+	plan.Email = types.StringValue(membership.Email)
+	plan.Role = types.StringValue(membership.Role)
+	plan.Status = types.StringValue(membershipStatus(membership))
+	plan.UserID = types.StringValue(membership.UserID)
+	plan.Username = types.StringValue(membership.Username)
+	plan.Permissions = membershipPermissions(plan.Role)
 
-		plan.ID = types.StringValue(membershipID)
-		plan.Email = types.StringValue(membership.Email)
-		plan.Role = types.StringValue(membership.Role)
-		plan.Status = types.StringValue(membership.Status)
-		plan.UserID = types.StringValue(membership.UserID)
-		plan.Username = types.StringValue(membership.Username)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
+	resp.Diagnostics.Append(r.waitForAcceptance(ctx, organizationClient, &plan)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
@@ -264,7 +502,21 @@ func (r *organizationMembershipResource) Read(ctx context.Context, req resource.
 		return
 	}
 
-	organizationClient := r.ClientFactory.NewOrganizationClient(state.OrganizationPublicKey.ValueString(), state.OrganizationPrivateKey.ValueString())
+	if skipRefreshLookup(ctx, r.ClientFactory, "langfuse_organization_membership", !state.Email.IsNull() && !state.UserID.IsNull()) {
+		return
+	}
+
+	// Expired memberships have been removed on purpose; there is nothing left to look up.
+	if state.Status.ValueString() == membershipStatusExpired {
+		return
+	}
+
+	clientFactory := clientFactoryForHost(r.ClientFactory, state.Host)
+	organizationClient, diags := newOrganizationClient(clientFactory, state.OrganizationPublicKey, state.OrganizationPrivateKey, state.CredentialRef)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	membership, err := organizationClient.GetMembership(ctx, state.ID.ValueString())
 	if err != nil {
@@ -272,13 +524,14 @@ func (r *organizationMembershipResource) Read(ctx context.Context, req resource.
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Error reading membership", err.Error())
+		addOrganizationClientError(ctx, &resp.Diagnostics, clientFactory, "Error reading membership", state.OrganizationPublicKey, "", err)
 		return
 	}
 
 	state.Email = types.StringValue(membership.Email)
 	state.Role = types.StringValue(membership.Role)
-	state.Status = types.StringValue(membership.Status)
+	state.Permissions = membershipPermissions(state.Role)
+	state.Status = types.StringValue(membershipStatus(membership))
 	state.UserID = types.StringValue(membership.UserID)
 	state.Username = types.StringValue(membership.Username)
 
@@ -293,6 +546,8 @@ func (r *organizationMembershipResource) Read(ctx context.Context, req resource.
 }
 
 func (r *organizationMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer addRunWarnings(&resp.Diagnostics, r.ClientFactory)
+
 	var plan organizationMembershipResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -323,50 +578,272 @@ func (r *organizationMembershipResource) Update(ctx context.Context, req resourc
 		return
 	}
 
-	organizationClient := r.ClientFactory.NewOrganizationClient(state.OrganizationPublicKey.ValueString(), state.OrganizationPrivateKey.ValueString())
+	// The planned credentials are used, so credentials updated in place take effect right away.
+	organizationClient, diags := newOrganizationClient(clientFactoryForHost(r.ClientFactory, plan.Host), plan.OrganizationPublicKey, plan.OrganizationPrivateKey, plan.CredentialRef)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	updateRequest := &langfuse.UpdateMembershipRequest{
-		Role: role,
+	if plan.Status.ValueString() == membershipStatusExpired {
+		if state.Status.ValueString() != membershipStatusExpired {
+			if err := organizationClient.RemoveMember(ctx, state.UserID.ValueString()); err != nil {
+				addClientError(&resp.Diagnostics, "Error removing expired member", err)
+				return
+			}
+			resp.Diagnostics.AddWarning(
+				"Organization membership expired",
+				fmt.Sprintf("%s was removed from the organization because expires_at %s has passed.", state.Email.ValueString(), plan.ExpiresAt.ValueString()),
+			)
+		}
+		plan.ID = state.ID
+		plan.Email = state.Email
+		plan.UserID = state.UserID
+		plan.Username = state.Username
+		plan.Permissions = membershipPermissions(plan.Role)
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		return
 	}
 
-	membership, err := organizationClient.UpdateMembership(ctx, state.ID.ValueString(), updateRequest)
+	// With the user ID from state the change needs no membership lookup; it is queued with the changes
+	// of the other memberships updated in parallel.
+	membership, err := organizationClient.QueueMembershipUpdate(ctx, langfuse.UpdateMembershipRequest{
+		UserID: state.UserID.ValueString(),
+		Role:   role,
+	})
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating membership", err.Error())
+		addClientFieldErrors(&resp.Diagnostics, "Error updating membership", err, membershipRequestFields)
 		return
 	}
 
-	// The API may not return membership ID, so use UserID as the resource ID
+	// The PUT response may not include the membership ID, so keep the one from state
 	membershipID := membership.ID
 	if membershipID == "" {
-		membershipID = membership.UserID
+		membershipID = state.ID.ValueString()
 	}
 
 	plan.ID = types.StringValue(membershipID)
 	plan.Email = types.StringValue(membership.Email)
 	plan.Role = types.StringValue(membership.Role)
-	plan.Status = types.StringValue(membership.Status)
+	plan.Status = types.StringValue(membershipStatus(membership))
 	plan.UserID = types.StringValue(membership.UserID)
 	plan.Username = types.StringValue(membership.Username)
+	plan.Permissions = membershipPermissions(plan.Role)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.waitForAcceptance(ctx, organizationClient, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
 }
 
 func (r *organizationMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer addRunWarnings(&resp.Diagnostics, r.ClientFactory)
+
 	var state organizationMembershipResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	organizationClient := r.ClientFactory.NewOrganizationClient(state.OrganizationPublicKey.ValueString(), state.OrganizationPrivateKey.ValueString())
+	organizationClient, diags := newOrganizationClient(clientFactoryForHost(r.ClientFactory, state.Host), state.OrganizationPublicKey, state.OrganizationPrivateKey, state.CredentialRef)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The user of an expired membership has already been removed.
+	if state.Status.ValueString() == membershipStatusExpired {
+		return
+	}
 
 	err := organizationClient.RemoveMember(ctx, state.UserID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Error removing member", err.Error())
+		addClientError(&resp.Diagnostics, "Error removing member", err)
 		return
 	}
 }
 
+// ImportState accepts "<membership_or_user_id>,<public_key>,<secret_key>", "<membership_or_user_id>,<credential_ref>"
+// or, when LANGFUSE_ORG_PUBLIC_KEY and LANGFUSE_ORG_SECRET_KEY are set, "<membership_or_user_id>". The
+// membership is looked up with those credentials, so the imported state is complete and the next
+// Read authenticates the same way.
 func (r *organizationMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	// Import format: membership_or_user_id,organization_public_key,organization_private_key
+	// Example: terraform import langfuse_organization_membership.example "user_123,pk_789,sk_012"
+	// Alternatively: membership_or_user_id,credential_ref
+	// Example: terraform import langfuse_organization_membership.example "user_123,prod-org"
+
+	importParts := strings.Split(req.ID, ",")
+	if len(importParts) > 3 || importParts[0] == "" {
+		resp.Diagnostics.AddError("Invalid import format",
+			"Import ID must be in format: membership_or_user_id,organization_public_key,organization_private_key or "+
+				"membership_or_user_id,credential_ref, or membership_or_user_id alone when LANGFUSE_ORG_PUBLIC_KEY and LANGFUSE_ORG_SECRET_KEY are set")
+		return
+	}
+
+	membershipID := importParts[0]
+	organizationPublicKey := types.StringNull()
+	organizationPrivateKey := types.StringNull()
+	credentialRef := types.StringNull()
+	switch len(importParts) {
+	case 3:
+		organizationPublicKey = types.StringValue(importParts[1])
+		organizationPrivateKey = types.StringValue(importParts[2])
+	case 2:
+		credentialRef = types.StringValue(importParts[1])
+	}
+
+	organizationClient, diags := newOrganizationClient(r.ClientFactory, organizationPublicKey, organizationPrivateKey, credentialRef)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	membership, err := organizationClient.GetMembership(ctx, membershipID)
+	if err != nil {
+		addOrganizationClientError(ctx, &resp.Diagnostics, r.ClientFactory, "Error importing membership "+membershipID, organizationPublicKey, "", err)
+		return
+	}
+
+	state := organizationMembershipResourceModel{
+		ID:                     types.StringValue(membership.UserID),
+		Email:                  types.StringValue(membership.Email),
+		Role:                   types.StringValue(membership.Role),
+		Status:                 types.StringValue(membershipStatus(membership)),
+		UserID:                 types.StringValue(membership.UserID),
+		Username:               types.StringValue(membership.Username),
+		OrganizationPublicKey:  organizationPublicKey,
+		OrganizationPrivateKey: organizationPrivateKey,
+		CredentialRef:          credentialRef,
+		WaitForAcceptance:      types.StringNull(),
+		ExpiresAt:              types.StringNull(),
+		DisplayName:            types.StringNull(),
+		ExternalID:             types.StringNull(),
+		Host:                   types.StringNull(),
+	}
+	// The API may not return membership ID, so use UserID as the resource ID
+	if membership.ID != "" {
+		state.ID = types.StringValue(membership.ID)
+	}
+	state.Permissions = membershipPermissions(state.Role)
+
+	// display_name and external_id are only kept for users provisioned through SCIM, recognisable by
+	// their external ID. The lookup is best effort: instances without SCIM leave both null.
+	if user, err := organizationClient.GetSCIMUserByEmail(ctx, membership.Email); err == nil && user.ExternalID != "" {
+		state.DisplayName = stringValueOrNull(user.DisplayName)
+		state.ExternalID = types.StringValue(user.ExternalID)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// waitForAcceptance polls the membership until it is active when wait_for_acceptance is set,
+// keeping the status in the model up to date.
+func (r *organizationMembershipResource) waitForAcceptance(ctx context.Context, organizationClient langfuse.OrganizationClient, model *organizationMembershipResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if model.WaitForAcceptance.IsNull() || model.Status.ValueString() == langfuse.MembershipStatusActive {
+		return diags
+	}
+
+	timeout, err := time.ParseDuration(model.WaitForAcceptance.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("wait_for_acceptance"), "Invalid wait_for_acceptance", err.Error())
+		return diags
+	}
+
+	runtime := runtimeOf(r.ClientFactory)
+	deadline := runtime.Now().Add(timeout)
+	for {
+		if runtime.Now().After(deadline) {
+			diags.AddError(
+				"Membership not accepted in time",
+				fmt.Sprintf("The invitation for %s was not accepted within %s (status %s). The membership has been created and is tainted; "+
+					"the next apply recreates it and waits again. Increase wait_for_acceptance or remove it to stop waiting.",
+					model.Email.ValueString(), timeout, model.Status.ValueString()),
+			)
+			return diags
+		}
+
+		select {
+		case <-ctx.Done():
+			diags.AddError("Membership not accepted in time", ctx.Err().Error())
+			return diags
+		case <-runtime.After(membershipPollInterval):
+		}
+
+		membership, err := organizationClient.GetMembership(ctx, model.ID.ValueString())
+		if err != nil {
+			addClientError(&diags, "Error reading membership while waiting for acceptance", err)
+			return diags
+		}
+		model.Status = types.StringValue(membershipStatus(membership))
+		tflog.Debug(ctx, "Waiting for membership acceptance", map[string]any{"email": model.Email.ValueString(), "status": model.Status.ValueString()})
+		if model.Status.ValueString() == langfuse.MembershipStatusActive {
+			return diags
+		}
+	}
+}
+
+// waitForSCIMMembership lists the memberships until the one of a user just created through SCIM
+// appears, backing off between listings: Langfuse Cloud can take a few seconds to propagate it.
+func (r *organizationMembershipResource) waitForSCIMMembership(ctx context.Context, organizationClient langfuse.OrganizationClient, userID string) (*langfuse.OrganizationMembership, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	runtime := runtimeOf(r.ClientFactory)
+	deadline := runtime.Now().Add(scimPropagationTimeout)
+	backoff := scimPropagationBackoff
+	for {
+		memberships, err := organizationClient.ListMemberships(ctx)
+		if err != nil {
+			addClientError(&diags, "Error listing memberships after SCIM user creation", err)
+			return nil, diags
+		}
+		for i := range memberships {
+			if memberships[i].UserID == userID {
+				return &memberships[i], diags
+			}
+		}
+
+		remaining := deadline.Sub(runtime.Now())
+		if remaining <= 0 {
+			diags.AddError(
+				"Error finding new membership",
+				fmt.Sprintf("User was created via SCIM but membership not found in organization within %s. UserID: %s", scimPropagationTimeout, userID),
+			)
+			return nil, diags
+		}
+		tflog.Debug(ctx, "Waiting for SCIM user to appear in memberships", map[string]any{"user_id": userID, "backoff": backoff.String()})
+
+		select {
+		case <-ctx.Done():
+			diags.AddError("Error finding new membership", ctx.Err().Error())
+			return nil, diags
+		case <-runtime.After(min(backoff, remaining)):
+		}
+		backoff = min(2*backoff, scimPropagationMaxBackoff)
+	}
+}
+
+// membershipStatus returns the status Langfuse reported for the membership. Memberships listed
+// without a status belong to existing users and are therefore active.
+func membershipStatus(membership *langfuse.OrganizationMembership) string {
+	if membership.Status == "" {
+		return langfuse.MembershipStatusActive
+	}
+	return membership.Status
+}
+
+// requiresReplaceUnlessCredentialsUpdatedInPlace replaces the membership when a credential
+// attribute changes, unless update_credentials_in_place is set.
+func requiresReplaceUnlessCredentialsUpdatedInPlace() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			var inPlace types.Bool
+			resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("update_credentials_in_place"), &inPlace)...)
+			resp.RequiresReplace = !inPlace.ValueBool()
+		},
+		"Changing the organization credentials replaces the membership unless update_credentials_in_place is true.",
+		"Changing the organization credentials replaces the membership unless `update_credentials_in_place` is true.",
+	)
 }
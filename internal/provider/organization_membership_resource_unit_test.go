@@ -2,10 +2,20 @@ package provider
 
 import (
 	"context"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/golang/mock/gomock"
+
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse/mocks"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
@@ -70,14 +80,22 @@ func TestOrganizationMembershipResource_Create_InvalidRole(t *testing.T) {
 
 	// Set up plan data with invalid role
 	planValue := map[string]tftypes.Value{
-		"id":                       tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
-		"email":                    tftypes.NewValue(tftypes.String, "test@example.com"),
-		"role":                     tftypes.NewValue(tftypes.String, "INVALID_ROLE"),
-		"status":                   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
-		"user_id":                  tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
-		"username":                 tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
-		"organization_public_key":  tftypes.NewValue(tftypes.String, "test-public"),
-		"organization_private_key": tftypes.NewValue(tftypes.String, "test-private"),
+		"id":                          tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"email":                       tftypes.NewValue(tftypes.String, "test@example.com"),
+		"role":                        tftypes.NewValue(tftypes.String, "INVALID_ROLE"),
+		"status":                      tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"user_id":                     tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"username":                    tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"organization_public_key":     tftypes.NewValue(tftypes.String, "test-public"),
+		"organization_private_key":    tftypes.NewValue(tftypes.String, "test-private"),
+		"credential_ref":              tftypes.NewValue(tftypes.String, nil),
+		"wait_for_acceptance":         tftypes.NewValue(tftypes.String, nil),
+		"expires_at":                  tftypes.NewValue(tftypes.String, nil),
+		"host":                        tftypes.NewValue(tftypes.String, nil),
+		"update_credentials_in_place": tftypes.NewValue(tftypes.Bool, nil),
+		"display_name":                tftypes.NewValue(tftypes.String, nil),
+		"external_id":                 tftypes.NewValue(tftypes.String, nil),
+		"permissions":                 tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
 	}
 
 	schemaResp := resource.SchemaResponse{}
@@ -116,25 +134,41 @@ func TestOrganizationMembershipResource_Update_InvalidRole(t *testing.T) {
 
 	// Set up plan data with invalid role
 	planValue := map[string]tftypes.Value{
-		"id":                       tftypes.NewValue(tftypes.String, "membership-123"),
-		"email":                    tftypes.NewValue(tftypes.String, "test@example.com"),
-		"role":                     tftypes.NewValue(tftypes.String, "SUPER_ADMIN"),
-		"status":                   tftypes.NewValue(tftypes.String, "ACTIVE"),
-		"user_id":                  tftypes.NewValue(tftypes.String, "user-123"),
-		"username":                 tftypes.NewValue(tftypes.String, "testuser"),
-		"organization_public_key":  tftypes.NewValue(tftypes.String, "test-public"),
-		"organization_private_key": tftypes.NewValue(tftypes.String, "test-private"),
+		"id":                          tftypes.NewValue(tftypes.String, "membership-123"),
+		"email":                       tftypes.NewValue(tftypes.String, "test@example.com"),
+		"role":                        tftypes.NewValue(tftypes.String, "SUPER_ADMIN"),
+		"status":                      tftypes.NewValue(tftypes.String, "ACTIVE"),
+		"user_id":                     tftypes.NewValue(tftypes.String, "user-123"),
+		"username":                    tftypes.NewValue(tftypes.String, "testuser"),
+		"organization_public_key":     tftypes.NewValue(tftypes.String, "test-public"),
+		"organization_private_key":    tftypes.NewValue(tftypes.String, "test-private"),
+		"credential_ref":              tftypes.NewValue(tftypes.String, nil),
+		"wait_for_acceptance":         tftypes.NewValue(tftypes.String, nil),
+		"expires_at":                  tftypes.NewValue(tftypes.String, nil),
+		"host":                        tftypes.NewValue(tftypes.String, nil),
+		"update_credentials_in_place": tftypes.NewValue(tftypes.Bool, nil),
+		"display_name":                tftypes.NewValue(tftypes.String, nil),
+		"external_id":                 tftypes.NewValue(tftypes.String, nil),
+		"permissions":                 tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
 	}
 
 	stateValue := map[string]tftypes.Value{
-		"id":                       tftypes.NewValue(tftypes.String, "membership-123"),
-		"email":                    tftypes.NewValue(tftypes.String, "test@example.com"),
-		"role":                     tftypes.NewValue(tftypes.String, "MEMBER"),
-		"status":                   tftypes.NewValue(tftypes.String, "ACTIVE"),
-		"user_id":                  tftypes.NewValue(tftypes.String, "user-123"),
-		"username":                 tftypes.NewValue(tftypes.String, "testuser"),
-		"organization_public_key":  tftypes.NewValue(tftypes.String, "test-public"),
-		"organization_private_key": tftypes.NewValue(tftypes.String, "test-private"),
+		"id":                          tftypes.NewValue(tftypes.String, "membership-123"),
+		"email":                       tftypes.NewValue(tftypes.String, "test@example.com"),
+		"role":                        tftypes.NewValue(tftypes.String, "MEMBER"),
+		"status":                      tftypes.NewValue(tftypes.String, "ACTIVE"),
+		"user_id":                     tftypes.NewValue(tftypes.String, "user-123"),
+		"username":                    tftypes.NewValue(tftypes.String, "testuser"),
+		"organization_public_key":     tftypes.NewValue(tftypes.String, "test-public"),
+		"organization_private_key":    tftypes.NewValue(tftypes.String, "test-private"),
+		"credential_ref":              tftypes.NewValue(tftypes.String, nil),
+		"wait_for_acceptance":         tftypes.NewValue(tftypes.String, nil),
+		"expires_at":                  tftypes.NewValue(tftypes.String, nil),
+		"host":                        tftypes.NewValue(tftypes.String, nil),
+		"update_credentials_in_place": tftypes.NewValue(tftypes.Bool, nil),
+		"display_name":                tftypes.NewValue(tftypes.String, nil),
+		"external_id":                 tftypes.NewValue(tftypes.String, nil),
+		"permissions":                 tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
 	}
 
 	schemaResp := resource.SchemaResponse{}
@@ -163,3 +197,511 @@ func TestOrganizationMembershipResource_Update_InvalidRole(t *testing.T) {
 		t.Fatalf("unexpected error summary. got %q, want %q", errorSummary, "Invalid Role")
 	}
 }
+
+func TestOrganizationMembershipResourceWaitForAcceptance(t *testing.T) {
+	membershipPollInterval = time.Millisecond
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	r := NewOrganizationMembershipResource().(*organizationMembershipResource)
+	organizationClient := mocks.NewMockOrganizationClient(ctrl)
+
+	gomock.InOrder(
+		organizationClient.EXPECT().GetMembership(ctx, "user-123").Return(&langfuse.OrganizationMembership{UserID: "user-123", Status: langfuse.MembershipStatusPendingInvite}, nil),
+		organizationClient.EXPECT().GetMembership(ctx, "user-123").Return(&langfuse.OrganizationMembership{UserID: "user-123", Status: langfuse.MembershipStatusActive}, nil),
+	)
+
+	model := organizationMembershipResourceModel{
+		ID:                types.StringValue("user-123"),
+		Email:             types.StringValue("test@example.com"),
+		Status:            types.StringValue(langfuse.MembershipStatusPendingInvite),
+		WaitForAcceptance: types.StringValue("1m"),
+	}
+	if diags := r.waitForAcceptance(ctx, organizationClient, &model); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if model.Status.ValueString() != langfuse.MembershipStatusActive {
+		t.Errorf("unexpected status %q", model.Status.ValueString())
+	}
+
+	organizationClient.EXPECT().GetMembership(ctx, "user-123").Return(&langfuse.OrganizationMembership{UserID: "user-123", Status: langfuse.MembershipStatusPendingInvite}, nil).AnyTimes()
+	model.Status = types.StringValue(langfuse.MembershipStatusPendingInvite)
+	model.WaitForAcceptance = types.StringValue("20ms")
+	diags := r.waitForAcceptance(ctx, organizationClient, &model)
+	if !diags.HasError() || diags.Errors()[0].Summary() != "Membership not accepted in time" {
+		t.Fatalf("expected a timeout error, got: %v", diags)
+	}
+}
+
+func TestOrganizationMembershipResourceModifyPlanCredentialChange(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := NewOrganizationMembershipResource().(*organizationMembershipResource)
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	membershipValue := func(publicKey string, inPlace bool) tftypes.Value {
+		return tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"id":                          tftypes.NewValue(tftypes.String, "membership-123"),
+			"email":                       tftypes.NewValue(tftypes.String, "test@example.com"),
+			"role":                        tftypes.NewValue(tftypes.String, "MEMBER"),
+			"status":                      tftypes.NewValue(tftypes.String, "ACTIVE"),
+			"user_id":                     tftypes.NewValue(tftypes.String, "user-123"),
+			"username":                    tftypes.NewValue(tftypes.String, "testuser"),
+			"organization_public_key":     tftypes.NewValue(tftypes.String, publicKey),
+			"organization_private_key":    tftypes.NewValue(tftypes.String, "test-private"),
+			"credential_ref":              tftypes.NewValue(tftypes.String, nil),
+			"wait_for_acceptance":         tftypes.NewValue(tftypes.String, nil),
+			"expires_at":                  tftypes.NewValue(tftypes.String, nil),
+			"host":                        tftypes.NewValue(tftypes.String, nil),
+			"update_credentials_in_place": tftypes.NewValue(tftypes.Bool, inPlace),
+			"display_name":                tftypes.NewValue(tftypes.String, nil),
+			"external_id":                 tftypes.NewValue(tftypes.String, nil),
+			"permissions":                 tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+		})
+	}
+
+	testCases := []struct {
+		name          string
+		planPublicKey string
+		inPlace       bool
+		expectWarning bool
+	}{
+		{name: "unchanged credentials", planPublicKey: "pk-old"},
+		{name: "rotated credentials", planPublicKey: "pk-new", expectWarning: true},
+		{name: "rotated credentials updated in place", planPublicKey: "pk-new", inPlace: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			state := tfsdk.State{Schema: schemaResp.Schema, Raw: membershipValue("pk-old", tc.inPlace)}
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: membershipValue(tc.planPublicKey, tc.inPlace)}
+
+			resp := resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{State: state, Plan: plan}, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			if got := resp.Diagnostics.WarningsCount() > 0; got != tc.expectWarning {
+				t.Fatalf("unexpected warning state: got %t, want %t: %v", got, tc.expectWarning, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestOrganizationMembershipResourceExpiry(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	var schemaResp resource.SchemaResponse
+	NewOrganizationMembershipResource().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	membershipValue := func(status, expiresAt string) tftypes.Value {
+		expiry := tftypes.NewValue(tftypes.String, nil)
+		if expiresAt != "" {
+			expiry = tftypes.NewValue(tftypes.String, expiresAt)
+		}
+		return tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"id":                          tftypes.NewValue(tftypes.String, "membership-123"),
+			"email":                       tftypes.NewValue(tftypes.String, "contractor@example.com"),
+			"role":                        tftypes.NewValue(tftypes.String, "MEMBER"),
+			"status":                      tftypes.NewValue(tftypes.String, status),
+			"user_id":                     tftypes.NewValue(tftypes.String, "user-123"),
+			"username":                    tftypes.NewValue(tftypes.String, "contractor"),
+			"organization_public_key":     tftypes.NewValue(tftypes.String, "pk-org"),
+			"organization_private_key":    tftypes.NewValue(tftypes.String, "sk-org"),
+			"credential_ref":              tftypes.NewValue(tftypes.String, nil),
+			"wait_for_acceptance":         tftypes.NewValue(tftypes.String, nil),
+			"expires_at":                  expiry,
+			"host":                        tftypes.NewValue(tftypes.String, nil),
+			"update_credentials_in_place": tftypes.NewValue(tftypes.Bool, nil),
+			"display_name":                tftypes.NewValue(tftypes.String, nil),
+			"external_id":                 tftypes.NewValue(tftypes.String, nil),
+			"permissions":                 tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+		})
+	}
+
+	testCases := []struct {
+		name           string
+		stateStatus    string
+		expiresAt      string
+		create         bool
+		expectStatus   string
+		expectWarning  bool
+		expectError    bool
+		expectReplaced bool
+	}{
+		{name: "not yet expired", stateStatus: "ACTIVE", expiresAt: "2026-07-01T00:00:00Z", expectStatus: "ACTIVE"},
+		{name: "expired", stateStatus: "ACTIVE", expiresAt: "2026-06-01T00:00:00Z", expectStatus: membershipStatusExpired, expectWarning: true},
+		{name: "already removed", stateStatus: membershipStatusExpired, expiresAt: "2026-06-01T00:00:00Z", expectStatus: membershipStatusExpired},
+		{name: "expiry lifted", stateStatus: membershipStatusExpired, expiresAt: "2026-07-01T00:00:00Z", expectStatus: membershipStatusExpired, expectReplaced: true},
+		{name: "created expired", create: true, expiresAt: "2026-06-01T00:00:00Z", expectError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			r := NewOrganizationMembershipResource().(*organizationMembershipResource)
+			clientFactory := mocks.NewMockClientFactory(ctrl)
+			clientFactory.Deps = langfuse.Runtime{Now: func() time.Time { return now }}
+			r.ClientFactory = clientFactory
+
+			state := tfsdk.State{Schema: schemaResp.Schema, Raw: membershipValue(tc.stateStatus, tc.expiresAt)}
+			if tc.create {
+				state.Raw = tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+			}
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: membershipValue(tc.stateStatus, tc.expiresAt)}
+
+			resp := resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{State: state, Plan: plan}, &resp)
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Fatalf("expected error %t, got: %v", tc.expectError, resp.Diagnostics)
+			}
+			if tc.expectError {
+				return
+			}
+			if got := resp.Diagnostics.WarningsCount() > 0; got != tc.expectWarning {
+				t.Errorf("expected warning %t, got: %v", tc.expectWarning, resp.Diagnostics)
+			}
+			if got := len(resp.RequiresReplace) > 0; got != tc.expectReplaced {
+				t.Errorf("expected replacement %t, got: %v", tc.expectReplaced, resp.RequiresReplace)
+			}
+			var status types.String
+			resp.Plan.GetAttribute(ctx, path.Root("status"), &status)
+			if status.ValueString() != tc.expectStatus {
+				t.Errorf("expected planned status %q, got %s", tc.expectStatus, status)
+			}
+		})
+	}
+
+	t.Run("apply removes the member", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		r := NewOrganizationMembershipResource().(*organizationMembershipResource)
+		clientFactory := mocks.NewMockClientFactory(ctrl)
+		r.ClientFactory = clientFactory
+		clientFactory.OrganizationClient.EXPECT().RemoveMember(ctx, "user-123").Return(nil)
+
+		state := tfsdk.State{Schema: schemaResp.Schema, Raw: membershipValue("ACTIVE", "2026-06-01T00:00:00Z")}
+		plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: membershipValue(membershipStatusExpired, "2026-06-01T00:00:00Z")}
+		resp := resource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+		r.Update(ctx, resource.UpdateRequest{State: state, Plan: plan}, &resp)
+		if resp.Diagnostics.HasError() || resp.Diagnostics.WarningsCount() != 1 {
+			t.Fatalf("expected a single warning, got: %v", resp.Diagnostics)
+		}
+
+		// Refreshing and destroying an expired membership leave Langfuse alone.
+		readResp := resource.ReadResponse{State: resp.State}
+		r.Read(ctx, resource.ReadRequest{State: resp.State}, &readResp)
+		if readResp.Diagnostics.HasError() || readResp.State.Raw.IsNull() {
+			t.Fatalf("expected the expired membership to stay in state, got: %v", readResp.Diagnostics)
+		}
+		r.Delete(ctx, resource.DeleteRequest{State: resp.State}, &resource.DeleteResponse{State: resp.State})
+	})
+}
+
+func TestOrganizationMembershipResourceCreateSCIMAttributes(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	r := NewOrganizationMembershipResource().(*organizationMembershipResource)
+	clientFactory := mocks.NewMockClientFactory(ctrl)
+	r.Configure(ctx, resource.ConfigureRequest{ProviderData: clientFactory}, &resource.ConfigureResponse{})
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	newMembership := langfuse.OrganizationMembership{ID: "mem-1", UserID: "user-1", Email: "jane@example.com", Role: "NONE"}
+	gomock.InOrder(
+		clientFactory.OrganizationClient.EXPECT().QueueMembershipUpdate(ctx, langfuse.UpdateMembershipRequest{Email: "jane@example.com", Role: "MEMBER"}).
+			Return(nil, langfuse.ErrNotFound),
+		clientFactory.OrganizationClient.EXPECT().CreateSCIMUser(ctx, gomock.Any()).DoAndReturn(
+			func(ctx context.Context, request *langfuse.SCIMUserRequest) (*langfuse.SCIMUserResponse, error) {
+				if request.DisplayName != "Jane Doe" || request.ExternalID != "okta-00u1" {
+					t.Errorf("unexpected SCIM attributes: displayName=%q externalId=%q", request.DisplayName, request.ExternalID)
+				}
+				return &langfuse.SCIMUserResponse{ID: "user-1", UserName: "jane@example.com", DisplayName: request.DisplayName, ExternalID: request.ExternalID}, nil
+			}),
+		clientFactory.OrganizationClient.EXPECT().ListMemberships(ctx).Return([]langfuse.OrganizationMembership{newMembership}, nil),
+		clientFactory.OrganizationClient.EXPECT().UpdateMembership(ctx, "mem-1", &langfuse.UpdateMembershipRequest{UserID: "user-1", Role: "MEMBER"}).
+			Return(&langfuse.OrganizationMembership{ID: "mem-1", UserID: "user-1", Email: "jane@example.com", Role: "MEMBER", Status: langfuse.MembershipStatusActive}, nil),
+	)
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{
+		"id":                          tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"email":                       tftypes.NewValue(tftypes.String, "jane@example.com"),
+		"role":                        tftypes.NewValue(tftypes.String, "MEMBER"),
+		"status":                      tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"user_id":                     tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"username":                    tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"organization_public_key":     tftypes.NewValue(tftypes.String, "test-public"),
+		"organization_private_key":    tftypes.NewValue(tftypes.String, "test-private"),
+		"credential_ref":              tftypes.NewValue(tftypes.String, nil),
+		"wait_for_acceptance":         tftypes.NewValue(tftypes.String, nil),
+		"expires_at":                  tftypes.NewValue(tftypes.String, nil),
+		"host":                        tftypes.NewValue(tftypes.String, nil),
+		"update_credentials_in_place": tftypes.NewValue(tftypes.Bool, nil),
+		"display_name":                tftypes.NewValue(tftypes.String, "Jane Doe"),
+		"external_id":                 tftypes.NewValue(tftypes.String, "okta-00u1"),
+		"permissions":                 tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
+	})}
+
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics from Create: %v", resp.Diagnostics)
+	}
+
+	var state organizationMembershipResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if state.DisplayName.ValueString() != "Jane Doe" || state.ExternalID.ValueString() != "okta-00u1" {
+		t.Errorf("unexpected SCIM attributes in state: %s, %s", state.DisplayName, state.ExternalID)
+	}
+}
+
+func TestOrganizationMembershipResourceWaitForSCIMMembership(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	start := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	setup := func(t *testing.T) (*organizationMembershipResource, *mocks.MockOrganizationClient, *[]time.Duration) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+
+		now := start
+		var waits []time.Duration
+		clientFactory := mocks.NewMockClientFactory(ctrl)
+		clientFactory.Deps = langfuse.Runtime{
+			Now: func() time.Time { return now },
+			After: func(d time.Duration) <-chan time.Time {
+				waits = append(waits, d)
+				now = now.Add(d)
+				ch := make(chan time.Time, 1)
+				ch <- now
+				return ch
+			},
+		}
+		r := NewOrganizationMembershipResource().(*organizationMembershipResource)
+		r.Configure(ctx, resource.ConfigureRequest{ProviderData: clientFactory}, &resource.ConfigureResponse{})
+		return r, clientFactory.OrganizationClient, &waits
+	}
+
+	t.Run("backs off until the user appears", func(t *testing.T) {
+		r, organizationClient, waits := setup(t)
+		newMembership := langfuse.OrganizationMembership{ID: "mem-1", UserID: "user-1", Email: "jane@example.com"}
+		gomock.InOrder(
+			organizationClient.EXPECT().ListMemberships(ctx).Return(nil, nil),
+			organizationClient.EXPECT().ListMemberships(ctx).Return([]langfuse.OrganizationMembership{{ID: "mem-0", UserID: "user-0"}}, nil),
+			organizationClient.EXPECT().ListMemberships(ctx).Return([]langfuse.OrganizationMembership{newMembership}, nil),
+		)
+
+		membership, diags := r.waitForSCIMMembership(ctx, organizationClient, "user-1")
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if membership.ID != "mem-1" {
+			t.Errorf("unexpected membership %+v", membership)
+		}
+		if expected := []time.Duration{500 * time.Millisecond, time.Second}; !slices.Equal(*waits, expected) {
+			t.Errorf("expected waits %v, got %v", expected, *waits)
+		}
+	})
+
+	t.Run("gives up after the timeout", func(t *testing.T) {
+		r, organizationClient, waits := setup(t)
+		organizationClient.EXPECT().ListMemberships(ctx).Return(nil, nil).AnyTimes()
+
+		_, diags := r.waitForSCIMMembership(ctx, organizationClient, "user-1")
+		if !diags.HasError() || diags.Errors()[0].Summary() != "Error finding new membership" {
+			t.Fatalf("expected a timeout error, got: %v", diags)
+		}
+		var waited time.Duration
+		for _, wait := range *waits {
+			waited += wait
+			if wait > scimPropagationMaxBackoff {
+				t.Errorf("expected waits of at most %s, got %v", scimPropagationMaxBackoff, *waits)
+			}
+		}
+		if waited != scimPropagationTimeout {
+			t.Errorf("expected to wait %s in total, got %s", scimPropagationTimeout, waited)
+		}
+	})
+}
+
+func TestOrganizationMembershipResourcePermissions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := NewOrganizationMembershipResource().(*organizationMembershipResource)
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	membershipValue := func(role string) tftypes.Value {
+		values := map[string]tftypes.Value{
+			"id":    tftypes.NewValue(tftypes.String, "user-123"),
+			"email": tftypes.NewValue(tftypes.String, "test@example.com"),
+			"role":  tftypes.NewValue(tftypes.String, role),
+		}
+		for name, attributeType := range objectType.AttributeTypes {
+			if _, ok := values[name]; !ok {
+				values[name] = tftypes.NewValue(attributeType, nil)
+			}
+		}
+		return tftypes.NewValue(objectType, values)
+	}
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: membershipValue("ADMIN")}
+	resp := resource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{
+		Plan:  plan,
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: membershipValue("VIEWER")},
+	}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics from ModifyPlan: %v", resp.Diagnostics)
+	}
+
+	var permissions []string
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("permissions"), &permissions)...)
+	if len(permissions) != len(membershipRolePermissions["ADMIN"]) || !slices.Contains(permissions, "organizationMembers:CUD") {
+		t.Errorf("unexpected planned permissions: %v", permissions)
+	}
+
+	if unknown := membershipPermissions(types.StringUnknown()); !unknown.IsUnknown() {
+		t.Errorf("expected unknown permissions for an unknown role, got %s", unknown)
+	}
+}
+
+func TestMembershipRolePermissionsDistinct(t *testing.T) {
+	t.Parallel()
+
+	seen := map[string]string{}
+	for role, permissions := range membershipRolePermissions {
+		sorted := slices.Sorted(slices.Values(permissions))
+		if len(slices.Compact(slices.Clone(sorted))) != len(sorted) {
+			t.Errorf("%s grants duplicate permissions: %v", role, sorted)
+		}
+		key := strings.Join(sorted, ",")
+		if other, ok := seen[key]; ok {
+			t.Errorf("%s and %s grant the same permissions, so a change between them shows no delta", role, other)
+		}
+		seen[key] = role
+	}
+
+	member, viewer := membershipRolePermissions["MEMBER"], membershipRolePermissions["VIEWER"]
+	if !slices.Contains(member, "prompts:CUD") || slices.Contains(viewer, "prompts:CUD") || !slices.Contains(viewer, "prompts:read") {
+		t.Errorf("expected MEMBER to write and VIEWER to only read project data, got MEMBER %v and VIEWER %v", member, viewer)
+	}
+}
+
+func TestOrganizationMembershipResourceImport(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := []struct {
+		name               string
+		importID           string
+		defaultCredentials bool
+		expectLookup       bool
+		expectError        bool
+		expectPublicKey    string
+		expectCredential   string
+		scimUser           *langfuse.SCIMUserResponse
+	}{
+		{name: "key pair", importID: "user-123,pk-lf-1,sk-lf-1", expectLookup: true, expectPublicKey: "pk-lf-1"},
+		{name: "credential reference", importID: "user-123,acme", expectLookup: true, expectCredential: "acme"},
+		{name: "default credentials", importID: "user-123", defaultCredentials: true, expectLookup: true},
+		{
+			name: "provisioned through SCIM", importID: "user-123,acme", expectLookup: true, expectCredential: "acme",
+			scimUser: &langfuse.SCIMUserResponse{ID: "user-123", DisplayName: "Jane Doe", ExternalID: "00u123"},
+		},
+		{name: "no credentials", importID: "user-123", expectError: true},
+		{name: "unknown credential reference", importID: "user-123,other", expectError: true},
+		{name: "too many parts", importID: "user-123,pk-lf-1,sk-lf-1,extra", expectError: true},
+		{name: "empty ID", importID: ",acme", expectError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			clientFactory := mocks.NewMockClientFactory(ctrl)
+			clientFactory.NoAdminAPIKey = true
+			clientFactory.Credentials["acme"] = langfuse.OrganizationCredentials{PublicKey: "pk-lf-acme", PrivateKey: "sk-lf-acme"}
+			if tc.defaultCredentials {
+				clientFactory.DefaultCredentials = &langfuse.OrganizationCredentials{PublicKey: "pk-lf-env", PrivateKey: "sk-lf-env"}
+			}
+			if tc.expectLookup {
+				clientFactory.OrganizationClient.EXPECT().GetMembership(ctx, "user-123").Return(&langfuse.OrganizationMembership{
+					UserID:   "user-123",
+					Email:    "jane@example.com",
+					Role:     "ADMIN",
+					Username: "jane",
+				}, nil)
+				if tc.scimUser != nil {
+					clientFactory.OrganizationClient.EXPECT().GetSCIMUserByEmail(ctx, "jane@example.com").Return(tc.scimUser, nil)
+				} else {
+					clientFactory.OrganizationClient.EXPECT().GetSCIMUserByEmail(ctx, "jane@example.com").Return(nil, langfuse.ErrNotFound)
+				}
+			}
+
+			r := NewOrganizationMembershipResource().(*organizationMembershipResource)
+			var configureResp resource.ConfigureResponse
+			r.Configure(ctx, resource.ConfigureRequest{ProviderData: clientFactory}, &configureResp)
+
+			var schemaResp resource.SchemaResponse
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+			importResp := resource.ImportStateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+			importResp.State.Raw = tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+			r.ImportState(ctx, resource.ImportStateRequest{ID: tc.importID}, &importResp)
+
+			if tc.expectError {
+				if !importResp.Diagnostics.HasError() {
+					t.Fatal("expected an error from ImportState")
+				}
+				return
+			}
+			if importResp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics from ImportState: %v", importResp.Diagnostics)
+			}
+
+			var state organizationMembershipResourceModel
+			importResp.Diagnostics.Append(importResp.State.Get(ctx, &state)...)
+			if state.ID.ValueString() != "user-123" || state.Email.ValueString() != "jane@example.com" || state.Role.ValueString() != "ADMIN" ||
+				state.Status.ValueString() != langfuse.MembershipStatusActive || state.Username.ValueString() != "jane" {
+				t.Errorf("unexpected imported membership: %+v", state)
+			}
+			if state.OrganizationPublicKey.ValueString() != tc.expectPublicKey || state.CredentialRef.ValueString() != tc.expectCredential {
+				t.Errorf("unexpected imported credentials: public key %s, credential_ref %s", state.OrganizationPublicKey, state.CredentialRef)
+			}
+			if tc.scimUser != nil && (state.DisplayName.ValueString() != tc.scimUser.DisplayName || state.ExternalID.ValueString() != tc.scimUser.ExternalID) {
+				t.Errorf("unexpected imported SCIM attributes: display_name %s, external_id %s", state.DisplayName, state.ExternalID)
+			}
+			if tc.scimUser == nil && (!state.DisplayName.IsNull() || !state.ExternalID.IsNull()) {
+				t.Errorf("expected null SCIM attributes, got display_name %s, external_id %s", state.DisplayName, state.ExternalID)
+			}
+			if len(state.Permissions.Elements()) != len(membershipRolePermissions["ADMIN"]) {
+				t.Errorf("unexpected imported permissions: %s", state.Permissions)
+			}
+		})
+	}
+}
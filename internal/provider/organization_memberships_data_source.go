@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+)
+
+var _ datasource.DataSource = &organizationMembershipsDataSource{}
+
+func NewOrganizationMembershipsDataSource() datasource.DataSource {
+	return &organizationMembershipsDataSource{}
+}
+
+// organizationMembershipRoles are the roles a membership can have.
+var organizationMembershipRoles = []string{"OWNER", "ADMIN", "MEMBER", "VIEWER"}
+
+type organizationMembershipsDataSourceModel struct {
+	Roles                  types.Set                           `tfsdk:"roles"`
+	OrganizationPublicKey  types.String                        `tfsdk:"organization_public_key"`
+	OrganizationPrivateKey types.String                        `tfsdk:"organization_private_key"`
+	CredentialRef          types.String                        `tfsdk:"credential_ref"`
+	Memberships            []organizationMembershipsEntryModel `tfsdk:"memberships"`
+	UserIDs                types.Map                           `tfsdk:"user_ids"`
+}
+
+type organizationMembershipsEntryModel struct {
+	UserID   types.String `tfsdk:"user_id"`
+	Email    types.String `tfsdk:"email"`
+	Username types.String `tfsdk:"username"`
+	Role     types.String `tfsdk:"role"`
+	Status   types.String `tfsdk:"status"`
+}
+
+type organizationMembershipsDataSource struct {
+	ClientFactory langfuse.ClientFactory
+}
+
+func (d *organizationMembershipsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clientFactory, ok := req.ProviderData.(langfuse.ClientFactory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected langfuse.ClientFactory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.ClientFactory = clientFactory
+}
+
+func (d *organizationMembershipsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_memberships"
+}
+
+func (d *organizationMembershipsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the memberships of an organization, optionally filtered by role. The listing follows pagination and is " +
+			"ordered by email and user ID, so `for_each` over the result does not change between refreshes.",
+		Attributes: map[string]schema.Attribute{
+			"roles": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Only list memberships with one of these roles. Valid values are: " + strings.Join(organizationMembershipRoles, ", ") + ".",
+			},
+			"organization_public_key": schema.StringAttribute{
+				Optional:    true,
+				Description: "Organization public key to authenticate the call. Conflicts with `credential_ref`. Defaults to LANGFUSE_ORG_PUBLIC_KEY.",
+			},
+			"organization_private_key": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Organization private key to authenticate the call. Conflicts with `credential_ref`. Defaults to LANGFUSE_ORG_SECRET_KEY.",
+			},
+			"credential_ref": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of organization credentials declared in the provider `credentials` block, used instead of `organization_public_key`/`organization_private_key`.",
+			},
+			"memberships": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The memberships of the organization, ordered by email and user ID.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"user_id":  schema.StringAttribute{Computed: true},
+						"email":    schema.StringAttribute{Computed: true},
+						"username": schema.StringAttribute{Computed: true},
+						"role":     schema.StringAttribute{Computed: true},
+						"status":   schema.StringAttribute{Computed: true, Description: "`ACTIVE`, or `PENDING_INVITE` for invited users without an account."},
+					},
+				},
+			},
+			"user_ids": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Map of email to user ID, for the `for_each` of resources keyed by member.",
+			},
+		},
+	}
+}
+
+func (d *organizationMembershipsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer addRunWarnings(&resp.Diagnostics, d.ClientFactory)
+
+	resp.Diagnostics.Append(checkInstanceVersion(ctx, d.ClientFactory, "langfuse_organization_memberships")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data organizationMembershipsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withRequestAttributes(ctx, d.ClientFactory, types.StringNull(), types.StringNull())
+
+	query := &langfuse.MembershipQuery{}
+	for role := range setElements(data.Roles) {
+		if !slices.Contains(organizationMembershipRoles, role) {
+			resp.Diagnostics.AddError(
+				"Invalid Role",
+				fmt.Sprintf("Role must be one of: %s. Got: %s", strings.Join(organizationMembershipRoles, ", "), role),
+			)
+			return
+		}
+		query.Roles = append(query.Roles, role)
+	}
+	sort.Strings(query.Roles)
+
+	resp.Diagnostics.Append(validateOrganizationCredentials(data.OrganizationPublicKey, data.OrganizationPrivateKey, data.CredentialRef)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationClient, diags := newOrganizationClient(d.ClientFactory, data.OrganizationPublicKey, data.OrganizationPrivateKey, data.CredentialRef)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	memberships, err := organizationClient.QueryMemberships(ctx, query)
+	if err != nil {
+		addOrganizationClientError(ctx, &resp.Diagnostics, d.ClientFactory, "Error listing organization memberships", data.OrganizationPublicKey, "", err)
+		return
+	}
+
+	userIDs := make(map[string]string, len(memberships))
+	data.Memberships = []organizationMembershipsEntryModel{}
+	for _, membership := range memberships {
+		data.Memberships = append(data.Memberships, organizationMembershipsEntryModel{
+			UserID:   stringValueOrNull(membership.UserID),
+			Email:    types.StringValue(membership.Email),
+			Username: stringValueOrNull(membership.Username),
+			Role:     types.StringValue(membership.Role),
+			Status:   stringValueOrNull(membership.Status),
+		})
+		if membership.Email != "" && membership.UserID != "" {
+			userIDs[membership.Email] = membership.UserID
+		}
+	}
+
+	userIDMap, diags := types.MapValueFrom(ctx, types.StringType, userIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.UserIDs = userIDMap
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse/mocks"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestOrganizationMembershipsDataSourceRead(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	d := NewOrganizationMembershipsDataSource().(*organizationMembershipsDataSource)
+	clientFactory := mocks.NewMockClientFactory(ctrl)
+	d.Configure(ctx, datasource.ConfigureRequest{ProviderData: clientFactory}, &datasource.ConfigureResponse{})
+
+	clientFactory.OrganizationClient.EXPECT().QueryMemberships(gomock.Any(), &langfuse.MembershipQuery{Roles: []string{"ADMIN", "OWNER"}}).Return([]langfuse.OrganizationMembership{
+		{UserID: "user-2", Email: "alice@example.com", Role: "ADMIN", Status: langfuse.MembershipStatusActive},
+		{Email: "bob@example.com", Role: "OWNER", Status: langfuse.MembershipStatusPendingInvite},
+	}, nil)
+
+	roles := tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+		tftypes.NewValue(tftypes.String, "OWNER"),
+		tftypes.NewValue(tftypes.String, "ADMIN"),
+	})
+	schemaResp, config := auditLogsConfig(ctx, t, d, map[string]tftypes.Value{"roles": roles})
+	readResp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics from Read: %v", readResp.Diagnostics)
+	}
+
+	var data organizationMembershipsDataSourceModel
+	readResp.Diagnostics.Append(readResp.State.Get(ctx, &data)...)
+	if len(data.Memberships) != 2 || data.Memberships[0].UserID.ValueString() != "user-2" || !data.Memberships[1].UserID.IsNull() {
+		t.Fatalf("unexpected memberships: %+v", data.Memberships)
+	}
+	if userIDs := data.UserIDs.Elements(); len(userIDs) != 1 || userIDs["alice@example.com"].String() != `"user-2"` {
+		t.Errorf("unexpected user IDs: %v", data.UserIDs)
+	}
+}
+
+func TestOrganizationMembershipsDataSourceInvalidRole(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	d := NewOrganizationMembershipsDataSource().(*organizationMembershipsDataSource)
+	d.Configure(ctx, datasource.ConfigureRequest{ProviderData: mocks.NewMockClientFactory(ctrl)}, &datasource.ConfigureResponse{})
+
+	roles := tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "owner")})
+	schemaResp, config := auditLogsConfig(ctx, t, d, map[string]tftypes.Value{"roles": roles})
+	readResp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, &readResp)
+	if !readResp.Diagnostics.HasError() || readResp.Diagnostics.Errors()[0].Summary() != "Invalid Role" {
+		t.Fatalf("expected an invalid role error, got: %v", readResp.Diagnostics)
+	}
+}
@@ -2,31 +2,80 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
 )
 
 var _ resource.Resource = &organizationResource{}
 var _ resource.ResourceWithImportState = &organizationResource{}
+var _ resource.ResourceWithModifyPlan = &organizationResource{}
+
+// organizationRequestFields maps the fields of organization requests to the attributes they are set from.
+var organizationRequestFields = map[string]path.Path{
+	"name":     path.Root("name"),
+	"metadata": path.Root("metadata"),
+}
 
 func NewOrganizationResource() resource.Resource {
 	return &organizationResource{}
 }
 
 type organizationResourceModel struct {
-	ID       types.String `tfsdk:"id"`
-	Name     types.String `tfsdk:"name"`
-	Metadata types.Map    `tfsdk:"metadata"`
+	ID                       types.String `tfsdk:"id"`
+	Name                     types.String `tfsdk:"name"`
+	Metadata                 types.Map    `tfsdk:"metadata"`
+	Plan                     types.String `tfsdk:"plan"`
+	MonthlyObservationLimit  types.Int64  `tfsdk:"monthly_observation_limit"`
+	ManagedMetadataOnly      types.Bool   `tfsdk:"managed_metadata_only"`
+	ReuseExisting            types.Bool   `tfsdk:"reuse_existing"`
+	DestroyReport            types.Bool   `tfsdk:"destroy_report"`
+	ConfirmDestroy           types.Bool   `tfsdk:"confirm_destroy"`
+	DestroyThresholdProjects types.Int64  `tfsdk:"destroy_threshold_projects"`
+	DestroyThresholdAPIKeys  types.Int64  `tfsdk:"destroy_threshold_api_keys"`
+	DestroyThresholdMembers  types.Int64  `tfsdk:"destroy_threshold_members"`
+	CredentialRef            types.String `tfsdk:"credential_ref"`
+}
+
+// copyDestroySettings copies the settings that guard the deletion of the organization, which
+// only live in state.
+func (m *organizationResourceModel) copyDestroySettings(from organizationResourceModel) {
+	m.DestroyReport = from.DestroyReport
+	m.ConfirmDestroy = from.ConfirmDestroy
+	m.DestroyThresholdProjects = from.DestroyThresholdProjects
+	m.DestroyThresholdAPIKeys = from.DestroyThresholdAPIKeys
+	m.DestroyThresholdMembers = from.DestroyThresholdMembers
+	m.CredentialRef = from.CredentialRef
+}
+
+// setCloudConfig copies the plan and limits of the organization; they stay null when the
+// instance does not report them.
+func (m *organizationResourceModel) setCloudConfig(org *langfuse.Organization) {
+	m.Plan = types.StringNull()
+	m.MonthlyObservationLimit = types.Int64Null()
+	if org.CloudConfig == nil {
+		return
+	}
+	if org.CloudConfig.Plan != "" {
+		m.Plan = types.StringValue(org.CloudConfig.Plan)
+	}
+	if org.CloudConfig.MonthlyObservationLimit != nil {
+		m.MonthlyObservationLimit = types.Int64Value(*org.CloudConfig.MonthlyObservationLimit)
+	}
 }
 
 type organizationResource struct {
-	AdminClient langfuse.AdminClient
+	AdminClient   langfuse.AdminClient
+	ClientFactory langfuse.ClientFactory
 }
 
 func (r *organizationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -34,7 +83,9 @@ func (r *organizationResource) Configure(ctx context.Context, req resource.Confi
 		return
 	}
 
-	r.AdminClient = req.ProviderData.(langfuse.ClientFactory).NewAdminClient()
+	r.ClientFactory = req.ProviderData.(langfuse.ClientFactory)
+	resp.Diagnostics.Append(checkAdminAPIKeyConfigured(r.ClientFactory, "langfuse_organization")...)
+	r.AdminClient = r.ClientFactory.NewAdminClient()
 }
 
 func (r *organizationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -56,11 +107,73 @@ func (r *organizationResource) Schema(ctx context.Context, req resource.SchemaRe
 				ElementType: types.StringType,
 				Description: "Metadata for the organization as key-value pairs.",
 			},
+			"managed_metadata_only": schema.BoolAttribute{
+				Optional: true,
+				Description: "Only manage the `metadata` keys declared in the configuration. Keys added by Langfuse or other tools are " +
+					"neither shown as drift nor removed on update. Defaults to `false`, which replaces the whole metadata map.",
+			},
+			"reuse_existing": schema.BoolAttribute{
+				Optional: true,
+				Description: "Adopt an existing organization with the same name on create instead of creating another one, e.g. when " +
+					"a previous apply timed out after Langfuse had already created the organization. Defaults to `false`.",
+			},
+			"destroy_report": schema.BoolAttribute{
+				Optional: true,
+				Description: "Warn in destroy plans how many projects, API keys and memberships are deleted along with the organization. " +
+					"They are counted with `credential_ref` or the provider organization key pair when it belongs to the organization; " +
+					"without one the report is skipped. Defaults to `false`.",
+			},
+			"confirm_destroy": schema.BoolAttribute{
+				Optional: true,
+				Description: "Allow deleting the organization although it exceeds a destroy threshold. Destroy plans read it from state, " +
+					"so it must be applied before the organization is destroyed. Defaults to `false`.",
+			},
+			"destroy_threshold_projects": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Require `confirm_destroy` to delete the organization when it has more projects than this.",
+			},
+			"destroy_threshold_api_keys": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Require `confirm_destroy` to delete the organization when it has more project and organization API keys together than this.",
+			},
+			"destroy_threshold_members": schema.Int64Attribute{
+				Optional:    true,
+				Description: "Require `confirm_destroy` to delete the organization when it has more memberships than this.",
+			},
+			"credential_ref": schema.StringAttribute{
+				Optional: true,
+				Description: "Name of organization credentials of this organization declared in the provider `credentials` block, used to " +
+					"count its objects for `destroy_report` and the destroy thresholds. Without credentials of the organization, destroy " +
+					"plans skip the count and deleting a guarded organization creates and deletes a temporary organization API key to count them.",
+			},
+			"plan": schema.StringAttribute{
+				Computed: true,
+				Description: "The plan of the organization (e.g. `Hobby`, `Core`, `Pro`, `Team`, `Enterprise`). " +
+					"Null when the instance does not report plans, as is usual for self-hosted instances.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"monthly_observation_limit": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The monthly observation limit of the organization's plan. Null when the instance does not report it or no limit applies.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 	}
 }
 
 func (r *organizationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer addRunWarnings(&resp.Diagnostics, r.ClientFactory)
+
+	resp.Diagnostics.Append(checkAdminAPIAvailable(r.ClientFactory, "langfuse_organization")...)
+	resp.Diagnostics.Append(checkInstanceVersion(ctx, r.ClientFactory, "langfuse_organization")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data organizationResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
@@ -76,19 +189,57 @@ func (r *organizationResource) Create(ctx context.Context, req resource.CreateRe
 		}
 	}
 
-	org, err := r.AdminClient.CreateOrganization(ctx, &langfuse.CreateOrganizationRequest{
-		Name:     data.Name.ValueString(),
-		Metadata: metadata,
-	})
-	if err != nil {
-		resp.Diagnostics.AddError("Error creating organization", err.Error())
-		return
+	var existing *langfuse.Organization
+	if data.ReuseExisting.ValueBool() {
+		var diags diag.Diagnostics
+		existing, diags = r.findOrganizationByName(ctx, data.Name.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var org *langfuse.Organization
+	var err error
+	if existing != nil {
+		// Converge the adopted organization on the configuration, as an update would.
+		requestMetadata := stampManagedByMetadata(r.ClientFactory, metadata)
+		if data.ManagedMetadataOnly.ValueBool() {
+			requestMetadata = mergeManagedMetadata(existing.Metadata, map[string]string{}, requestMetadata)
+		}
+		org, err = r.AdminClient.UpdateOrganization(ctx, existing.ID, &langfuse.UpdateOrganizationRequest{
+			Name:     data.Name.ValueString(),
+			Metadata: requestMetadata,
+		})
+		if err != nil {
+			addClientFieldErrors(&resp.Diagnostics, "Error updating existing organization", err, organizationRequestFields)
+			return
+		}
+		resp.Diagnostics.AddWarning(
+			"Existing organization reused",
+			fmt.Sprintf("Organization %q already exists with ID %s and was adopted instead of creating another one because reuse_existing is set.",
+				org.Name, org.ID),
+		)
+	} else {
+		org, err = r.AdminClient.CreateOrganization(ctx, &langfuse.CreateOrganizationRequest{
+			Name:     data.Name.ValueString(),
+			Metadata: stampManagedByMetadata(r.ClientFactory, metadata),
+		})
+		if err != nil {
+			addAdminClientError(&resp.Diagnostics, r.ClientFactory, "langfuse_organization", "Error creating organization", err)
+			return
+		}
+	}
+
+	remoteMetadata := stripManagedByMetadata(org.Metadata, metadata)
+	if data.ManagedMetadataOnly.ValueBool() {
+		remoteMetadata = filterManagedMetadata(remoteMetadata, metadata)
 	}
 
 	var metadataMap types.Map
-	if len(org.Metadata) > 0 {
+	if len(remoteMetadata) > 0 {
 		var diags diag.Diagnostics
-		metadataMap, diags = types.MapValueFrom(ctx, types.StringType, org.Metadata)
+		metadataMap, diags = types.MapValueFrom(ctx, types.StringType, remoteMetadata)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
@@ -97,31 +248,52 @@ func (r *organizationResource) Create(ctx context.Context, req resource.CreateRe
 		metadataMap = types.MapNull(types.StringType)
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &organizationResourceModel{
-		ID:       types.StringValue(org.ID),
-		Name:     types.StringValue(org.Name),
-		Metadata: metadataMap,
-	})...)
+	state := organizationResourceModel{
+		ID:                  types.StringValue(org.ID),
+		Name:                types.StringValue(org.Name),
+		Metadata:            metadataMap,
+		ManagedMetadataOnly: data.ManagedMetadataOnly,
+		ReuseExisting:       data.ReuseExisting,
+	}
+	state.copyDestroySettings(data)
+	state.setCloudConfig(org)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *organizationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(checkAdminAPIAvailable(r.ClientFactory, "langfuse_organization")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data organizationResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withRequestAttributes(ctx, r.ClientFactory, data.ID, types.StringNull())
 
 	org, err := r.AdminClient.GetOrganization(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading organization", err.Error())
+		addClientError(&resp.Diagnostics, "Error reading organization", err)
 		return
 	}
 
+	declared, diags := metadataElements(ctx, data.Metadata)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	remoteMetadata := stripManagedByMetadata(org.Metadata, declared)
+	if data.ManagedMetadataOnly.ValueBool() {
+		remoteMetadata = filterManagedMetadata(remoteMetadata, declared)
+	}
+
 	var metadataMap types.Map
-	if len(org.Metadata) > 0 {
+	if len(remoteMetadata) > 0 {
 		var diags diag.Diagnostics
-		metadataMap, diags = types.MapValueFrom(ctx, types.StringType, org.Metadata)
+		metadataMap, diags = types.MapValueFrom(ctx, types.StringType, remoteMetadata)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
@@ -130,14 +302,26 @@ func (r *organizationResource) Read(ctx context.Context, req resource.ReadReques
 		metadataMap = types.MapNull(types.StringType)
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &organizationResourceModel{
-		ID:       types.StringValue(org.ID),
-		Name:     types.StringValue(org.Name),
-		Metadata: metadataMap,
-	})...)
+	state := organizationResourceModel{
+		ID:                  types.StringValue(org.ID),
+		Name:                types.StringValue(org.Name),
+		Metadata:            metadataMap,
+		ManagedMetadataOnly: data.ManagedMetadataOnly,
+		ReuseExisting:       data.ReuseExisting,
+	}
+	state.copyDestroySettings(data)
+	state.setCloudConfig(org)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *organizationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer addRunWarnings(&resp.Diagnostics, r.ClientFactory)
+
+	resp.Diagnostics.Append(checkAdminAPIAvailable(r.ClientFactory, "langfuse_organization")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data organizationResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
@@ -151,6 +335,7 @@ func (r *organizationResource) Update(ctx context.Context, req resource.UpdateRe
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withRequestAttributes(ctx, r.ClientFactory, currentState.ID, types.StringNull())
 
 	orgID := currentState.ID.ValueString()
 
@@ -162,21 +347,52 @@ func (r *organizationResource) Update(ctx context.Context, req resource.UpdateRe
 		}
 	}
 
+	metadataChanged := !data.Metadata.Equal(currentState.Metadata) || !data.ManagedMetadataOnly.Equal(currentState.ManagedMetadataOnly)
+	requestMetadata := stampManagedByMetadata(r.ClientFactory, metadata)
+	if metadataChanged && data.ManagedMetadataOnly.ValueBool() {
+		// Preserve the keys this resource does not manage. Keys are only removed when they were
+		// managed before, i.e. declared while managed_metadata_only was already set.
+		current, err := r.AdminClient.GetOrganization(ctx, orgID)
+		if err != nil {
+			addClientError(&resp.Diagnostics, "Error reading organization", err)
+			return
+		}
+		previous := make(map[string]string)
+		if currentState.ManagedMetadataOnly.ValueBool() {
+			var diags diag.Diagnostics
+			previous, diags = metadataElements(ctx, currentState.Metadata)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+		requestMetadata = mergeManagedMetadata(current.Metadata, previous, requestMetadata)
+	}
+
+	// Metadata is only sent when it changed, so that a rename does not overwrite metadata changed
+	// outside Terraform in the meantime. Langfuse requires the name on every update.
 	request := &langfuse.UpdateOrganizationRequest{
-		Name:     data.Name.ValueString(),
-		Metadata: metadata,
+		Name: data.Name.ValueString(),
+	}
+	if metadataChanged {
+		request.Metadata = requestMetadata
 	}
 
 	org, err := r.AdminClient.UpdateOrganization(ctx, orgID, request)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating organization", err.Error())
+		addClientFieldErrors(&resp.Diagnostics, "Error updating organization", err, organizationRequestFields)
 		return
 	}
 
+	remoteMetadata := stripManagedByMetadata(org.Metadata, metadata)
+	if data.ManagedMetadataOnly.ValueBool() {
+		remoteMetadata = filterManagedMetadata(remoteMetadata, metadata)
+	}
+
 	var metadataMap types.Map
-	if len(org.Metadata) > 0 {
+	if len(remoteMetadata) > 0 {
 		var diags diag.Diagnostics
-		metadataMap, diags = types.MapValueFrom(ctx, types.StringType, org.Metadata)
+		metadataMap, diags = types.MapValueFrom(ctx, types.StringType, remoteMetadata)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
@@ -185,17 +401,54 @@ func (r *organizationResource) Update(ctx context.Context, req resource.UpdateRe
 		metadataMap = types.MapNull(types.StringType)
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &organizationResourceModel{
-		ID:       types.StringValue(org.ID),
-		Name:     types.StringValue(org.Name),
-		Metadata: metadataMap,
-	})...)
+	state := organizationResourceModel{
+		ID:                  types.StringValue(org.ID),
+		Name:                types.StringValue(org.Name),
+		Metadata:            metadataMap,
+		ManagedMetadataOnly: data.ManagedMetadataOnly,
+		ReuseExisting:       data.ReuseExisting,
+	}
+	state.copyDestroySettings(data)
+	state.setCloudConfig(org)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// ModifyPlan reports what destroying the organization deletes along with it and enforces the
+// destroy thresholds.
+func (r *organizationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var data organizationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withRequestAttributes(ctx, r.ClientFactory, data.ID, types.StringNull())
+
+	resp.Diagnostics.Append(checkOrganizationDestroy(ctx, r.ClientFactory, data, true)...)
 }
 
 func (r *organizationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer addRunWarnings(&resp.Diagnostics, r.ClientFactory)
+
+	resp.Diagnostics.Append(checkAdminAPIAvailable(r.ClientFactory, "langfuse_organization")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data organizationResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withRequestAttributes(ctx, r.ClientFactory, data.ID, types.StringNull())
+
+	// Repeat the threshold check of the plan, for Terraform versions that do not plan destroys
+	// through the provider and in case objects were added since.
+	resp.Diagnostics.Append(checkOrganizationDestroy(ctx, r.ClientFactory, data, false)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -211,19 +464,29 @@ func (r *organizationResource) Delete(ctx context.Context, req resource.DeleteRe
 					"the Docker environment cleanup will handle resource removal. Error: "+err.Error(),
 			)
 		} else {
-			resp.Diagnostics.AddError("Error deleting organization", err.Error())
+			addClientError(&resp.Diagnostics, "Error deleting organization", err)
 			return
 		}
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &organizationResourceModel{
-		ID:       types.StringValue(""),
-		Name:     types.StringValue(""),
-		Metadata: types.MapNull(types.StringType),
+		ID:                      types.StringValue(""),
+		Name:                    types.StringValue(""),
+		Metadata:                types.MapNull(types.StringType),
+		Plan:                    types.StringNull(),
+		MonthlyObservationLimit: types.Int64Null(),
+		ManagedMetadataOnly:     types.BoolNull(),
+		ReuseExisting:           types.BoolNull(),
 	})...)
 }
 
 func (r *organizationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(checkAdminAPIAvailable(r.ClientFactory, "langfuse_organization")...)
+	resp.Diagnostics.Append(checkInstanceVersion(ctx, r.ClientFactory, "langfuse_organization")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Import by organization ID
 	orgID := req.ID
 
@@ -237,9 +500,9 @@ func (r *organizationResource) ImportState(ctx context.Context, req resource.Imp
 
 	// Convert metadata to the appropriate type
 	var metadataMap types.Map
-	if len(org.Metadata) > 0 {
+	if remoteMetadata := stripManagedByMetadata(org.Metadata, nil); len(remoteMetadata) > 0 {
 		var diags diag.Diagnostics
-		metadataMap, diags = types.MapValueFrom(ctx, types.StringType, org.Metadata)
+		metadataMap, diags = types.MapValueFrom(ctx, types.StringType, remoteMetadata)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
@@ -249,12 +512,49 @@ func (r *organizationResource) ImportState(ctx context.Context, req resource.Imp
 	}
 
 	// Set the imported state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &organizationResourceModel{
+	state := organizationResourceModel{
 		ID:       types.StringValue(org.ID),
 		Name:     types.StringValue(org.Name),
 		Metadata: metadataMap,
-	})...)
+	}
+	state.setCloudConfig(org)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 
 	// Set the ID attribute explicitly (this is a best practice for import)
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// findOrganizationByName returns the organization with the given name, or nil when there is none.
+// Several organizations with the name cannot be told apart, so they are reported as an error.
+func (r *organizationResource) findOrganizationByName(ctx context.Context, name string) (*langfuse.Organization, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	orgs, err := r.AdminClient.ListOrganizations(ctx)
+	if err != nil {
+		addClientError(&diags, "Error listing organizations", err)
+		return nil, diags
+	}
+
+	var matches []*langfuse.Organization
+	for _, org := range orgs {
+		if org.Name == name {
+			matches = append(matches, org)
+		}
+	}
+	if len(matches) > 1 {
+		ids := make([]string, 0, len(matches))
+		for _, org := range matches {
+			ids = append(ids, org.ID)
+		}
+		diags.AddError(
+			"Ambiguous existing organization",
+			fmt.Sprintf("reuse_existing is set, but %d organizations are named %q (%s). Import the intended one with terraform import instead.",
+				len(matches), name, strings.Join(ids, ", ")),
+		)
+		return nil, diags
+	}
+	if len(matches) == 0 {
+		return nil, diags
+	}
+
+	return matches[0], diags
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
 	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse/mocks"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	resschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -149,6 +150,7 @@ func TestOrganizationResourceCRUD(t *testing.T) {
 	})
 
 	var readResp resource.ReadResponse
+	observationLimit := int64(1000000)
 	t.Run("Read", func(t *testing.T) {
 		clientFactory.AdminClient.EXPECT().
 			GetOrganization(ctx, "org-123").
@@ -156,6 +158,10 @@ func TestOrganizationResourceCRUD(t *testing.T) {
 				ID:       "org-123",
 				Name:     createName,
 				Metadata: createMetadata,
+				CloudConfig: &langfuse.OrganizationCloudConfig{
+					Plan:                    "Team",
+					MonthlyObservationLimit: &observationLimit,
+				},
 			}, nil)
 
 		readResp.State.Schema = resourceSchema
@@ -165,6 +171,12 @@ func TestOrganizationResourceCRUD(t *testing.T) {
 		if readResp.Diagnostics.HasError() {
 			t.Fatalf("unexpected diagnostics from Read: %v", readResp.Diagnostics)
 		}
+
+		var state organizationResourceModel
+		readResp.Diagnostics.Append(readResp.State.Get(ctx, &state)...)
+		if state.Plan.ValueString() != "Team" || state.MonthlyObservationLimit.ValueInt64() != observationLimit {
+			t.Errorf("unexpected plan attributes: plan=%s monthly_observation_limit=%s", state.Plan, state.MonthlyObservationLimit)
+		}
 	})
 
 	var updateResp resource.UpdateResponse
@@ -281,16 +293,358 @@ func TestOrganizationResourceCRUD(t *testing.T) {
 	})
 }
 
+func TestOrganizationResourceManagedMetadataOnly(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	r := NewOrganizationResource().(*organizationResource)
+	clientFactory := mocks.NewMockClientFactory(ctrl)
+	r.Configure(ctx, resource.ConfigureRequest{ProviderData: clientFactory}, &resource.ConfigureResponse{})
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	resourceSchema := schemaResp.Schema
+
+	metadataValue := func(entries map[string]string) tftypes.Value {
+		values := make(map[string]tftypes.Value)
+		for key, value := range entries {
+			values[key] = tftypes.NewValue(tftypes.String, value)
+		}
+		return tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, values)
+	}
+	stateMetadata := func(t *testing.T, state tfsdk.State) map[string]string {
+		var model organizationResourceModel
+		if diags := state.Get(ctx, &model); diags.HasError() {
+			t.Fatalf("unexpected diagnostics reading state: %v", diags)
+		}
+		metadata, diags := metadataElements(ctx, model.Metadata)
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics reading metadata: %v", diags)
+		}
+		return metadata
+	}
+
+	state := tfsdk.State{
+		Schema: resourceSchema,
+		Raw: buildObjectValue(map[string]tftypes.Value{
+			"id":                    tftypes.NewValue(tftypes.String, "org-123"),
+			"name":                  tftypes.NewValue(tftypes.String, "Acme Inc"),
+			"metadata":              metadataValue(map[string]string{"team": "platform", "owner": "jane"}),
+			"managed_metadata_only": tftypes.NewValue(tftypes.Bool, true),
+		}),
+	}
+
+	t.Run("Read ignores undeclared keys", func(t *testing.T) {
+		clientFactory.AdminClient.EXPECT().GetOrganization(ctx, "org-123").Return(&langfuse.Organization{
+			ID:       "org-123",
+			Name:     "Acme Inc",
+			Metadata: map[string]string{"team": "platform", "owner": "jane", "billing_id": "cus_1"},
+		}, nil)
+
+		readResp := resource.ReadResponse{State: tfsdk.State{Schema: resourceSchema}}
+		r.Read(ctx, resource.ReadRequest{State: state}, &readResp)
+		if readResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics from Read: %v", readResp.Diagnostics)
+		}
+		if got := stateMetadata(t, readResp.State); len(got) != 2 || got["team"] != "platform" || got["owner"] != "jane" {
+			t.Errorf("unexpected metadata in state: %v", got)
+		}
+	})
+
+	t.Run("Update preserves undeclared keys", func(t *testing.T) {
+		clientFactory.AdminClient.EXPECT().GetOrganization(ctx, "org-123").Return(&langfuse.Organization{
+			ID:       "org-123",
+			Name:     "Acme Inc",
+			Metadata: map[string]string{"team": "platform", "owner": "jane", "billing_id": "cus_1"},
+		}, nil)
+		updated := map[string]string{"team": "ml", "billing_id": "cus_1"}
+		clientFactory.AdminClient.EXPECT().UpdateOrganization(ctx, "org-123", &langfuse.UpdateOrganizationRequest{
+			Name:     "Acme Inc",
+			Metadata: updated,
+		}).Return(&langfuse.Organization{ID: "org-123", Name: "Acme Inc", Metadata: updated}, nil)
+
+		config := tfsdk.Config{
+			Schema: resourceSchema,
+			Raw: buildObjectValue(map[string]tftypes.Value{
+				"name":                  tftypes.NewValue(tftypes.String, "Acme Inc"),
+				"metadata":              metadataValue(map[string]string{"team": "ml"}),
+				"managed_metadata_only": tftypes.NewValue(tftypes.Bool, true),
+			}),
+		}
+
+		updateResp := resource.UpdateResponse{State: tfsdk.State{Schema: resourceSchema}}
+		r.Update(ctx, resource.UpdateRequest{Config: config, State: state}, &updateResp)
+		if updateResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics from Update: %v", updateResp.Diagnostics)
+		}
+		if got := stateMetadata(t, updateResp.State); len(got) != 1 || got["team"] != "ml" {
+			t.Errorf("unexpected metadata in state: %v", got)
+		}
+	})
+
+	t.Run("Rename does not send metadata", func(t *testing.T) {
+		// billing_id was changed outside Terraform since the last refresh; a rename must not revert it.
+		clientFactory.AdminClient.EXPECT().UpdateOrganization(ctx, "org-123", &langfuse.UpdateOrganizationRequest{
+			Name: "Acme Corp",
+		}).Return(&langfuse.Organization{ID: "org-123", Name: "Acme Corp", Metadata: map[string]string{"team": "platform", "owner": "jane", "billing_id": "cus_2"}}, nil)
+
+		config := tfsdk.Config{
+			Schema: resourceSchema,
+			Raw: buildObjectValue(map[string]tftypes.Value{
+				"name":                  tftypes.NewValue(tftypes.String, "Acme Corp"),
+				"metadata":              metadataValue(map[string]string{"team": "platform", "owner": "jane"}),
+				"managed_metadata_only": tftypes.NewValue(tftypes.Bool, true),
+			}),
+		}
+
+		updateResp := resource.UpdateResponse{State: tfsdk.State{Schema: resourceSchema}}
+		r.Update(ctx, resource.UpdateRequest{Config: config, State: state}, &updateResp)
+		if updateResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics from Update: %v", updateResp.Diagnostics)
+		}
+		if got := stateMetadata(t, updateResp.State); len(got) != 2 || got["team"] != "platform" {
+			t.Errorf("unexpected metadata in state: %v", got)
+		}
+	})
+}
+
+func TestOrganizationResourceReuseExisting(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	r := NewOrganizationResource().(*organizationResource)
+	clientFactory := mocks.NewMockClientFactory(ctrl)
+	r.Configure(ctx, resource.ConfigureRequest{ProviderData: clientFactory}, &resource.ConfigureResponse{})
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	resourceSchema := schemaResp.Schema
+
+	config := tfsdk.Config{
+		Schema: resourceSchema,
+		Raw: buildObjectValue(map[string]tftypes.Value{
+			"name":           tftypes.NewValue(tftypes.String, "Acme Inc"),
+			"reuse_existing": tftypes.NewValue(tftypes.Bool, true),
+		}),
+	}
+
+	t.Run("adopts existing organization", func(t *testing.T) {
+		clientFactory.AdminClient.EXPECT().ListOrganizations(ctx).Return([]*langfuse.Organization{
+			{ID: "org-1", Name: "Other"},
+			{ID: "org-2", Name: "Acme Inc"},
+		}, nil)
+		clientFactory.AdminClient.EXPECT().UpdateOrganization(ctx, "org-2", &langfuse.UpdateOrganizationRequest{
+			Name:     "Acme Inc",
+			Metadata: map[string]string{},
+		}).Return(&langfuse.Organization{ID: "org-2", Name: "Acme Inc"}, nil)
+
+		createResp := resource.CreateResponse{State: tfsdk.State{Schema: resourceSchema}}
+		r.Create(ctx, resource.CreateRequest{Config: config}, &createResp)
+		if createResp.Diagnostics.HasError() || createResp.Diagnostics.WarningsCount() != 1 {
+			t.Fatalf("expected a single warning from Create, got: %v", createResp.Diagnostics)
+		}
+		var id string
+		createResp.State.GetAttribute(ctx, path.Root("id"), &id)
+		if id != "org-2" {
+			t.Errorf("unexpected id. got %q, want %q", id, "org-2")
+		}
+	})
+
+	t.Run("creates when no organization matches", func(t *testing.T) {
+		clientFactory.AdminClient.EXPECT().ListOrganizations(ctx).Return([]*langfuse.Organization{{ID: "org-1", Name: "Other"}}, nil)
+		clientFactory.AdminClient.EXPECT().CreateOrganization(ctx, &langfuse.CreateOrganizationRequest{
+			Name:     "Acme Inc",
+			Metadata: map[string]string{},
+		}).Return(&langfuse.Organization{ID: "org-3", Name: "Acme Inc"}, nil)
+
+		createResp := resource.CreateResponse{State: tfsdk.State{Schema: resourceSchema}}
+		r.Create(ctx, resource.CreateRequest{Config: config}, &createResp)
+		if createResp.Diagnostics.HasError() || createResp.Diagnostics.WarningsCount() != 0 {
+			t.Fatalf("unexpected diagnostics from Create: %v", createResp.Diagnostics)
+		}
+	})
+
+	t.Run("fails on ambiguous name", func(t *testing.T) {
+		clientFactory.AdminClient.EXPECT().ListOrganizations(ctx).Return([]*langfuse.Organization{
+			{ID: "org-2", Name: "Acme Inc"},
+			{ID: "org-4", Name: "Acme Inc"},
+		}, nil)
+
+		createResp := resource.CreateResponse{State: tfsdk.State{Schema: resourceSchema}}
+		r.Create(ctx, resource.CreateRequest{Config: config}, &createResp)
+		if !createResp.Diagnostics.HasError() {
+			t.Fatalf("expected an error from Create")
+		}
+	})
+}
+
+func TestOrganizationResourceDestroyGuard(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	NewOrganizationResource().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	resourceSchema := schemaResp.Schema
+
+	stateWith := func(values map[string]tftypes.Value) tfsdk.State {
+		values["id"] = tftypes.NewValue(tftypes.String, "org-123")
+		values["name"] = tftypes.NewValue(tftypes.String, "Acme Inc")
+		return tfsdk.State{Schema: resourceSchema, Raw: buildObjectValue(values)}
+	}
+	// The organization has one key, pk-acme, which the provider credentials named acme hold.
+	expectCounts := func(adminClient *mocks.MockAdminClient, organizationClient *mocks.MockOrganizationClient, temporaryKey bool) {
+		adminClient.EXPECT().ListOrganizationApiKeys(ctx, "org-123").Return([]langfuse.OrganizationApiKey{{ID: "oak-1", PublicKey: "pk-acme"}}, nil)
+		if temporaryKey {
+			adminClient.EXPECT().CreateOrganizationApiKey(ctx, "org-123").Return(&langfuse.OrganizationApiKey{ID: "oak-tmp", PublicKey: "pk-tmp", SecretKey: "sk-tmp"}, nil)
+			adminClient.EXPECT().DeleteOrganizationApiKey(ctx, "org-123", "oak-tmp").Return(nil)
+		}
+		organizationClient.EXPECT().ListProjects(ctx).Return([]*langfuse.Project{{ID: "proj-1"}, {ID: "proj-2"}}, nil)
+		organizationClient.EXPECT().ListProjectApiKeys(ctx, "proj-1").Return([]langfuse.ProjectApiKey{{ID: "pak-1"}, {ID: "pak-2"}}, nil)
+		organizationClient.EXPECT().ListProjectApiKeys(ctx, "proj-2").Return(nil, nil)
+		organizationClient.EXPECT().ListMemberships(ctx).Return([]langfuse.OrganizationMembership{{UserID: "user-1"}}, nil)
+	}
+	credentialRef := tftypes.NewValue(tftypes.String, "acme")
+	planDestroy := func(r *organizationResource, state tfsdk.State) resource.ModifyPlanResponse {
+		plan := tfsdk.Plan{Schema: resourceSchema, Raw: tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil)}
+		resp := resource.ModifyPlanResponse{Plan: plan}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{State: state, Plan: plan}, &resp)
+		return resp
+	}
+	newResource := func(t *testing.T) (*organizationResource, *mocks.MockAdminClient, *mocks.MockOrganizationClient) {
+		ctrl := gomock.NewController(t)
+		t.Cleanup(ctrl.Finish)
+		r := NewOrganizationResource().(*organizationResource)
+		clientFactory := mocks.NewMockClientFactory(ctrl)
+		clientFactory.Credentials = map[string]langfuse.OrganizationCredentials{
+			"acme":  {PublicKey: "pk-acme", PrivateKey: "sk-acme"},
+			"other": {PublicKey: "pk-other", PrivateKey: "sk-other"},
+		}
+		r.Configure(ctx, resource.ConfigureRequest{ProviderData: clientFactory}, &resource.ConfigureResponse{})
+		return r, clientFactory.AdminClient, clientFactory.OrganizationClient
+	}
+
+	t.Run("report", func(t *testing.T) {
+		r, adminClient, organizationClient := newResource(t)
+		expectCounts(adminClient, organizationClient, false)
+
+		resp := planDestroy(r, stateWith(map[string]tftypes.Value{"destroy_report": tftypes.NewValue(tftypes.Bool, true), "credential_ref": credentialRef}))
+		if resp.Diagnostics.HasError() || resp.Diagnostics.WarningsCount() != 1 {
+			t.Fatalf("expected a single warning, got: %v", resp.Diagnostics)
+		}
+		want := `Destroying organization "Acme Inc" (org-123) also deletes its 2 projects, 2 project API keys, 1 organization API keys and 1 memberships.`
+		if detail := resp.Diagnostics.Warnings()[0].Detail(); detail != want {
+			t.Errorf("unexpected report:\n%s\nwant:\n%s", detail, want)
+		}
+	})
+
+	t.Run("threshold exceeded", func(t *testing.T) {
+		r, adminClient, organizationClient := newResource(t)
+		expectCounts(adminClient, organizationClient, false)
+
+		resp := planDestroy(r, stateWith(map[string]tftypes.Value{"destroy_threshold_projects": tftypes.NewValue(tftypes.Number, 1), "credential_ref": credentialRef}))
+		if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != organizationDeletionNotConfirmed {
+			t.Fatalf("expected %q, got: %v", organizationDeletionNotConfirmed, resp.Diagnostics)
+		}
+	})
+
+	t.Run("threshold not exceeded", func(t *testing.T) {
+		r, adminClient, organizationClient := newResource(t)
+		expectCounts(adminClient, organizationClient, false)
+
+		resp := planDestroy(r, stateWith(map[string]tftypes.Value{
+			"credential_ref":             credentialRef,
+			"destroy_threshold_api_keys": tftypes.NewValue(tftypes.Number, 3),
+			"destroy_threshold_members":  tftypes.NewValue(tftypes.Number, 1),
+		}))
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+		}
+	})
+
+	t.Run("plan without credentials of the organization", func(t *testing.T) {
+		for _, ref := range []tftypes.Value{tftypes.NewValue(tftypes.String, nil), tftypes.NewValue(tftypes.String, "other")} {
+			r, adminClient, _ := newResource(t)
+			adminClient.EXPECT().ListOrganizationApiKeys(ctx, "org-123").Return([]langfuse.OrganizationApiKey{{ID: "oak-1", PublicKey: "pk-acme"}}, nil)
+
+			resp := planDestroy(r, stateWith(map[string]tftypes.Value{
+				"destroy_report":             tftypes.NewValue(tftypes.Bool, true),
+				"destroy_threshold_projects": tftypes.NewValue(tftypes.Number, 0),
+				"credential_ref":             ref,
+			}))
+			if resp.Diagnostics.HasError() || resp.Diagnostics.WarningsCount() != 1 || resp.Diagnostics.Warnings()[0].Summary() != "Organization deletion report unavailable" {
+				t.Fatalf("expected the report to be skipped with a warning (credential_ref %v), got: %v", ref, resp.Diagnostics)
+			}
+		}
+	})
+
+	t.Run("confirmed", func(t *testing.T) {
+		r, adminClient, _ := newResource(t)
+		adminClient.EXPECT().DeleteOrganization(ctx, "org-123").Return(nil)
+
+		state := stateWith(map[string]tftypes.Value{
+			"confirm_destroy":            tftypes.NewValue(tftypes.Bool, true),
+			"destroy_threshold_projects": tftypes.NewValue(tftypes.Number, 0),
+		})
+		if resp := planDestroy(r, state); resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics from ModifyPlan: %v", resp.Diagnostics)
+		}
+		deleteResp := resource.DeleteResponse{State: state}
+		r.Delete(ctx, resource.DeleteRequest{State: state}, &deleteResp)
+		if deleteResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics from Delete: %v", deleteResp.Diagnostics)
+		}
+	})
+
+	t.Run("Delete checks thresholds", func(t *testing.T) {
+		r, adminClient, organizationClient := newResource(t)
+		expectCounts(adminClient, organizationClient, true)
+
+		state := stateWith(map[string]tftypes.Value{"destroy_threshold_members": tftypes.NewValue(tftypes.Number, 0)})
+		deleteResp := resource.DeleteResponse{State: state}
+		r.Delete(ctx, resource.DeleteRequest{State: state}, &deleteResp)
+		if !deleteResp.Diagnostics.HasError() || deleteResp.Diagnostics.Errors()[0].Summary() != organizationDeletionNotConfirmed {
+			t.Fatalf("expected %q, got: %v", organizationDeletionNotConfirmed, deleteResp.Diagnostics)
+		}
+	})
+}
+
 func buildObjectValue(values map[string]tftypes.Value) tftypes.Value {
-	return tftypes.NewValue(
-		tftypes.Object{
-			AttributeTypes: map[string]tftypes.Type{
-				"id":       tftypes.String,
-				"name":     tftypes.String,
-				"metadata": tftypes.Map{ElementType: tftypes.String},
-			},
-			OptionalAttributes: map[string]struct{}{"id": {}, "metadata": {}},
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":                         tftypes.String,
+			"name":                       tftypes.String,
+			"metadata":                   tftypes.Map{ElementType: tftypes.String},
+			"plan":                       tftypes.String,
+			"monthly_observation_limit":  tftypes.Number,
+			"managed_metadata_only":      tftypes.Bool,
+			"reuse_existing":             tftypes.Bool,
+			"destroy_report":             tftypes.Bool,
+			"confirm_destroy":            tftypes.Bool,
+			"destroy_threshold_projects": tftypes.Number,
+			"destroy_threshold_api_keys": tftypes.Number,
+			"destroy_threshold_members":  tftypes.Number,
+			"credential_ref":             tftypes.String,
 		},
-		values,
-	)
+		OptionalAttributes: map[string]struct{}{
+			"id": {}, "metadata": {}, "plan": {}, "monthly_observation_limit": {}, "managed_metadata_only": {}, "reuse_existing": {},
+			"destroy_report": {}, "confirm_destroy": {}, "destroy_threshold_projects": {}, "destroy_threshold_api_keys": {}, "destroy_threshold_members": {},
+			"credential_ref": {},
+		},
+	}
+	// Computed-only attributes are null in configuration; tests only spell out the ones they care about.
+	for name, attributeType := range objectType.AttributeTypes {
+		if _, ok := values[name]; !ok {
+			values[name] = tftypes.NewValue(attributeType, nil)
+		}
+	}
+	return tftypes.NewValue(objectType, values)
 }
@@ -0,0 +1,283 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+)
+
+var _ resource.Resource = &organizationRetentionPolicyResource{}
+var _ resource.ResourceWithValidateConfig = &organizationRetentionPolicyResource{}
+var _ resource.ResourceWithModifyPlan = &organizationRetentionPolicyResource{}
+
+// organizationRetentionPolicyID is the ID of every retention policy: there is at most one per organization.
+const organizationRetentionPolicyID = "retention_policy"
+
+func NewOrganizationRetentionPolicyResource() resource.Resource {
+	return &organizationRetentionPolicyResource{}
+}
+
+type organizationRetentionPolicyResourceModel struct {
+	ID                     types.String `tfsdk:"id"`
+	RetentionDays          types.Int32  `tfsdk:"retention_days"`
+	ExcludeProjectIDs      types.Set    `tfsdk:"exclude_project_ids"`
+	OrganizationPublicKey  types.String `tfsdk:"organization_public_key"`
+	OrganizationPrivateKey types.String `tfsdk:"organization_private_key"`
+	CredentialRef          types.String `tfsdk:"credential_ref"`
+	AppliedProjectIDs      types.Set    `tfsdk:"applied_project_ids"`
+	PendingProjectIDs      types.Set    `tfsdk:"pending_project_ids"`
+}
+
+type organizationRetentionPolicyResource struct {
+	ClientFactory langfuse.ClientFactory
+}
+
+func (r *organizationRetentionPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.ClientFactory = req.ProviderData.(langfuse.ClientFactory)
+}
+
+func (r *organizationRetentionPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_organization_retention_policy"
+}
+
+func (r *organizationRetentionPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Applies a default data retention to every project of an organization, including projects created after the policy. " +
+			"Projects created since the last apply are picked up by the next plan. Destroying the policy does not change the retention of any project.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"retention_days": schema.Int32Attribute{
+				Required:    true,
+				Description: "Retention period in days applied to the projects. 0 stores data indefinitely.",
+			},
+			"exclude_project_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Projects that keep their own retention, e.g. projects whose `langfuse_project` sets `retention_days`.",
+			},
+			"organization_public_key": schema.StringAttribute{
+				Optional:    true,
+				Description: "Organization public key to authenticate the calls. Conflicts with `credential_ref`. Defaults to LANGFUSE_ORG_PUBLIC_KEY.",
+			},
+			"organization_private_key": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Organization private key to authenticate the calls. Conflicts with `credential_ref`. Defaults to LANGFUSE_ORG_SECRET_KEY.",
+			},
+			"credential_ref": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of organization credentials declared in the provider `credentials` block, used instead of `organization_public_key`/`organization_private_key`.",
+			},
+			"applied_project_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "IDs of the projects the retention was applied to.",
+			},
+			"pending_project_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "IDs of projects found on refresh that the retention has not been applied to yet. Non-empty after a refresh means the next apply applies it.",
+			},
+		},
+	}
+}
+
+func (r *organizationRetentionPolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data organizationRetentionPolicyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateOrganizationCredentials(data.OrganizationPublicKey, data.OrganizationPrivateKey, data.CredentialRef)...)
+
+	if !data.RetentionDays.IsNull() && !data.RetentionDays.IsUnknown() && data.RetentionDays.ValueInt32() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retention_days"),
+			"Invalid retention",
+			fmt.Sprintf("retention_days must be 0 or greater. Got: %d", data.RetentionDays.ValueInt32()),
+		)
+	}
+}
+
+// ModifyPlan plans an update whenever the last refresh found projects the retention has not been
+// applied to, so that new projects are covered on the next apply even though the configuration did not change.
+func (r *organizationRetentionPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	addSensitiveStateSummary(ctx, r.ClientFactory, req, resp)
+
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state organizationRetentionPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(state.PendingProjectIDs.Elements()) > 0 {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("applied_project_ids"), types.SetUnknown(types.StringType))...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("pending_project_ids"), types.SetValueMust(types.StringType, nil))...)
+	}
+}
+
+func (r *organizationRetentionPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer addRunWarnings(&resp.Diagnostics, r.ClientFactory)
+
+	resp.Diagnostics.Append(checkInstanceVersion(ctx, r.ClientFactory, "langfuse_organization_retention_policy")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data organizationRetentionPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.enforce(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *organizationRetentionPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data organizationRetentionPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationClient, diags := newOrganizationClient(r.ClientFactory, data.OrganizationPublicKey, data.OrganizationPrivateKey, data.CredentialRef)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projects, err := organizationClient.ListProjects(ctx)
+	if err != nil {
+		addOrganizationClientError(ctx, &resp.Diagnostics, r.ClientFactory, "Error listing projects", data.OrganizationPublicKey, "", err)
+		return
+	}
+
+	// Langfuse does not return the retention of a project, so only projects the policy has not been
+	// applied to yet can be detected; a retention changed in the UI is not.
+	applied := setElements(data.AppliedProjectIDs)
+	appliedIDs, pendingIDs := []string{}, []string{}
+	for _, project := range retentionPolicyProjects(projects, setElements(data.ExcludeProjectIDs)) {
+		if _, ok := applied[project.ID]; ok {
+			appliedIDs = append(appliedIDs, project.ID)
+		} else {
+			pendingIDs = append(pendingIDs, project.ID)
+		}
+	}
+
+	appliedProjectIDs, diags := types.SetValueFrom(ctx, types.StringType, appliedIDs)
+	resp.Diagnostics.Append(diags...)
+	pendingProjectIDs, diags := types.SetValueFrom(ctx, types.StringType, pendingIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.AppliedProjectIDs = appliedProjectIDs
+	data.PendingProjectIDs = pendingProjectIDs
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *organizationRetentionPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer addRunWarnings(&resp.Diagnostics, r.ClientFactory)
+
+	var data organizationRetentionPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.enforce(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *organizationRetentionPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Removing the policy only stops enforcement; the projects keep the retention they have.
+	resp.State.RemoveResource(ctx)
+}
+
+// enforce sets the retention of every project the policy covers and fills in the computed attributes.
+func (r *organizationRetentionPolicyResource) enforce(ctx context.Context, data *organizationRetentionPolicyResourceModel) diag.Diagnostics {
+	organizationClient, diags := newOrganizationClient(r.ClientFactory, data.OrganizationPublicKey, data.OrganizationPrivateKey, data.CredentialRef)
+	if diags.HasError() {
+		return diags
+	}
+
+	projects, err := organizationClient.ListProjects(ctx)
+	if err != nil {
+		addClientError(&diags, "Error listing projects", err)
+		return diags
+	}
+
+	appliedIDs := []string{}
+	for _, project := range retentionPolicyProjects(projects, setElements(data.ExcludeProjectIDs)) {
+		// Langfuse requires the name on every update; the metadata is left alone.
+		_, err := organizationClient.UpdateProject(ctx, project.ID, &langfuse.UpdateProjectRequest{
+			Name:          project.Name,
+			RetentionDays: data.RetentionDays.ValueInt32Pointer(),
+		})
+		if err != nil {
+			addClientError(&diags, fmt.Sprintf("Error setting the retention of project %s (%s)", project.ID, project.Name), err)
+			return diags
+		}
+		appliedIDs = append(appliedIDs, project.ID)
+	}
+
+	appliedProjectIDs, setDiags := types.SetValueFrom(ctx, types.StringType, appliedIDs)
+	diags.Append(setDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.ID = types.StringValue(organizationRetentionPolicyID)
+	data.AppliedProjectIDs = appliedProjectIDs
+	data.PendingProjectIDs = types.SetValueMust(types.StringType, nil)
+
+	return diags
+}
+
+// retentionPolicyProjects returns the projects not excluded from the policy, sorted by ID.
+func retentionPolicyProjects(projects []*langfuse.Project, excluded map[string]struct{}) []*langfuse.Project {
+	var covered []*langfuse.Project
+	for _, project := range projects {
+		if _, ok := excluded[project.ID]; !ok {
+			covered = append(covered, project)
+		}
+	}
+	sort.Slice(covered, func(i, j int) bool { return covered[i].ID < covered[j].ID })
+	return covered
+}
@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse/mocks"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestOrganizationRetentionPolicyResourceCRUD(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	r := NewOrganizationRetentionPolicyResource().(*organizationRetentionPolicyResource)
+	clientFactory := mocks.NewMockClientFactory(ctrl)
+	r.Configure(ctx, resource.ConfigureRequest{ProviderData: clientFactory}, &resource.ConfigureResponse{})
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	resourceSchema := schemaResp.Schema
+	if diags := resourceSchema.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("schema implementation validation failed: %v", diags)
+	}
+
+	chat := &langfuse.Project{ID: "proj-1", Name: "chat", Metadata: map[string]string{"team": "ml"}}
+	legal := &langfuse.Project{ID: "proj-2", Name: "legal"}
+	search := &langfuse.Project{ID: "proj-3", Name: "search"}
+
+	objectType := resourceSchema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{
+		"id":             tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"retention_days": tftypes.NewValue(tftypes.Number, 30),
+		"exclude_project_ids": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, legal.ID),
+		}),
+		"organization_public_key":  tftypes.NewValue(tftypes.String, "pk-org"),
+		"organization_private_key": tftypes.NewValue(tftypes.String, "sk-org"),
+		"applied_project_ids":      tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, tftypes.UnknownValue),
+		"pending_project_ids":      tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, tftypes.UnknownValue),
+	}
+	for name, attributeType := range objectType.AttributeTypes {
+		if _, ok := values[name]; !ok {
+			values[name] = tftypes.NewValue(attributeType, nil)
+		}
+	}
+	plan := tfsdk.Plan{Schema: resourceSchema, Raw: tftypes.NewValue(objectType, values)}
+
+	var createResp resource.CreateResponse
+	t.Run("Create applies the retention to every project not excluded", func(t *testing.T) {
+		clientFactory.OrganizationClient.EXPECT().ListProjects(ctx).Return([]*langfuse.Project{legal, chat}, nil)
+		retention := int32(30)
+		clientFactory.OrganizationClient.EXPECT().UpdateProject(ctx, chat.ID, &langfuse.UpdateProjectRequest{
+			Name:          chat.Name,
+			RetentionDays: &retention,
+		}).Return(chat, nil)
+
+		createResp.State.Schema = resourceSchema
+		r.Create(ctx, resource.CreateRequest{Plan: plan}, &createResp)
+		if createResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics from Create: %v", createResp.Diagnostics)
+		}
+	})
+
+	var readResp resource.ReadResponse
+	t.Run("Read reports new projects", func(t *testing.T) {
+		clientFactory.OrganizationClient.EXPECT().ListProjects(ctx).Return([]*langfuse.Project{legal, chat, search}, nil)
+
+		readResp.State.Schema = resourceSchema
+		r.Read(ctx, resource.ReadRequest{State: createResp.State}, &readResp)
+		if readResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics from Read: %v", readResp.Diagnostics)
+		}
+
+		var pendingProjectIDs types.Set
+		readResp.State.GetAttribute(ctx, path.Root("pending_project_ids"), &pendingProjectIDs)
+		if len(pendingProjectIDs.Elements()) != 1 || !pendingProjectIDs.Elements()[0].Equal(types.StringValue(search.ID)) {
+			t.Fatalf("expected the new project to be pending, got %v", pendingProjectIDs)
+		}
+	})
+
+	t.Run("ModifyPlan plans applying the retention", func(t *testing.T) {
+		modifyResp := resource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: resourceSchema, Raw: readResp.State.Raw}}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{State: readResp.State, Plan: modifyResp.Plan}, &modifyResp)
+		if modifyResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics from ModifyPlan: %v", modifyResp.Diagnostics)
+		}
+
+		var pendingProjectIDs types.Set
+		modifyResp.Plan.GetAttribute(ctx, path.Root("pending_project_ids"), &pendingProjectIDs)
+		if len(pendingProjectIDs.Elements()) != 0 {
+			t.Fatalf("expected the plan to clear pending projects, got %v", pendingProjectIDs)
+		}
+	})
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// preserveStateModifier keeps the prior state value of a computed attribute in the plan of an
+// existing resource, null included. UseStateForUnknown leaves the plan unknown when the state
+// value is null, which shows "(known after apply)" churn in every update plan for attributes
+// that are legitimately null, such as the secret key of an imported or hashed API key. Terraform
+// plans replacements as a create without prior state, so new keys still get unknown values.
+type preserveStateModifier struct{}
+
+// preserveStateString returns a plan modifier that keeps the prior state value of a computed string.
+func preserveStateString() planmodifier.String {
+	return preserveStateModifier{}
+}
+
+// preserveStateMap returns a plan modifier that keeps the prior state value of a computed map.
+func preserveStateMap() planmodifier.Map {
+	return preserveStateModifier{}
+}
+
+func (m preserveStateModifier) Description(ctx context.Context) string {
+	return "Once set, the value of this attribute in state will not change, even when it is null."
+}
+
+func (m preserveStateModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m preserveStateModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.State.Raw.IsNull() || !req.PlanValue.IsUnknown() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	resp.PlanValue = req.StateValue
+}
+
+func (m preserveStateModifier) PlanModifyMap(ctx context.Context, req planmodifier.MapRequest, resp *planmodifier.MapResponse) {
+	if req.State.Raw.IsNull() || !req.PlanValue.IsUnknown() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	resp.PlanValue = req.StateValue
+}
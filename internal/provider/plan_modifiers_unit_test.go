@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestPreserveStateString(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	existing := tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{})}
+	created := tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, nil)}
+
+	testCases := []struct {
+		name   string
+		state  tfsdk.State
+		prior  types.String
+		config types.String
+		plan   types.String
+		want   types.String
+	}{
+		{
+			name:   "prior value kept",
+			state:  existing,
+			prior:  types.StringValue("sk-lf-1"),
+			config: types.StringNull(),
+			plan:   types.StringUnknown(),
+			want:   types.StringValue("sk-lf-1"),
+		},
+		{
+			name:   "prior null kept",
+			state:  existing,
+			prior:  types.StringNull(),
+			config: types.StringNull(),
+			plan:   types.StringUnknown(),
+			want:   types.StringNull(),
+		},
+		{
+			name:   "create stays unknown",
+			state:  created,
+			prior:  types.StringNull(),
+			config: types.StringNull(),
+			plan:   types.StringUnknown(),
+			want:   types.StringUnknown(),
+		},
+		{
+			name:   "known plan untouched",
+			state:  existing,
+			prior:  types.StringValue("https://old.example.com"),
+			config: types.StringNull(),
+			plan:   types.StringValue("https://new.example.com"),
+			want:   types.StringValue("https://new.example.com"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := planmodifier.StringResponse{PlanValue: tc.plan}
+			preserveStateString().PlanModifyString(ctx, planmodifier.StringRequest{
+				State:       tc.state,
+				StateValue:  tc.prior,
+				ConfigValue: tc.config,
+				PlanValue:   tc.plan,
+			}, &resp)
+			if !resp.PlanValue.Equal(tc.want) {
+				t.Errorf("unexpected plan value. got %v, want %v", resp.PlanValue, tc.want)
+			}
+		})
+	}
+}
+
+// TestKeyResourcesPreserveComputedAttributes guards against computed key attributes that would
+// show up as "(known after apply)" in update plans.
+func TestKeyResourcesPreserveComputedAttributes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	for _, r := range []resource.Resource{NewOrganizationApiKeyResource(), NewProjectApiKeyResource()} {
+		var schemaResp resource.SchemaResponse
+		r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+		for name, attribute := range schemaResp.Schema.Attributes {
+			if !attribute.IsComputed() || attribute.IsOptional() {
+				continue
+			}
+			switch attribute := attribute.(type) {
+			case resschema.StringAttribute:
+				if len(attribute.PlanModifiers) == 0 {
+					t.Errorf("%T: computed attribute %q has no plan modifier", r, name)
+				}
+			case resschema.MapAttribute:
+				if len(attribute.PlanModifiers) == 0 {
+					t.Errorf("%T: computed attribute %q has no plan modifier", r, name)
+				}
+			default:
+				t.Errorf("%T: unexpected computed attribute %q of type %T", r, name, attribute)
+			}
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+)
+
+var _ datasource.DataSource = &projectApiKeyImportsDataSource{}
+
+func NewProjectApiKeyImportsDataSource() datasource.DataSource {
+	return &projectApiKeyImportsDataSource{}
+}
+
+type projectApiKeyImportsDataSourceModel struct {
+	ProjectID              types.String                    `tfsdk:"project_id"`
+	OrganizationPublicKey  types.String                    `tfsdk:"organization_public_key"`
+	OrganizationPrivateKey types.String                    `tfsdk:"organization_private_key"`
+	CredentialRef          types.String                    `tfsdk:"credential_ref"`
+	Keys                   []projectApiKeyImportEntryModel `tfsdk:"keys"`
+	ImportIDs              types.Map                       `tfsdk:"import_ids"`
+	ImportBlocks           types.String                    `tfsdk:"import_blocks"`
+}
+
+type projectApiKeyImportEntryModel struct {
+	ID        types.String `tfsdk:"id"`
+	PublicKey types.String `tfsdk:"public_key"`
+	Note      types.String `tfsdk:"note"`
+	ImportID  types.String `tfsdk:"import_id"`
+}
+
+type projectApiKeyImportsDataSource struct {
+	ClientFactory langfuse.ClientFactory
+}
+
+func (d *projectApiKeyImportsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clientFactory, ok := req.ProviderData.(langfuse.ClientFactory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected langfuse.ClientFactory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.ClientFactory = clientFactory
+}
+
+func (d *projectApiKeyImportsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_api_key_imports"
+}
+
+func (d *projectApiKeyImportsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists every API key of a project with its `langfuse_project_api_key` import ID, so a single `import` block with `for_each` " +
+			"adopts all keys of the project at once. Secret keys cannot be read back, so imported keys have a null `secret_key`.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the project whose keys are listed.",
+			},
+			"organization_public_key": schema.StringAttribute{
+				Optional:    true,
+				Description: "Organization public key to authenticate the call. Conflicts with `credential_ref`. Defaults to LANGFUSE_ORG_PUBLIC_KEY.",
+			},
+			"organization_private_key": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Organization private key to authenticate the call. Conflicts with `credential_ref`. Defaults to LANGFUSE_ORG_SECRET_KEY.",
+			},
+			"credential_ref": schema.StringAttribute{
+				Optional: true,
+				Description: "Name of organization credentials declared in the provider `credentials` block, used instead of `organization_public_key`/`organization_private_key`. " +
+					"It is also part of the import IDs, so imported keys read themselves with it; without it they read with LANGFUSE_ORG_PUBLIC_KEY and LANGFUSE_ORG_SECRET_KEY.",
+			},
+			"keys": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The API keys of the project, ordered by ID.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":         schema.StringAttribute{Computed: true},
+						"public_key": schema.StringAttribute{Computed: true},
+						"note":       schema.StringAttribute{Computed: true},
+						"import_id":  schema.StringAttribute{Computed: true, Description: "Import ID for `langfuse_project_api_key`."},
+					},
+				},
+			},
+			"import_ids": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Map of key ID to import ID, for the `for_each` of an `import` block and of the `langfuse_project_api_key` resource.",
+			},
+			"import_blocks": schema.StringAttribute{
+				Computed:    true,
+				Description: "Terraform `import` blocks for every key, ready to paste into a configuration.",
+			},
+		},
+	}
+}
+
+func (d *projectApiKeyImportsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer addRunWarnings(&resp.Diagnostics, d.ClientFactory)
+
+	resp.Diagnostics.Append(checkInstanceVersion(ctx, d.ClientFactory, "langfuse_project_api_key_imports")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data projectApiKeyImportsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withRequestAttributes(ctx, d.ClientFactory, types.StringNull(), data.ProjectID)
+
+	resp.Diagnostics.Append(validateOrganizationCredentials(data.OrganizationPublicKey, data.OrganizationPrivateKey, data.CredentialRef)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationClient, diags := newOrganizationClient(d.ClientFactory, data.OrganizationPublicKey, data.OrganizationPrivateKey, data.CredentialRef)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiKeys, err := organizationClient.ListProjectApiKeys(ctx, data.ProjectID.ValueString())
+	if err != nil {
+		addOrganizationClientError(ctx, &resp.Diagnostics, d.ClientFactory, "Error listing project API keys", data.OrganizationPublicKey, "", err)
+		return
+	}
+	sort.Slice(apiKeys, func(i, j int) bool { return apiKeys[i].ID < apiKeys[j].ID })
+
+	blocks := newImportBlockWriter()
+	importIDs := make(map[string]string, len(apiKeys))
+	data.Keys = []projectApiKeyImportEntryModel{}
+	for _, key := range apiKeys {
+		importParts := []string{data.ProjectID.ValueString(), key.ID}
+		if !data.CredentialRef.IsNull() {
+			importParts = append(importParts, data.CredentialRef.ValueString())
+		}
+		importID := strings.Join(importParts, ",")
+		importIDs[key.ID] = importID
+		data.Keys = append(data.Keys, projectApiKeyImportEntryModel{
+			ID:        types.StringValue(key.ID),
+			PublicKey: types.StringValue(key.PublicKey),
+			Note:      stringValueOrNull(key.Note),
+			ImportID:  types.StringValue(importID),
+		})
+		name := key.Note
+		if name == "" {
+			name = key.PublicKey
+		}
+		blocks.add("langfuse_project_api_key", name, importID)
+	}
+
+	importIDMap, diags := types.MapValueFrom(ctx, types.StringType, importIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.ImportIDs = importIDMap
+	data.ImportBlocks = types.StringValue(blocks.String())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse/mocks"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestProjectApiKeyImportsDataSourceRead(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := []struct {
+		name          string
+		credentialRef string
+		expectID      string
+	}{
+		{name: "credential reference", credentialRef: "acme", expectID: "proj-1,pak-1,acme"},
+		{name: "default credentials", expectID: "proj-1,pak-1"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := NewProjectApiKeyImportsDataSource().(*projectApiKeyImportsDataSource)
+			clientFactory := mocks.NewMockClientFactory(ctrl)
+			clientFactory.Credentials["acme"] = langfuse.OrganizationCredentials{PublicKey: "pk-org", PrivateKey: "sk-org"}
+			clientFactory.DefaultCredentials = &langfuse.OrganizationCredentials{PublicKey: "pk-env", PrivateKey: "sk-env"}
+			d.Configure(ctx, datasource.ConfigureRequest{ProviderData: clientFactory}, &datasource.ConfigureResponse{})
+
+			var schemaResp datasource.SchemaResponse
+			d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+			if diags := schemaResp.Schema.ValidateImplementation(ctx); diags.HasError() {
+				t.Fatalf("schema implementation validation failed: %v", diags)
+			}
+
+			clientFactory.OrganizationClient.EXPECT().ListProjectApiKeys(gomock.Any(), "proj-1").Return([]langfuse.ProjectApiKey{
+				{ID: "pak-2", PublicKey: "pk-lf-2"},
+				{ID: "pak-1", PublicKey: "pk-lf-1", Note: "checkout"},
+			}, nil)
+
+			objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+			values := map[string]tftypes.Value{"project_id": tftypes.NewValue(tftypes.String, "proj-1")}
+			if tc.credentialRef != "" {
+				values["credential_ref"] = tftypes.NewValue(tftypes.String, tc.credentialRef)
+			}
+			for name, attributeType := range objectType.AttributeTypes {
+				if _, ok := values[name]; !ok {
+					values[name] = tftypes.NewValue(attributeType, nil)
+				}
+			}
+
+			readResp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+			d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}}, &readResp)
+			if readResp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics from Read: %v", readResp.Diagnostics)
+			}
+
+			var data projectApiKeyImportsDataSourceModel
+			readResp.Diagnostics.Append(readResp.State.Get(ctx, &data)...)
+			if len(data.Keys) != 2 || data.Keys[0].ImportID.ValueString() != tc.expectID || !data.Keys[1].Note.IsNull() {
+				t.Errorf("unexpected keys: %+v", data.Keys)
+			}
+			if got := data.ImportIDs.Elements()["pak-1"].String(); got != `"`+tc.expectID+`"` {
+				t.Errorf("unexpected import ID for pak-1: %s", got)
+			}
+			if !strings.Contains(data.ImportBlocks.ValueString(), "to = langfuse_project_api_key.checkout\n  id = \""+tc.expectID+"\"") {
+				t.Errorf("unexpected import blocks:\n%s", data.ImportBlocks.ValueString())
+			}
+		})
+	}
+}
@@ -2,16 +2,38 @@ package provider
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ resource.Resource = &projectApiKeyResource{}
+var _ resource.ResourceWithValidateConfig = &projectApiKeyResource{}
+var _ resource.ResourceWithModifyPlan = &projectApiKeyResource{}
+var _ resource.ResourceWithImportState = &projectApiKeyResource{}
+
+const (
+	// secretKeyStoragePlaintext stores the secret key in state, readable through secret_key, env and otlp_auth_header.
+	secretKeyStoragePlaintext = "plaintext"
+	// secretKeyStorageHash stores only a salted hash of the secret key in state.
+	secretKeyStorageHash = "hash"
+	// secretKeyStorageEncrypted stores the secret key in state encrypted with the provider's state_encryption_key.
+	secretKeyStorageEncrypted = "encrypted"
+)
 
 func NewProjectApiKeyResource() resource.Resource {
 	return &projectApiKeyResource{}
@@ -21,9 +43,17 @@ type projectApiKeyResourceModel struct {
 	ID                     types.String `tfsdk:"id"`
 	OrganizationPublicKey  types.String `tfsdk:"organization_public_key"`
 	OrganizationPrivateKey types.String `tfsdk:"organization_private_key"`
+	CredentialRef          types.String `tfsdk:"credential_ref"`
 	ProjectID              types.String `tfsdk:"project_id"`
 	PublicKey              types.String `tfsdk:"public_key"`
 	SecretKey              types.String `tfsdk:"secret_key"`
+	SecretKeyStorage       types.String `tfsdk:"secret_key_storage"`
+	SecretKeyHash          types.String `tfsdk:"secret_key_hash"`
+	SecretKeyEncrypted     types.String `tfsdk:"secret_key_encrypted"`
+	Env                    types.Map    `tfsdk:"env"`
+	OtlpEndpoint           types.String `tfsdk:"otlp_endpoint"`
+	OtlpAuthHeader         types.String `tfsdk:"otlp_auth_header"`
+	Host                   types.String `tfsdk:"host"`
 }
 
 type projectApiKeyResource struct {
@@ -47,6 +77,9 @@ func (r *projectApiKeyResource) Schema(ctx context.Context, req resource.SchemaR
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					preserveStateString(),
+				},
 			},
 			"project_id": schema.StringAttribute{
 				Required:    true,
@@ -56,81 +89,293 @@ func (r *projectApiKeyResource) Schema(ctx context.Context, req resource.SchemaR
 				},
 			},
 			"organization_public_key": schema.StringAttribute{
-				Required:    true,
-				Description: "Organization public key to authenticate the call.",
+				Optional:    true,
+				Description: "Organization public key to authenticate the call. Conflicts with `credential_ref`. Defaults to LANGFUSE_ORG_PUBLIC_KEY.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
 			"organization_private_key": schema.StringAttribute{
-				Required:    true,
+				Optional:    true,
 				Sensitive:   true,
-				Description: "Organization private key to authenticate the call.",
+				Description: "Organization private key to authenticate the call. Conflicts with `credential_ref`. Defaults to LANGFUSE_ORG_SECRET_KEY.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"credential_ref": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of organization credentials declared in the provider `credentials` block, used instead of `organization_public_key`/`organization_private_key`.",
+			},
 			"public_key": schema.StringAttribute{
 				Computed:    true,
-				Sensitive:   true,
 				Description: "The public value of the API key (only returned at creation time).",
 				PlanModifiers: []planmodifier.String{
 					// Keep the value that is already in state because Read() will never be able to fetch it again.
-					stringplanmodifier.UseStateForUnknown(),
+					preserveStateString(),
 				},
 			},
 			"secret_key": schema.StringAttribute{
 				Computed:    true,
 				Sensitive:   true,
-				Description: "The secret value of the API key (only returned at creation time).",
+				Description: "The secret value of the API key (only returned at creation time). Null when `secret_key_storage` is `hash` or `encrypted`.",
 				PlanModifiers: []planmodifier.String{
 					// Keep the value that is already in state because Read() will never be able to fetch it again.
-					stringplanmodifier.UseStateForUnknown(),
+					preserveStateString(),
+				},
+			},
+			"secret_key_storage": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(secretKeyStoragePlaintext),
+				Description: "How the secret key is kept in state: `plaintext` (default), `hash` or `encrypted`. With `hash`, state only holds `secret_key_hash`; " +
+					"`secret_key`, `env` and `otlp_auth_header` are null and the secret is only available through the `langfuse_project_api_key_secret` " +
+					"ephemeral resource during the apply that creates the key. With `encrypted`, state holds `secret_key_encrypted`, encrypted with the " +
+					"provider's `state_encryption_key`, which the ephemeral resource decrypts at any time. Changing this value replaces the key.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"secret_key_hash": schema.StringAttribute{
+				Computed: true,
+				Description: "Salted SHA-256 hash of the secret key as `sha256:<salt>:<hash>` (hex-encoded; the hash covers the salt bytes followed by the secret). " +
+					"Only set when `secret_key_storage` is `hash`.",
+				PlanModifiers: []planmodifier.String{
+					preserveStateString(),
+				},
+			},
+			"secret_key_encrypted": schema.StringAttribute{
+				Computed: true,
+				Description: "The secret key encrypted with AES-256-GCM under the provider's `state_encryption_key`, as `aes256gcm:<key fingerprint>:<ciphertext>`. " +
+					"Only set when `secret_key_storage` is `encrypted`; decrypt it with the `langfuse_project_api_key_secret` ephemeral resource.",
+				PlanModifiers: []planmodifier.String{
+					preserveStateString(),
+				},
+			},
+			"env": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "LANGFUSE_PUBLIC_KEY, LANGFUSE_SECRET_KEY and LANGFUSE_HOST for the key, ready to pass to the Langfuse SDKs as environment variables.",
+				PlanModifiers: []planmodifier.Map{
+					preserveStateMap(),
+				},
+			},
+			"host": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Description: "Base URI of the Langfuse instance the key belongs to. Defaults to the provider's and follows it when it changes. " +
+					"Set it when the project lives on another instance, e.g. during a migration; the admin API key and " +
+					"LANGFUSE_ORG_PUBLIC_KEY/LANGFUSE_ORG_SECRET_KEY belong to the provider's instance, so set the organization key pair or " +
+					"`credential_ref` as well. Setting, changing or removing it replaces the key.",
+				PlanModifiers: []planmodifier.String{
+					preserveStateString(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"otlp_endpoint": schema.StringAttribute{
+				Computed:    true,
+				Description: "OTLP/HTTP endpoint of the Langfuse OpenTelemetry ingestion, for OTEL_EXPORTER_OTLP_ENDPOINT.",
+				PlanModifiers: []planmodifier.String{
+					preserveStateString(),
+				},
+			},
+			"otlp_auth_header": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Value of the Authorization header for the OTLP endpoint (`Basic` followed by the base64-encoded key pair).",
+				PlanModifiers: []planmodifier.String{
+					preserveStateString(),
 				},
 			},
 		},
 	}
 }
 
+func (r *projectApiKeyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data projectApiKeyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateOrganizationCredentials(data.OrganizationPublicKey, data.OrganizationPrivateKey, data.CredentialRef)...)
+	resp.Diagnostics.Append(validateHostOverride(data.Host)...)
+
+	if storage := data.SecretKeyStorage; !storage.IsNull() && !storage.IsUnknown() &&
+		!slices.Contains([]string{secretKeyStoragePlaintext, secretKeyStorageHash, secretKeyStorageEncrypted}, storage.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("secret_key_storage"),
+			"Invalid secret key storage",
+			fmt.Sprintf("secret_key_storage must be %q, %q or %q. Got: %s", secretKeyStoragePlaintext, secretKeyStorageHash, secretKeyStorageEncrypted, storage.ValueString()),
+		)
+	}
+}
+
+// ModifyPlan replaces keys whose host override was removed from the configuration, as they are
+// to be created on the provider's instance, rejects encrypted secret storage without a state
+// encryption key, and reports the sensitive attributes the plan writes to state, when requested.
+func (r *projectApiKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	addSensitiveStateSummary(ctx, r.ClientFactory, req, resp)
+
+	if !req.Plan.Raw.IsNull() && r.ClientFactory != nil && r.ClientFactory.StateEncryptionKey() == nil {
+		var storage types.String
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("secret_key_storage"), &storage)...)
+		if storage.ValueString() == secretKeyStorageEncrypted {
+			resp.Diagnostics.Append(stateEncryptionKeyRequired())
+		}
+	}
+
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || r.ClientFactory == nil {
+		return
+	}
+
+	var configHost types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("host"), &configHost)...)
+	override, diags := privateHostOverride(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !configHost.IsNull() || override.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("host"), r.ClientFactory.Host())...)
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("host"))
+}
+
 func (r *projectApiKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer addRunWarnings(&resp.Diagnostics, r.ClientFactory)
+
 	var data projectApiKeyResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	clientFactory := clientFactoryForHost(r.ClientFactory, data.Host)
+
+	if data.SecretKeyStorage.ValueString() == secretKeyStorageEncrypted && r.ClientFactory.StateEncryptionKey() == nil {
+		resp.Diagnostics.Append(stateEncryptionKeyRequired())
+		return
+	}
+
+	resp.Diagnostics.Append(checkInstanceVersion(ctx, clientFactory, "langfuse_project_api_key")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withRequestAttributes(ctx, clientFactory, types.StringNull(), data.ProjectID)
 
-	organizationClient := r.ClientFactory.NewOrganizationClient(data.OrganizationPublicKey.ValueString(), data.OrganizationPrivateKey.ValueString())
+	organizationClient, diags := newOrganizationClient(clientFactory, data.OrganizationPublicKey, data.OrganizationPrivateKey, data.CredentialRef)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// The project's existing keys are listed once per run, before its first key is created, so that
+	// every created key can be checked against them.
+	if err := clientFactory.ProjectApiKeys().Prepare(ctx, data.ProjectID.ValueString(), organizationClient.ListProjectApiKeys); err != nil {
+		addClientError(&resp.Diagnostics, "Error listing project API keys", err)
+		return
+	}
 	projectApiKey, err := organizationClient.CreateProjectApiKey(ctx, data.ProjectID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating project API key", err.Error())
+		addClientError(&resp.Diagnostics, "Error creating project API key", err)
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &projectApiKeyResourceModel{
+	resp.Diagnostics.Append(verifyCreatedProjectApiKey(ctx, clientFactory, organizationClient, data.ProjectID.ValueString(), projectApiKey)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := &projectApiKeyResourceModel{
 		ID:                     types.StringValue(projectApiKey.ID),
-		OrganizationPublicKey:  types.StringValue(data.OrganizationPublicKey.ValueString()),
-		OrganizationPrivateKey: types.StringValue(data.OrganizationPrivateKey.ValueString()),
+		OrganizationPublicKey:  data.OrganizationPublicKey,
+		OrganizationPrivateKey: data.OrganizationPrivateKey,
+		CredentialRef:          data.CredentialRef,
 		ProjectID:              types.StringValue(data.ProjectID.ValueString()),
 		PublicKey:              types.StringValue(projectApiKey.PublicKey),
 		SecretKey:              types.StringValue(projectApiKey.SecretKey),
-	})...)
+		SecretKeyStorage:       types.StringValue(secretKeyStoragePlaintext),
+		SecretKeyHash:          types.StringNull(),
+		SecretKeyEncrypted:     types.StringNull(),
+	}
+	if data.SecretKeyStorage.ValueString() == secretKeyStorageHash {
+		secretKeyHash, err := hashSecretKey(projectApiKey.SecretKey)
+		if err != nil {
+			resp.Diagnostics.AddError("Error hashing project API key secret", err.Error())
+			return
+		}
+		r.ClientFactory.IssuedSecrets().Store(projectApiKey.ID, projectApiKey.SecretKey)
+		state.SecretKey = types.StringNull()
+		state.SecretKeyStorage = types.StringValue(secretKeyStorageHash)
+		state.SecretKeyHash = types.StringValue(secretKeyHash)
+	}
+	if data.SecretKeyStorage.ValueString() == secretKeyStorageEncrypted {
+		secretKeyEncrypted, err := encryptStateSecret(r.ClientFactory.StateEncryptionKey(), projectApiKey.SecretKey, projectApiKey.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Error encrypting project API key secret", err.Error())
+			return
+		}
+		r.ClientFactory.IssuedSecrets().Store(projectApiKey.ID, projectApiKey.SecretKey)
+		state.SecretKey = types.StringNull()
+		state.SecretKeyStorage = types.StringValue(secretKeyStorageEncrypted)
+		state.SecretKeyEncrypted = types.StringValue(secretKeyEncrypted)
+	}
+	state.setConnectionDetails(r.connectionHost(data.Host))
+
+	if !data.Host.IsNull() {
+		override, err := json.Marshal(data.Host.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error recording host override", err.Error())
+			return
+		}
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, hostOverridePrivateKey, override)...)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
 
 func (r *projectApiKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var data projectApiKeyResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	override, diags := privateHostOverride(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	clientFactory := clientFactoryForHost(r.ClientFactory, override)
+	ctx = withRequestAttributes(ctx, clientFactory, types.StringNull(), data.ProjectID)
 
-	organizationClient := r.ClientFactory.NewOrganizationClient(data.OrganizationPublicKey.ValueString(), data.OrganizationPrivateKey.ValueString())
-	_, err := organizationClient.GetProjectApiKey(ctx, data.ProjectID.ValueString(), data.ID.ValueString())
-	if err != nil {
-		resp.State.RemoveResource(ctx)
-		return
+	// With fast_refresh the key pair in state is trusted; only the derived attributes below are updated.
+	if !skipRefreshLookup(ctx, r.ClientFactory, "langfuse_project_api_key", !data.PublicKey.IsNull()) {
+		organizationClient, diags := newOrganizationClient(clientFactory, data.OrganizationPublicKey, data.OrganizationPrivateKey, data.CredentialRef)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		_, err := organizationClient.GetProjectApiKey(ctx, data.ProjectID.ValueString(), data.ID.ValueString())
+		if err != nil {
+			// Only a key that is confirmed missing is dropped from state. Credential problems and
+			// failed lookups say nothing about whether the key still exists, so they are surfaced
+			// instead of planning a replacement.
+			if errors.Is(err, langfuse.ErrNotFound) {
+				resp.State.RemoveResource(ctx)
+				return
+			}
+			addOrganizationClientError(ctx, &resp.Diagnostics, clientFactory, "Error reading project API key", data.OrganizationPublicKey, "", err)
+			return
+		}
+	}
+
+	// Keys created before secret_key_storage existed keep their secret in state.
+	if data.SecretKeyStorage.IsNull() {
+		data.SecretKeyStorage = types.StringValue(secretKeyStoragePlaintext)
 	}
 
+	// Recompute the connection details so they follow provider host changes and get backfilled
+	// for keys created before they existed; the key pair itself is still in state.
+	data.setConnectionDetails(r.connectionHost(override))
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -139,19 +384,178 @@ func (r *projectApiKeyResource) Update(ctx context.Context, req resource.UpdateR
 }
 
 func (r *projectApiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer addRunWarnings(&resp.Diagnostics, r.ClientFactory)
+
 	var data projectApiKeyResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	override, diags := privateHostOverride(ctx, req.Private)
+	resp.Diagnostics.Append(diags...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	clientFactory := clientFactoryForHost(r.ClientFactory, override)
+	ctx = withRequestAttributes(ctx, clientFactory, types.StringNull(), data.ProjectID)
 
-	organizationClient := r.ClientFactory.NewOrganizationClient(data.OrganizationPublicKey.ValueString(), data.OrganizationPrivateKey.ValueString())
+	organizationClient, diags := newOrganizationClient(clientFactory, data.OrganizationPublicKey, data.OrganizationPrivateKey, data.CredentialRef)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	err := organizationClient.DeleteProjectApiKey(ctx, data.ProjectID.ValueString(), data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Error deleting project API key", err.Error())
+		addClientError(&resp.Diagnostics, "Error deleting project API key", err)
+		return
+	}
+
+	r.ClientFactory.IssuedSecrets().Delete(data.ID.ValueString())
+	clientFactory.ProjectApiKeys().Release(data.ProjectID.ValueString(), &langfuse.ProjectApiKey{ID: data.ID.ValueString(), PublicKey: data.PublicKey.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &projectApiKeyResourceModel{Env: types.MapNull(types.StringType)})...)
+}
+
+// ImportState accepts "<project_id>,<key_id>", optionally followed by ",<credential_ref>" and
+// ",<secret_key>". An empty credential reference reads the key with LANGFUSE_ORG_PUBLIC_KEY and
+// LANGFUSE_ORG_SECRET_KEY. Langfuse only returns the secret key at creation, so it is null, and so
+// are env and otlp_auth_header, unless passed in the import ID; a passed secret is checked against
+// the masked secret Langfuse lists for the key. Imported keys store their secret in plaintext.
+func (r *projectApiKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ",")
+	if len(parts) < 2 || len(parts) > 5 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("Expected \"<project_id>,<key_id>\", \"<project_id>,<key_id>,<credential_ref>\", \"<project_id>,<key_id>,<credential_ref>,<secret_key>\" "+
+				"or \"<project_id>,<key_id>,<organization_public_key>,<organization_private_key>,<secret_key>\", got %q.", req.ID),
+		)
 		return
 	}
+	// The organization key pair form always has five parts; its secret key may be left empty.
+	var secretKey string
+	switch len(parts) {
+	case 4:
+		secretKey = parts[3]
+	case 5:
+		secretKey = parts[4]
+	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &projectApiKeyResourceModel{})...)
+	state := projectApiKeyResourceModel{
+		ID:                     types.StringValue(parts[1]),
+		OrganizationPublicKey:  types.StringNull(),
+		OrganizationPrivateKey: types.StringNull(),
+		CredentialRef:          types.StringNull(),
+		ProjectID:              types.StringValue(parts[0]),
+		SecretKey:              types.StringNull(),
+		SecretKeyStorage:       types.StringValue(secretKeyStoragePlaintext),
+		SecretKeyHash:          types.StringNull(),
+		SecretKeyEncrypted:     types.StringNull(),
+	}
+	if len(parts) == 5 {
+		state.OrganizationPublicKey = types.StringValue(parts[2])
+		state.OrganizationPrivateKey = types.StringValue(parts[3])
+	} else if len(parts) > 2 && parts[2] != "" {
+		state.CredentialRef = types.StringValue(parts[2])
+	}
+	ctx = withRequestAttributes(ctx, r.ClientFactory, types.StringNull(), state.ProjectID)
+
+	organizationClient, diags := newOrganizationClient(r.ClientFactory, state.OrganizationPublicKey, state.OrganizationPrivateKey, state.CredentialRef)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	apiKey, err := organizationClient.GetProjectApiKey(ctx, state.ProjectID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		addOrganizationClientError(ctx, &resp.Diagnostics, r.ClientFactory, "Error importing project API key "+state.ID.ValueString(), state.OrganizationPublicKey, "", err)
+		return
+	}
+	state.PublicKey = types.StringValue(apiKey.PublicKey)
+
+	if secretKey != "" {
+		if !matchesDisplaySecretKey(apiKey.DisplaySecretKey, secretKey) {
+			resp.Diagnostics.AddError(
+				"Secret key does not match",
+				fmt.Sprintf("The secret key in the import ID does not belong to project API key %s, whose secret key is %s.", apiKey.PublicKey, apiKey.DisplaySecretKey),
+			)
+			return
+		}
+		state.SecretKey = types.StringValue(secretKey)
+	}
+	state.setConnectionDetails(r.ClientFactory.Host())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// verifyCreatedProjectApiKey makes sure a freshly created key is a key of its own before it is
+// stored: neither its ID nor its public key may belong to a key the project had before the run or
+// to a key created for another langfuse_project_api_key in the run. When many keys are created for
+// one project in the same apply, this catches racing or misbehaving servers instead of letting two
+// resources manage the same key. A key with a new ID is deleted again; a key whose ID is taken is
+// the other key and is left alone.
+func verifyCreatedProjectApiKey(ctx context.Context, clientFactory langfuse.ClientFactory, organizationClient langfuse.OrganizationClient, projectID string, apiKey *langfuse.ProjectApiKey) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if apiKey.ID == "" || apiKey.PublicKey == "" {
+		diags.AddError("Error creating project API key", fmt.Sprintf("Langfuse returned a key without ID or public key for project %s.", projectID))
+		return diags
+	}
+
+	sameID, err := clientFactory.ProjectApiKeys().Claim(projectID, apiKey)
+	if err == nil {
+		return diags
+	}
+	if sameID {
+		diags.AddError(
+			"Duplicate project API key",
+			fmt.Sprintf("Langfuse returned key %s (%s) for project %s, which already belongs to another key of the project or to another langfuse_project_api_key in this run. "+
+				"The key is not stored twice; run terraform apply again to create a distinct key.", apiKey.ID, apiKey.PublicKey, projectID),
+		)
+		return diags
+	}
+
+	detail := fmt.Sprintf("Langfuse returned key %s (%s) for project %s, whose public key already belongs to another key of the project or to another langfuse_project_api_key in this run. "+
+		"Inspect the project's API keys in Langfuse and run terraform apply again.", apiKey.ID, apiKey.PublicKey, projectID)
+	// The key was not claimed, so the registry keeps the public key of the key it duplicates.
+	if err := organizationClient.DeleteProjectApiKey(ctx, projectID, apiKey.ID); err != nil {
+		detail += fmt.Sprintf("\n\nDeleting the duplicate key %s failed; delete it in the Langfuse UI: %s", apiKey.ID, err)
+	}
+	diags.AddError("Duplicate project API key", detail)
+	return diags
+}
+
+// connectionHost returns the base URI of the instance the key lives on: the host override, or the
+// provider's host, which the key follows when it changes.
+func (r *projectApiKeyResource) connectionHost(override types.String) string {
+	if !override.IsNull() && !override.IsUnknown() {
+		return override.ValueString()
+	}
+	return r.ClientFactory.Host()
+}
+
+// setConnectionDetails derives the values SDKs and OpenTelemetry exporters need to send data with the key.
+func (m *projectApiKeyResourceModel) setConnectionDetails(host string) {
+	m.Host = types.StringValue(host)
+	if m.PublicKey.IsNull() || m.SecretKey.IsNull() {
+		m.Env = types.MapNull(types.StringType)
+		m.OtlpEndpoint = types.StringNull()
+		m.OtlpAuthHeader = types.StringNull()
+		return
+	}
+
+	env := map[string]attr.Value{
+		"LANGFUSE_PUBLIC_KEY": m.PublicKey,
+		"LANGFUSE_SECRET_KEY": m.SecretKey,
+		"LANGFUSE_HOST":       types.StringValue(host),
+	}
+	m.Env = types.MapValueMust(types.StringType, env)
+	m.OtlpEndpoint = types.StringValue(otlpEndpoint(host))
+	m.OtlpAuthHeader = types.StringValue("Basic " + base64.StdEncoding.EncodeToString([]byte(m.PublicKey.ValueString()+":"+m.SecretKey.ValueString())))
+}
+
+// matchesDisplaySecretKey reports whether secretKey is the secret Langfuse lists masked as
+// displaySecretKey, e.g. "sk-lf-...1a2b". Instances that list no masked secret cannot be checked.
+func matchesDisplaySecretKey(displaySecretKey, secretKey string) bool {
+	prefix, suffix, masked := strings.Cut(displaySecretKey, "...")
+	if !masked {
+		return displaySecretKey == "" || displaySecretKey == secretKey
+	}
+	return len(secretKey) >= len(prefix)+len(suffix) && strings.HasPrefix(secretKey, prefix) && strings.HasSuffix(secretKey, suffix)
 }
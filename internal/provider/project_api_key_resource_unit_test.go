@@ -1,7 +1,10 @@
 package provider
 
 import (
+	"bytes"
 	"context"
+	"encoding/hex"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -9,6 +12,8 @@ import (
 	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
 	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse/mocks"
 
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	resschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -61,6 +66,14 @@ func TestProjectApiKeyResourceSchema(t *testing.T) {
 		t.Fatalf("'secret_key' attribute must be a computed string")
 	}
 
+	// Public keys identify the key pair in plan diffs; only the secret is hidden.
+	if pkAttr, ok := schemaResp.Schema.Attributes["public_key"].(resschema.StringAttribute); !ok || pkAttr.Sensitive {
+		t.Fatalf("'public_key' attribute must not be sensitive")
+	}
+	if !skAttr.Sensitive {
+		t.Fatalf("'secret_key' attribute must be sensitive")
+	}
+
 	projIDAttr, ok := schemaResp.Schema.Attributes["project_id"].(resschema.StringAttribute)
 	if !ok || !projIDAttr.Required {
 		t.Fatalf("'project_id' attribute must be required string")
@@ -81,6 +94,7 @@ func TestProjectApiKeyResourceCRUD(t *testing.T) {
 	}
 
 	clientFactory := mocks.NewMockClientFactory(ctrl)
+	clientFactory.BaseURL = "http://localhost:3000"
 
 	var resourceSchema resschema.Schema
 	t.Run("Configure", func(t *testing.T) {
@@ -105,14 +119,20 @@ func TestProjectApiKeyResourceCRUD(t *testing.T) {
 	var createResp resource.CreateResponse
 	t.Run("Create", func(t *testing.T) {
 		clientFactory.OrganizationClient.EXPECT().CreateProjectApiKey(ctx, projectID).Return(&langfuse.ProjectApiKey{ID: projectApiKeyID, PublicKey: publicKey, SecretKey: privateKey}, nil)
+		clientFactory.OrganizationClient.EXPECT().ListProjectApiKeys(ctx, projectID).Return(nil, nil)
 
 		createConfig := tfsdk.Config{Raw: buildApiKeyObjectValue(map[string]tftypes.Value{
 			"id":                       tftypes.NewValue(tftypes.String, nil),
 			"project_id":               tftypes.NewValue(tftypes.String, projectID),
 			"organization_public_key":  tftypes.NewValue(tftypes.String, publicKey),
 			"organization_private_key": tftypes.NewValue(tftypes.String, privateKey),
+			"credential_ref":           tftypes.NewValue(tftypes.String, nil),
 			"public_key":               tftypes.NewValue(tftypes.String, nil),
 			"secret_key":               tftypes.NewValue(tftypes.String, nil),
+			"env":                      tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			"otlp_endpoint":            tftypes.NewValue(tftypes.String, nil),
+			"otlp_auth_header":         tftypes.NewValue(tftypes.String, nil),
+			"host":                     tftypes.NewValue(tftypes.String, nil),
 		}), Schema: resourceSchema}
 		createResp.State.Schema = resourceSchema
 
@@ -120,6 +140,23 @@ func TestProjectApiKeyResourceCRUD(t *testing.T) {
 		if createResp.Diagnostics.HasError() {
 			t.Fatalf("unexpected diagnostics from Create: %v", createResp.Diagnostics)
 		}
+
+		var env map[string]string
+		createResp.State.GetAttribute(ctx, path.Root("env"), &env)
+		if env["LANGFUSE_PUBLIC_KEY"] != publicKey || env["LANGFUSE_SECRET_KEY"] != privateKey || env["LANGFUSE_HOST"] != clientFactory.BaseURL {
+			t.Errorf("unexpected env: %v", env)
+		}
+
+		var otlpEndpoint, otlpAuthHeader string
+		createResp.State.GetAttribute(ctx, path.Root("otlp_endpoint"), &otlpEndpoint)
+		createResp.State.GetAttribute(ctx, path.Root("otlp_auth_header"), &otlpAuthHeader)
+		if otlpEndpoint != "http://localhost:3000/api/public/otel" {
+			t.Errorf("unexpected otlp_endpoint: %q", otlpEndpoint)
+		}
+		// base64("pk-1234:sk-1234")
+		if otlpAuthHeader != "Basic cGstMTIzNDpzay0xMjM0" {
+			t.Errorf("unexpected otlp_auth_header: %q", otlpAuthHeader)
+		}
 	})
 
 	var readResp resource.ReadResponse
@@ -146,22 +183,359 @@ func TestProjectApiKeyResourceCRUD(t *testing.T) {
 }
 
 func buildApiKeyObjectValue(values map[string]tftypes.Value) tftypes.Value {
-	return tftypes.NewValue(
-		tftypes.Object{
-			AttributeTypes: map[string]tftypes.Type{
-				"id":                       tftypes.String,
-				"organization_public_key":  tftypes.String,
-				"organization_private_key": tftypes.String,
-				"project_id":               tftypes.String,
-				"public_key":               tftypes.String,
-				"secret_key":               tftypes.String,
-			},
-			OptionalAttributes: map[string]struct{}{
-				"id":         {},
-				"public_key": {},
-				"secret_key": {},
-			},
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":                       tftypes.String,
+			"organization_public_key":  tftypes.String,
+			"organization_private_key": tftypes.String,
+			"credential_ref":           tftypes.String,
+			"project_id":               tftypes.String,
+			"public_key":               tftypes.String,
+			"secret_key":               tftypes.String,
+			"secret_key_storage":       tftypes.String,
+			"secret_key_hash":          tftypes.String,
+			"secret_key_encrypted":     tftypes.String,
+			"env":                      tftypes.Map{ElementType: tftypes.String},
+			"otlp_endpoint":            tftypes.String,
+			"otlp_auth_header":         tftypes.String,
+			"host":                     tftypes.String,
+		},
+		OptionalAttributes: map[string]struct{}{
+			"id":                       {},
+			"organization_public_key":  {},
+			"organization_private_key": {},
+			"credential_ref":           {},
+			"public_key":               {},
+			"secret_key":               {},
+			"secret_key_storage":       {},
+			"secret_key_hash":          {},
+			"secret_key_encrypted":     {},
+			"env":                      {},
+			"otlp_endpoint":            {},
+			"otlp_auth_header":         {},
+			"host":                     {},
 		},
-		values,
-	)
+	}
+	// Attributes a test does not spell out are null, as computed attributes are in configuration.
+	for name, attributeType := range objectType.AttributeTypes {
+		if _, ok := values[name]; !ok {
+			values[name] = tftypes.NewValue(attributeType, nil)
+		}
+	}
+	return tftypes.NewValue(objectType, values)
+}
+
+func TestProjectApiKeyResourceHashedSecret(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	r := NewProjectApiKeyResource().(*projectApiKeyResource)
+	clientFactory := mocks.NewMockClientFactory(ctrl)
+	clientFactory.BaseURL = "http://localhost:3000"
+	r.Configure(ctx, resource.ConfigureRequest{ProviderData: clientFactory}, &resource.ConfigureResponse{})
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	clientFactory.OrganizationClient.EXPECT().CreateProjectApiKey(ctx, "proj-hash").Return(&langfuse.ProjectApiKey{ID: "pak-hash", PublicKey: "pk-lf-hash", SecretKey: "sk-lf-hash"}, nil)
+	clientFactory.OrganizationClient.EXPECT().ListProjectApiKeys(ctx, "proj-hash").Return(nil, nil)
+
+	createResp := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, resource.CreateRequest{Config: tfsdk.Config{Raw: buildApiKeyObjectValue(map[string]tftypes.Value{
+		"project_id":               tftypes.NewValue(tftypes.String, "proj-hash"),
+		"organization_public_key":  tftypes.NewValue(tftypes.String, "pk-org"),
+		"organization_private_key": tftypes.NewValue(tftypes.String, "sk-org"),
+		"secret_key_storage":       tftypes.NewValue(tftypes.String, secretKeyStorageHash),
+	}), Schema: schemaResp.Schema}}, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics from Create: %v", createResp.Diagnostics)
+	}
+
+	var state projectApiKeyResourceModel
+	createResp.Diagnostics.Append(createResp.State.Get(ctx, &state)...)
+	if !state.SecretKey.IsNull() || !state.Env.IsNull() || !state.OtlpAuthHeader.IsNull() {
+		t.Errorf("secret must not be stored in state: secret_key=%s env=%s otlp_auth_header=%s", state.SecretKey, state.Env, state.OtlpAuthHeader)
+	}
+
+	parts := strings.Split(state.SecretKeyHash.ValueString(), ":")
+	if len(parts) != 3 || parts[0] != "sha256" {
+		t.Fatalf("unexpected secret_key_hash: %q", state.SecretKeyHash.ValueString())
+	}
+	salt, err := hex.DecodeString(parts[1])
+	if err != nil {
+		t.Fatalf("invalid salt: %v", err)
+	}
+	if hashSecretKeyWithSalt("sk-lf-hash", salt) != state.SecretKeyHash.ValueString() {
+		t.Errorf("secret_key_hash does not match the secret key")
+	}
+
+	e := NewProjectApiKeySecretEphemeralResource()
+	e.(*projectApiKeySecretEphemeralResource).Configure(ctx, ephemeral.ConfigureRequest{ProviderData: clientFactory}, &ephemeral.ConfigureResponse{})
+	var ephemeralSchemaResp ephemeral.SchemaResponse
+	e.Schema(ctx, ephemeral.SchemaRequest{}, &ephemeralSchemaResp)
+
+	objectType := ephemeralSchemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	open := func() projectApiKeySecretEphemeralResourceModel {
+		openResp := ephemeral.OpenResponse{Result: tfsdk.EphemeralResultData{Schema: ephemeralSchemaResp.Schema}}
+		e.Open(ctx, ephemeral.OpenRequest{Config: tfsdk.Config{Schema: ephemeralSchemaResp.Schema, Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"api_key_id":           tftypes.NewValue(tftypes.String, "pak-hash"),
+			"secret_key_encrypted": tftypes.NewValue(tftypes.String, nil),
+			"secret_key":           tftypes.NewValue(tftypes.String, nil),
+			"available":            tftypes.NewValue(tftypes.Bool, nil),
+		})}}, &openResp)
+		if openResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics from Open: %v", openResp.Diagnostics)
+		}
+
+		var secret projectApiKeySecretEphemeralResourceModel
+		openResp.Diagnostics.Append(openResp.Result.Get(ctx, &secret)...)
+		return secret
+	}
+
+	if secret := open(); secret.SecretKey.ValueString() != "sk-lf-hash" || !secret.Available.ValueBool() {
+		t.Errorf("unexpected ephemeral secret: %v", secret)
+	}
+	// The secret is handed out once and not kept in memory afterwards.
+	if secret := open(); !secret.SecretKey.IsNull() || secret.Available.ValueBool() {
+		t.Errorf("expected the secret to be unavailable after the first read: %v", secret)
+	}
+}
+
+func TestProjectApiKeyResourceEncryptedSecret(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	key := bytes.Repeat([]byte{7}, 32)
+
+	r := NewProjectApiKeyResource().(*projectApiKeyResource)
+	clientFactory := mocks.NewMockClientFactory(ctrl)
+	clientFactory.BaseURL = "http://localhost:3000"
+	clientFactory.StateKey = key
+	r.Configure(ctx, resource.ConfigureRequest{ProviderData: clientFactory}, &resource.ConfigureResponse{})
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	clientFactory.OrganizationClient.EXPECT().CreateProjectApiKey(ctx, "proj-enc").Return(&langfuse.ProjectApiKey{ID: "pak-enc", PublicKey: "pk-lf-enc", SecretKey: "sk-lf-enc"}, nil)
+	clientFactory.OrganizationClient.EXPECT().ListProjectApiKeys(ctx, "proj-enc").Return(nil, nil)
+
+	config := tfsdk.Config{Raw: buildApiKeyObjectValue(map[string]tftypes.Value{
+		"project_id":               tftypes.NewValue(tftypes.String, "proj-enc"),
+		"organization_public_key":  tftypes.NewValue(tftypes.String, "pk-org"),
+		"organization_private_key": tftypes.NewValue(tftypes.String, "sk-org"),
+		"secret_key_storage":       tftypes.NewValue(tftypes.String, secretKeyStorageEncrypted),
+	}), Schema: schemaResp.Schema}
+	createResp := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, resource.CreateRequest{Config: config}, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics from Create: %v", createResp.Diagnostics)
+	}
+
+	var state projectApiKeyResourceModel
+	createResp.Diagnostics.Append(createResp.State.Get(ctx, &state)...)
+	if !state.SecretKey.IsNull() || !state.Env.IsNull() || !state.OtlpAuthHeader.IsNull() || strings.Contains(state.SecretKeyEncrypted.ValueString(), "sk-lf-enc") {
+		t.Errorf("secret must not be stored in state in plaintext: secret_key=%s env=%s otlp_auth_header=%s secret_key_encrypted=%s", state.SecretKey, state.Env, state.OtlpAuthHeader, state.SecretKeyEncrypted)
+	}
+
+	// A later run no longer has the issued secret in memory and decrypts it from state.
+	clientFactory.IssuedSecrets().Delete("pak-enc")
+	e := NewProjectApiKeySecretEphemeralResource()
+	e.(*projectApiKeySecretEphemeralResource).Configure(ctx, ephemeral.ConfigureRequest{ProviderData: clientFactory}, &ephemeral.ConfigureResponse{})
+	var ephemeralSchemaResp ephemeral.SchemaResponse
+	e.Schema(ctx, ephemeral.SchemaRequest{}, &ephemeralSchemaResp)
+
+	openResp := ephemeral.OpenResponse{Result: tfsdk.EphemeralResultData{Schema: ephemeralSchemaResp.Schema}}
+	objectType := ephemeralSchemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	e.Open(ctx, ephemeral.OpenRequest{Config: tfsdk.Config{Schema: ephemeralSchemaResp.Schema, Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"api_key_id":           tftypes.NewValue(tftypes.String, "pak-enc"),
+		"secret_key_encrypted": tftypes.NewValue(tftypes.String, state.SecretKeyEncrypted.ValueString()),
+		"secret_key":           tftypes.NewValue(tftypes.String, nil),
+		"available":            tftypes.NewValue(tftypes.Bool, nil),
+	})}}, &openResp)
+	if openResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics from Open: %v", openResp.Diagnostics)
+	}
+
+	var secret projectApiKeySecretEphemeralResourceModel
+	openResp.Diagnostics.Append(openResp.Result.Get(ctx, &secret)...)
+	if secret.SecretKey.ValueString() != "sk-lf-enc" || !secret.Available.ValueBool() {
+		t.Errorf("unexpected ephemeral secret: %v", secret)
+	}
+
+	// Without a state encryption key, no key is created.
+	clientFactory.StateKey = nil
+	createResp = resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, resource.CreateRequest{Config: config}, &createResp)
+	if !createResp.Diagnostics.HasError() || createResp.Diagnostics.Errors()[0].Summary() != "State encryption key required" {
+		t.Fatalf("expected a state encryption key required error, got: %v", createResp.Diagnostics)
+	}
+}
+
+func TestVerifyCreatedProjectApiKey(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	listed := []langfuse.ProjectApiKey{{ID: "pak-other", PublicKey: "pk-other"}}
+
+	testCases := []struct {
+		name         string
+		apiKey       langfuse.ProjectApiKey
+		expectError  bool
+		expectDelete bool
+	}{
+		{
+			name:   "unique key",
+			apiKey: langfuse.ProjectApiKey{ID: "pak-verify-1", PublicKey: "pk-verify-1"},
+		},
+		{
+			name:        "ID of an existing key",
+			apiKey:      langfuse.ProjectApiKey{ID: "pak-other", PublicKey: "pk-verify-2"},
+			expectError: true,
+		},
+		{
+			name:         "public key shared with an existing key",
+			apiKey:       langfuse.ProjectApiKey{ID: "pak-verify-3", PublicKey: "pk-other"},
+			expectError:  true,
+			expectDelete: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			clientFactory := mocks.NewMockClientFactory(ctrl)
+			clientFactory.OrganizationClient.EXPECT().ListProjectApiKeys(ctx, "proj-verify").Return(listed, nil)
+			if tc.expectDelete {
+				clientFactory.OrganizationClient.EXPECT().DeleteProjectApiKey(ctx, "proj-verify", tc.apiKey.ID).Return(nil)
+			}
+
+			if err := clientFactory.ProjectApiKeys().Prepare(ctx, "proj-verify", clientFactory.OrganizationClient.ListProjectApiKeys); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			diags := verifyCreatedProjectApiKey(ctx, clientFactory, clientFactory.OrganizationClient, "proj-verify", &tc.apiKey)
+			if diags.HasError() != tc.expectError {
+				t.Fatalf("unexpected error state: got error=%t, want error=%t: %v", diags.HasError(), tc.expectError, diags)
+			}
+		})
+	}
+
+	t.Run("key ID returned twice", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		clientFactory := mocks.NewMockClientFactory(ctrl)
+		apiKey := &langfuse.ProjectApiKey{ID: "pak-verify-twice", PublicKey: "pk-verify-twice"}
+
+		if diags := verifyCreatedProjectApiKey(ctx, clientFactory, clientFactory.OrganizationClient, "proj-verify", apiKey); diags.HasError() {
+			t.Fatalf("unexpected diagnostics for the first creation: %v", diags)
+		}
+		// The key belongs to the first resource, so it is not deleted.
+		diags := verifyCreatedProjectApiKey(ctx, clientFactory, clientFactory.OrganizationClient, "proj-verify", apiKey)
+		if !diags.HasError() || diags.Errors()[0].Summary() != "Duplicate project API key" {
+			t.Fatalf("expected a duplicate key error, got %v", diags)
+		}
+	})
+}
+
+func TestProjectApiKeyResourceImport(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	testCases := []struct {
+		name             string
+		importID         string
+		expectLookup     bool
+		expectError      bool
+		expectCredential string
+		expectPublicKey  string
+		expectSecretKey  string
+	}{
+		{name: "default credentials", importID: "proj-1,pak-1", expectLookup: true},
+		{name: "credential reference", importID: "proj-1,pak-1,acme", expectLookup: true, expectCredential: "acme"},
+		{name: "secret key", importID: "proj-1,pak-1,,sk-lf-pak-1", expectLookup: true, expectSecretKey: "sk-lf-pak-1"},
+		{name: "mismatching secret key", importID: "proj-1,pak-1,acme,sk-lf-pak-2", expectLookup: true, expectError: true},
+		{name: "missing key ID", importID: "proj-1", expectError: true},
+		{name: "organization key pair", importID: "proj-1,pak-1,pk-lf-org,sk-lf-org,", expectLookup: true, expectPublicKey: "pk-lf-org"},
+		{name: "organization key pair with secret key", importID: "proj-1,pak-1,pk-lf-org,sk-lf-org,sk-lf-pak-1", expectLookup: true, expectPublicKey: "pk-lf-org", expectSecretKey: "sk-lf-pak-1"},
+		{name: "too many parts", importID: "proj-1,pak-1,pk-lf-org,sk-lf-org,sk-lf-pak-1,extra", expectError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			r := NewProjectApiKeyResource().(*projectApiKeyResource)
+			clientFactory := mocks.NewMockClientFactory(ctrl)
+			clientFactory.Credentials["acme"] = langfuse.OrganizationCredentials{PublicKey: "pk-lf-acme", PrivateKey: "sk-lf-acme"}
+			clientFactory.DefaultCredentials = &langfuse.OrganizationCredentials{PublicKey: "pk-lf-env", PrivateKey: "sk-lf-env"}
+			r.Configure(ctx, resource.ConfigureRequest{ProviderData: clientFactory}, &resource.ConfigureResponse{})
+			if tc.expectLookup {
+				clientFactory.OrganizationClient.EXPECT().GetProjectApiKey(ctx, "proj-1", "pak-1").Return(&langfuse.ProjectApiKey{
+					ID: "pak-1", PublicKey: "pk-lf-pak-1", DisplaySecretKey: "sk-lf-...ak-1",
+				}, nil)
+			}
+
+			var schemaResp resource.SchemaResponse
+			r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+			importResp := resource.ImportStateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}}
+			r.ImportState(ctx, resource.ImportStateRequest{ID: tc.importID}, &importResp)
+
+			if importResp.Diagnostics.HasError() != tc.expectError {
+				t.Fatalf("expected error: %t, got diagnostics: %v", tc.expectError, importResp.Diagnostics)
+			}
+			if tc.expectError {
+				return
+			}
+
+			var state projectApiKeyResourceModel
+			importResp.Diagnostics.Append(importResp.State.Get(ctx, &state)...)
+			if state.ID.ValueString() != "pak-1" || state.ProjectID.ValueString() != "proj-1" || state.PublicKey.ValueString() != "pk-lf-pak-1" ||
+				state.SecretKeyStorage.ValueString() != secretKeyStoragePlaintext {
+				t.Errorf("unexpected imported key: %+v", state)
+			}
+			if state.CredentialRef.ValueString() != tc.expectCredential || state.SecretKey.ValueString() != tc.expectSecretKey {
+				t.Errorf("unexpected credential_ref %s or secret_key %s", state.CredentialRef, state.SecretKey)
+			}
+			if state.OrganizationPublicKey.ValueString() != tc.expectPublicKey || (tc.expectPublicKey != "" && state.OrganizationPrivateKey.ValueString() != "sk-lf-org") {
+				t.Errorf("unexpected organization key pair %s, %s", state.OrganizationPublicKey, state.OrganizationPrivateKey)
+			}
+			if state.Env.IsNull() != (tc.expectSecretKey == "") {
+				t.Errorf("expected env only with a secret key, got %s", state.Env)
+			}
+		})
+	}
+}
+
+func TestMatchesDisplaySecretKey(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		display string
+		secret  string
+		want    bool
+	}{
+		{display: "sk-lf-...1a2b", secret: "sk-lf-0000-1a2b", want: true},
+		{display: "sk-lf-...1a2b", secret: "sk-lf-0000-3c4d"},
+		{display: "sk-lf-...1a2b", secret: "sk-1a2b"},
+		{display: "", secret: "sk-lf-0000-1a2b", want: true},
+	}
+
+	for _, tc := range testCases {
+		if got := matchesDisplaySecretKey(tc.display, tc.secret); got != tc.want {
+			t.Errorf("matchesDisplaySecretKey(%q, %q) = %t, want %t", tc.display, tc.secret, got, tc.want)
+		}
+	}
 }
@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+)
+
+var _ ephemeral.EphemeralResource = &projectApiKeySecretEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &projectApiKeySecretEphemeralResource{}
+
+func NewProjectApiKeySecretEphemeralResource() ephemeral.EphemeralResource {
+	return &projectApiKeySecretEphemeralResource{}
+}
+
+type projectApiKeySecretEphemeralResourceModel struct {
+	ApiKeyID           types.String `tfsdk:"api_key_id"`
+	SecretKeyEncrypted types.String `tfsdk:"secret_key_encrypted"`
+	SecretKey          types.String `tfsdk:"secret_key"`
+	Available          types.Bool   `tfsdk:"available"`
+}
+
+type projectApiKeySecretEphemeralResource struct {
+	ClientFactory langfuse.ClientFactory
+}
+
+func (r *projectApiKeySecretEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.ClientFactory = req.ProviderData.(langfuse.ClientFactory)
+}
+
+func (r *projectApiKeySecretEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_api_key_secret"
+}
+
+func (r *projectApiKeySecretEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Secret key of a `langfuse_project_api_key` created with `secret_key_storage = \"hash\"` or `\"encrypted\"`. " +
+			"With `hash` the secret is only available once, to the first read during the apply that creates the key; pass it on from there, e.g. to a write-only attribute of a secrets manager resource. " +
+			"With `encrypted`, pass the key's `secret_key_encrypted` to decrypt the secret with the provider's `state_encryption_key` in any run.",
+		Attributes: map[string]schema.Attribute{
+			"api_key_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The `id` of the `langfuse_project_api_key`.",
+			},
+			"secret_key_encrypted": schema.StringAttribute{
+				Optional:    true,
+				Description: "The `secret_key_encrypted` of the `langfuse_project_api_key`, decrypted when the secret is not otherwise available.",
+			},
+			"secret_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The secret key, or null after its first read in the apply that created the key unless `secret_key_encrypted` is set.",
+			},
+			"available": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether `secret_key` is set.",
+			},
+		},
+	}
+}
+
+func (r *projectApiKeySecretEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data projectApiKeySecretEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.SecretKey = types.StringNull()
+	data.Available = types.BoolValue(false)
+	// Project API keys created with secret_key_storage = "hash" or "encrypted" leave their secret with
+	// the provider configuration's client factory, since Langfuse only returns it at creation. It is
+	// taken on first read, so it does not stay in memory for the rest of the run.
+	var secretKey string
+	var issued bool
+	if r.ClientFactory != nil {
+		secretKey, issued = r.ClientFactory.IssuedSecrets().Take(data.ApiKeyID.ValueString())
+	}
+	if issued {
+		data.SecretKey = types.StringValue(secretKey)
+		data.Available = types.BoolValue(true)
+	} else if data.SecretKeyEncrypted.ValueString() != "" {
+		var key []byte
+		if r.ClientFactory != nil {
+			key = r.ClientFactory.StateEncryptionKey()
+		}
+		if key == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("secret_key_encrypted"),
+				"State encryption key required",
+				"Decrypting secret_key_encrypted needs the provider's state_encryption_key, which is not set. Set state_encryption_key or LANGFUSE_STATE_ENCRYPTION_KEY.",
+			)
+			return
+		}
+		secretKey, err := decryptStateSecret(key, data.SecretKeyEncrypted.ValueString(), data.ApiKeyID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("secret_key_encrypted"),
+				"Error decrypting project API key secret",
+				fmt.Sprintf("The secret of project API key %s cannot be decrypted: %s.", data.ApiKeyID.ValueString(), err),
+			)
+			return
+		}
+		data.SecretKey = types.StringValue(secretKey)
+		data.Available = types.BoolValue(true)
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// hashSecretKey returns a salted SHA-256 hash of secretKey in the form sha256:<salt>:<hash>,
+// with salt and hash hex-encoded. A secret can be checked against it by hashing the salt bytes
+// followed by the secret.
+func hashSecretKey(secretKey string) (string, error) {
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	return hashSecretKeyWithSalt(secretKey, salt), nil
+}
+
+func hashSecretKeyWithSalt(secretKey string, salt []byte) string {
+	sum := sha256.Sum256(append(append([]byte{}, salt...), secretKey...))
+	return "sha256:" + hex.EncodeToString(salt) + ":" + hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,278 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+)
+
+var _ resource.Resource = &projectApiKeysPolicyResource{}
+var _ resource.ResourceWithValidateConfig = &projectApiKeysPolicyResource{}
+var _ resource.ResourceWithModifyPlan = &projectApiKeysPolicyResource{}
+
+func NewProjectApiKeysPolicyResource() resource.Resource {
+	return &projectApiKeysPolicyResource{}
+}
+
+type projectApiKeysPolicyResourceModel struct {
+	ID                     types.String `tfsdk:"id"`
+	ProjectID              types.String `tfsdk:"project_id"`
+	OrganizationPublicKey  types.String `tfsdk:"organization_public_key"`
+	OrganizationPrivateKey types.String `tfsdk:"organization_private_key"`
+	CredentialRef          types.String `tfsdk:"credential_ref"`
+	AllowedPublicKeys      types.Set    `tfsdk:"allowed_public_keys"`
+	AllowedNotes           types.Set    `tfsdk:"allowed_notes"`
+	UnmanagedKeyIDs        types.Set    `tfsdk:"unmanaged_key_ids"`
+}
+
+type projectApiKeysPolicyResource struct {
+	ClientFactory langfuse.ClientFactory
+}
+
+func (r *projectApiKeysPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.ClientFactory = req.ProviderData.(langfuse.ClientFactory)
+}
+
+func (r *projectApiKeysPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_api_keys_policy"
+}
+
+func (r *projectApiKeysPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Declares the complete set of API keys allowed on a project. Every other key, including keys created in the Langfuse UI, is revoked on apply. " +
+			"Destroying the policy does not delete or restore any key.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the project whose keys are reconciled.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"organization_public_key": schema.StringAttribute{
+				Optional:    true,
+				Description: "Organization public key to authenticate the call. Conflicts with `credential_ref`. Defaults to LANGFUSE_ORG_PUBLIC_KEY.",
+			},
+			"organization_private_key": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Organization private key to authenticate the call. Conflicts with `credential_ref`. Defaults to LANGFUSE_ORG_SECRET_KEY.",
+			},
+			"credential_ref": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of organization credentials declared in the provider `credentials` block, used instead of `organization_public_key`/`organization_private_key`.",
+			},
+			"allowed_public_keys": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Public keys that may remain on the project.",
+			},
+			"allowed_notes": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Key notes that may remain on the project. A key is kept if its public key or its note is allowed.",
+			},
+			"unmanaged_key_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "IDs of keys found on the project that the policy does not allow. Non-empty after a refresh means the next apply revokes them.",
+			},
+		},
+	}
+}
+
+func (r *projectApiKeysPolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data projectApiKeysPolicyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateOrganizationCredentials(data.OrganizationPublicKey, data.OrganizationPrivateKey, data.CredentialRef)...)
+
+	// Omitting both lists would silently revoke every key of the project; require an explicit empty set for that.
+	if data.AllowedPublicKeys.IsNull() && data.AllowedNotes.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("allowed_public_keys"),
+			"Missing allowed keys",
+			"Set allowed_public_keys, allowed_notes or both. To revoke every key of the project, set allowed_public_keys = [].",
+		)
+	}
+}
+
+// ModifyPlan plans an update whenever the last refresh found keys outside the policy, so that
+// shadow keys are revoked on the next apply even though the configuration did not change.
+func (r *projectApiKeysPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	addSensitiveStateSummary(ctx, r.ClientFactory, req, resp)
+
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state projectApiKeysPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(state.UnmanagedKeyIDs.Elements()) > 0 {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("unmanaged_key_ids"), types.SetValueMust(types.StringType, nil))...)
+	}
+}
+
+func (r *projectApiKeysPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer addRunWarnings(&resp.Diagnostics, r.ClientFactory)
+
+	resp.Diagnostics.Append(checkInstanceVersion(ctx, r.ClientFactory, "langfuse_project_api_keys_policy")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data projectApiKeysPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withRequestAttributes(ctx, r.ClientFactory, types.StringNull(), data.ProjectID)
+
+	resp.Diagnostics.Append(r.enforce(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *projectApiKeysPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data projectApiKeysPolicyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withRequestAttributes(ctx, r.ClientFactory, types.StringNull(), data.ProjectID)
+
+	organizationClient, diags := newOrganizationClient(r.ClientFactory, data.OrganizationPublicKey, data.OrganizationPrivateKey, data.CredentialRef)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiKeys, err := organizationClient.ListProjectApiKeys(ctx, data.ProjectID.ValueString())
+	if err != nil {
+		addOrganizationClientError(ctx, &resp.Diagnostics, r.ClientFactory, "Error reading project API keys", data.OrganizationPublicKey, "", err)
+		return
+	}
+
+	unmanaged := unmanagedProjectApiKeys(apiKeys, setElements(data.AllowedPublicKeys), setElements(data.AllowedNotes))
+	unmanagedIDs := make([]string, 0, len(unmanaged))
+	for _, key := range unmanaged {
+		unmanagedIDs = append(unmanagedIDs, key.ID)
+	}
+
+	unmanagedKeyIDs, diags := types.SetValueFrom(ctx, types.StringType, unmanagedIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.UnmanagedKeyIDs = unmanagedKeyIDs
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *projectApiKeysPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer addRunWarnings(&resp.Diagnostics, r.ClientFactory)
+
+	var data projectApiKeysPolicyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withRequestAttributes(ctx, r.ClientFactory, types.StringNull(), data.ProjectID)
+
+	resp.Diagnostics.Append(r.enforce(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *projectApiKeysPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer addRunWarnings(&resp.Diagnostics, r.ClientFactory)
+
+	// Removing the policy only stops enforcement; the keys that are currently allowed stay in place.
+	resp.State.RemoveResource(ctx)
+}
+
+// enforce revokes every key of the project that the policy does not allow and fills in the computed attributes.
+func (r *projectApiKeysPolicyResource) enforce(ctx context.Context, data *projectApiKeysPolicyResourceModel) diag.Diagnostics {
+	organizationClient, diags := newOrganizationClient(r.ClientFactory, data.OrganizationPublicKey, data.OrganizationPrivateKey, data.CredentialRef)
+	if diags.HasError() {
+		return diags
+	}
+
+	projectID := data.ProjectID.ValueString()
+	apiKeys, err := organizationClient.ListProjectApiKeys(ctx, projectID)
+	if err != nil {
+		addClientError(&diags, "Error listing project API keys", err)
+		return diags
+	}
+
+	for _, key := range unmanagedProjectApiKeys(apiKeys, setElements(data.AllowedPublicKeys), setElements(data.AllowedNotes)) {
+		if err := organizationClient.DeleteProjectApiKey(ctx, projectID, key.ID); err != nil {
+			addClientError(&diags, fmt.Sprintf("Error revoking project API key %s (%s)", key.ID, key.PublicKey), err)
+			return diags
+		}
+	}
+
+	data.ID = types.StringValue(projectID)
+	data.UnmanagedKeyIDs = types.SetValueMust(types.StringType, nil)
+
+	return diags
+}
+
+// unmanagedProjectApiKeys returns the keys whose public key and note are both outside the allowed sets.
+func unmanagedProjectApiKeys(apiKeys []langfuse.ProjectApiKey, allowedPublicKeys, allowedNotes map[string]struct{}) []langfuse.ProjectApiKey {
+	var unmanaged []langfuse.ProjectApiKey
+	for _, key := range apiKeys {
+		if _, ok := allowedPublicKeys[key.PublicKey]; ok {
+			continue
+		}
+		if _, ok := allowedNotes[key.Note]; ok && key.Note != "" {
+			continue
+		}
+		unmanaged = append(unmanaged, key)
+	}
+	return unmanaged
+}
+
+func setElements(set types.Set) map[string]struct{} {
+	elements := make(map[string]struct{}, len(set.Elements()))
+	for _, element := range set.Elements() {
+		if value, ok := element.(types.String); ok && !value.IsNull() && !value.IsUnknown() {
+			elements[value.ValueString()] = struct{}{}
+		}
+	}
+	return elements
+}
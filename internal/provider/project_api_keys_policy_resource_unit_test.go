@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse/mocks"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestProjectApiKeysPolicyResourceSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := NewProjectApiKeysPolicyResource()
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics from Schema: %v", schemaResp.Diagnostics)
+	}
+
+	if diags := schemaResp.Schema.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("schema implementation validation failed: %v", diags)
+	}
+
+	unmanagedAttr, ok := schemaResp.Schema.Attributes["unmanaged_key_ids"].(resschema.SetAttribute)
+	if !ok || !unmanagedAttr.Computed {
+		t.Fatalf("'unmanaged_key_ids' attribute must be a computed set")
+	}
+}
+
+func TestProjectApiKeysPolicyResourceValidateConfig(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := NewProjectApiKeysPolicyResource().(*projectApiKeysPolicyResource)
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	t.Run("No allowed keys", func(t *testing.T) {
+		var resp resource.ValidateConfigResponse
+		r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw:    buildApiKeysPolicyObjectValue(map[string]tftypes.Value{}),
+		}}, &resp)
+		if !resp.Diagnostics.HasError() {
+			t.Fatal("expected an error when neither allowed_public_keys nor allowed_notes is set")
+		}
+	})
+
+	t.Run("Explicitly empty allowed keys", func(t *testing.T) {
+		var resp resource.ValidateConfigResponse
+		r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw: buildApiKeysPolicyObjectValue(map[string]tftypes.Value{
+				"allowed_public_keys": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{}),
+			}),
+		}}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics from ValidateConfig: %v", resp.Diagnostics)
+		}
+	})
+}
+
+func TestProjectApiKeysPolicyResourceCRUD(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	r, ok := NewProjectApiKeysPolicyResource().(*projectApiKeysPolicyResource)
+	if !ok {
+		t.Fatalf("factory did not return *projectApiKeysPolicyResource")
+	}
+
+	clientFactory := mocks.NewMockClientFactory(ctrl)
+	r.Configure(ctx, resource.ConfigureRequest{ProviderData: clientFactory}, &resource.ConfigureResponse{})
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	resourceSchema := schemaResp.Schema
+
+	projectID := "proj-123"
+	managedKey := langfuse.ProjectApiKey{ID: "pak-1", PublicKey: "pk-lf-managed"}
+	notedKey := langfuse.ProjectApiKey{ID: "pak-2", PublicKey: "pk-lf-ci", Note: "ci"}
+	shadowKey := langfuse.ProjectApiKey{ID: "pak-3", PublicKey: "pk-lf-shadow", Note: "created in UI"}
+
+	plan := tfsdk.Plan{Schema: resourceSchema, Raw: buildApiKeysPolicyObjectValue(map[string]tftypes.Value{
+		"id":                       tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"project_id":               tftypes.NewValue(tftypes.String, projectID),
+		"organization_public_key":  tftypes.NewValue(tftypes.String, "pk-org"),
+		"organization_private_key": tftypes.NewValue(tftypes.String, "sk-org"),
+		"allowed_public_keys": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, managedKey.PublicKey),
+		}),
+		"allowed_notes": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "ci"),
+		}),
+		"unmanaged_key_ids": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, tftypes.UnknownValue),
+	})}
+
+	var createResp resource.CreateResponse
+	t.Run("Create revokes keys outside the policy", func(t *testing.T) {
+		clientFactory.OrganizationClient.EXPECT().ListProjectApiKeys(ctx, projectID).Return([]langfuse.ProjectApiKey{managedKey, notedKey, shadowKey}, nil)
+		clientFactory.OrganizationClient.EXPECT().DeleteProjectApiKey(ctx, projectID, shadowKey.ID).Return(nil)
+
+		createResp.State.Schema = resourceSchema
+		r.Create(ctx, resource.CreateRequest{Plan: plan}, &createResp)
+		if createResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics from Create: %v", createResp.Diagnostics)
+		}
+	})
+
+	var readResp resource.ReadResponse
+	t.Run("Read reports new shadow keys", func(t *testing.T) {
+		clientFactory.OrganizationClient.EXPECT().ListProjectApiKeys(ctx, projectID).Return([]langfuse.ProjectApiKey{managedKey, shadowKey}, nil)
+
+		readResp.State.Schema = resourceSchema
+		r.Read(ctx, resource.ReadRequest{State: createResp.State}, &readResp)
+		if readResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics from Read: %v", readResp.Diagnostics)
+		}
+
+		var unmanagedKeyIDs types.Set
+		readResp.State.GetAttribute(ctx, path.Root("unmanaged_key_ids"), &unmanagedKeyIDs)
+		if len(unmanagedKeyIDs.Elements()) != 1 {
+			t.Fatalf("expected one unmanaged key, got %v", unmanagedKeyIDs)
+		}
+	})
+
+	t.Run("ModifyPlan plans the revocation", func(t *testing.T) {
+		modifyResp := resource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: resourceSchema, Raw: readResp.State.Raw}}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{State: readResp.State, Plan: modifyResp.Plan}, &modifyResp)
+		if modifyResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics from ModifyPlan: %v", modifyResp.Diagnostics)
+		}
+
+		var unmanagedKeyIDs types.Set
+		modifyResp.Plan.GetAttribute(ctx, path.Root("unmanaged_key_ids"), &unmanagedKeyIDs)
+		if len(unmanagedKeyIDs.Elements()) != 0 {
+			t.Fatalf("expected the plan to clear unmanaged keys, got %v", unmanagedKeyIDs)
+		}
+	})
+
+	t.Run("Delete keeps keys", func(t *testing.T) {
+		var deleteResp resource.DeleteResponse
+		deleteResp.State.Schema = resourceSchema
+		r.Delete(ctx, resource.DeleteRequest{State: readResp.State}, &deleteResp)
+		if deleteResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics from Delete: %v", deleteResp.Diagnostics)
+		}
+	})
+}
+
+// buildApiKeysPolicyObjectValue builds a policy object where every attribute not in values is null.
+func buildApiKeysPolicyObjectValue(values map[string]tftypes.Value) tftypes.Value {
+	attributeTypes := map[string]tftypes.Type{
+		"id":                       tftypes.String,
+		"project_id":               tftypes.String,
+		"organization_public_key":  tftypes.String,
+		"organization_private_key": tftypes.String,
+		"credential_ref":           tftypes.String,
+		"allowed_public_keys":      tftypes.Set{ElementType: tftypes.String},
+		"allowed_notes":            tftypes.Set{ElementType: tftypes.String},
+		"unmanaged_key_ids":        tftypes.Set{ElementType: tftypes.String},
+	}
+	for name, attributeType := range attributeTypes {
+		if _, ok := values[name]; !ok {
+			values[name] = tftypes.NewValue(attributeType, nil)
+		}
+	}
+	return tftypes.NewValue(tftypes.Object{AttributeTypes: attributeTypes}, values)
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+)
+
+// validateProjectCredentials checks that a resource sets both keys of its project key pair or
+// neither, in which case the provider-level project key pair is used.
+func validateProjectCredentials(publicKey, secretKey types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if publicKey.IsNull() != secretKey.IsNull() {
+		diags.AddError(
+			"Incomplete project credentials",
+			"project_public_key and project_secret_key must be set together.",
+		)
+	}
+	return diags
+}
+
+// newProjectClient builds a project client from the key pair set on the resource, or from the
+// project key pair of the provider configuration.
+func newProjectClient(clientFactory langfuse.ClientFactory, publicKey, secretKey types.String) (langfuse.ProjectClient, diag.Diagnostics) {
+	diags := checkProviderConfigurationKnown(clientFactory)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if publicKey.ValueString() != "" && secretKey.ValueString() != "" {
+		return clientFactory.NewProjectClient(publicKey.ValueString(), secretKey.ValueString()), diags
+	}
+
+	credentials, ok := clientFactory.DefaultProjectCredentials()
+	if !ok {
+		diags.AddError(
+			"Missing project credentials",
+			"Set project_public_key and project_secret_key on the resource or in the provider configuration.",
+		)
+		return nil, diags
+	}
+	return clientFactory.NewProjectClient(credentials.PublicKey, credentials.SecretKey), diags
+}
@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse/mocks"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateProjectCredentials(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		publicKey   types.String
+		secretKey   types.String
+		expectError bool
+	}{
+		{name: "key pair", publicKey: types.StringValue("pk-lf-1"), secretKey: types.StringValue("sk-lf-1")},
+		{name: "nothing set", publicKey: types.StringNull(), secretKey: types.StringNull()},
+		{name: "unknown keys", publicKey: types.StringUnknown(), secretKey: types.StringUnknown()},
+		{name: "only public key", publicKey: types.StringValue("pk-lf-1"), secretKey: types.StringNull(), expectError: true},
+		{name: "only secret key", publicKey: types.StringNull(), secretKey: types.StringValue("sk-lf-1"), expectError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := validateProjectCredentials(tc.publicKey, tc.secretKey)
+			if diags.HasError() != tc.expectError {
+				t.Fatalf("unexpected validation result. got error=%t, want error=%t: %v", diags.HasError(), tc.expectError, diags)
+			}
+		})
+	}
+}
+
+func TestNewProjectClientDefaultCredentials(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	clientFactory := mocks.NewMockClientFactory(ctrl)
+
+	t.Run("Key pair on the resource", func(t *testing.T) {
+		client, diags := newProjectClient(clientFactory, types.StringValue("pk-lf-1"), types.StringValue("sk-lf-1"))
+		if diags.HasError() || client == nil {
+			t.Fatalf("expected a client, got diagnostics: %v", diags)
+		}
+	})
+
+	t.Run("Without default credentials", func(t *testing.T) {
+		_, diags := newProjectClient(clientFactory, types.StringNull(), types.StringNull())
+		if !diags.HasError() || diags.Errors()[0].Summary() != "Missing project credentials" {
+			t.Fatalf("expected missing project credentials, got: %v", diags)
+		}
+	})
+
+	t.Run("With default credentials", func(t *testing.T) {
+		clientFactory.ProjectCredentials = &langfuse.ProjectCredentials{PublicKey: "pk-lf-provider", SecretKey: "sk-lf-provider"}
+
+		client, diags := newProjectClient(clientFactory, types.StringNull(), types.StringNull())
+		if diags.HasError() || client == nil {
+			t.Fatalf("expected a client, got diagnostics: %v", diags)
+		}
+	})
+}
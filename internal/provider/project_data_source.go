@@ -0,0 +1,189 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+)
+
+var _ datasource.DataSource = &projectDataSource{}
+
+func NewProjectDataSource() datasource.DataSource {
+	return &projectDataSource{}
+}
+
+type projectDataSourceModel struct {
+	ID                     types.String `tfsdk:"id"`
+	Name                   types.String `tfsdk:"name"`
+	Metadata               types.Map    `tfsdk:"metadata"`
+	RetentionDays          types.Int64  `tfsdk:"retention_days"`
+	OrganizationPublicKey  types.String `tfsdk:"organization_public_key"`
+	OrganizationPrivateKey types.String `tfsdk:"organization_private_key"`
+	CredentialRef          types.String `tfsdk:"credential_ref"`
+}
+
+type projectDataSource struct {
+	ClientFactory langfuse.ClientFactory
+}
+
+func (d *projectDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clientFactory, ok := req.ProviderData.(langfuse.ClientFactory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected langfuse.ClientFactory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.ClientFactory = clientFactory
+}
+
+func (d *projectDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+func (d *projectDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up an existing project of an organization by ID or name, e.g. to reference a project created outside Terraform " +
+			"without importing it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the project. Exactly one of `id` and `name` must be set.",
+			},
+			"name": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the project. Exactly one of `id` and `name` must be set; the name must match a single project.",
+			},
+			"metadata": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Metadata of the project.",
+			},
+			"retention_days": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Retention of the project in days, or null when the instance does not report it or the project keeps data indefinitely.",
+			},
+			"organization_public_key": schema.StringAttribute{
+				Optional:    true,
+				Description: "Organization public key to authenticate the call. Conflicts with `credential_ref`. Defaults to LANGFUSE_ORG_PUBLIC_KEY.",
+			},
+			"organization_private_key": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Organization private key to authenticate the call. Conflicts with `credential_ref`. Defaults to LANGFUSE_ORG_SECRET_KEY.",
+			},
+			"credential_ref": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of organization credentials declared in the provider `credentials` block, used instead of `organization_public_key`/`organization_private_key`.",
+			},
+		},
+	}
+}
+
+func (d *projectDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer addRunWarnings(&resp.Diagnostics, d.ClientFactory)
+
+	resp.Diagnostics.Append(checkInstanceVersion(ctx, d.ClientFactory, "langfuse_project")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data projectDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withRequestAttributes(ctx, d.ClientFactory, types.StringNull(), data.ID)
+
+	if data.ID.IsNull() == data.Name.IsNull() {
+		resp.Diagnostics.AddError(
+			"Invalid project lookup",
+			"Exactly one of id and name must be set to look up a project.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(validateOrganizationCredentials(data.OrganizationPublicKey, data.OrganizationPrivateKey, data.CredentialRef)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationClient, diags := newOrganizationClient(d.ClientFactory, data.OrganizationPublicKey, data.OrganizationPrivateKey, data.CredentialRef)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projects, err := organizationClient.ListProjects(ctx)
+	if err != nil {
+		addOrganizationClientError(ctx, &resp.Diagnostics, d.ClientFactory, "Error listing projects", data.OrganizationPublicKey, "", err)
+		return
+	}
+
+	var matches []*langfuse.Project
+	for _, project := range projects {
+		if project.ID == data.ID.ValueString() || !data.Name.IsNull() && project.Name == data.Name.ValueString() {
+			matches = append(matches, project)
+		}
+	}
+
+	lookup := "ID " + data.ID.ValueString()
+	if !data.Name.IsNull() {
+		lookup = "name " + data.Name.ValueString()
+	}
+	switch len(matches) {
+	case 0:
+		resp.Diagnostics.AddError(
+			"Project not found",
+			fmt.Sprintf("The organization has no project with %s, or the organization credentials cannot see it.", lookup),
+		)
+		return
+	case 1:
+	default:
+		resp.Diagnostics.AddError(
+			"Ambiguous project name",
+			fmt.Sprintf("%d projects of the organization have the %s. Look the project up by id instead.", len(matches), lookup),
+		)
+		return
+	}
+
+	project := matches[0]
+	data.ID = types.StringValue(project.ID)
+	data.Name = types.StringValue(project.Name)
+	data.RetentionDays = projectRetentionDaysValue(project)
+
+	metadata := project.Metadata
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+	metadataMap, diags := types.MapValueFrom(ctx, types.StringType, metadata)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Metadata = metadataMap
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// projectRetentionDaysValue returns the retention of a listed project, null when the listing does
+// not report it or the project keeps data indefinitely.
+func projectRetentionDaysValue(project *langfuse.Project) types.Int64 {
+	if project.RetentionDays == nil || *project.RetentionDays <= 0 {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*project.RetentionDays))
+}
@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse/mocks"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestProjectDataSourceRead(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	retention := int32(30)
+	projects := []*langfuse.Project{
+		{ID: "proj-1", Name: "checkout", RetentionDays: &retention, Metadata: map[string]string{"team": "payments"}},
+		{ID: "proj-2", Name: "search"},
+		{ID: "proj-3", Name: "search"},
+	}
+
+	testCases := []struct {
+		name          string
+		values        map[string]tftypes.Value
+		wantID        string
+		expectedError string
+	}{
+		{name: "by name", values: map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, "checkout")}, wantID: "proj-1"},
+		{name: "by ID", values: map[string]tftypes.Value{"id": tftypes.NewValue(tftypes.String, "proj-2")}, wantID: "proj-2"},
+		{name: "not found", values: map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, "billing")}, expectedError: "Project not found"},
+		{name: "ambiguous name", values: map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, "search")}, expectedError: "Ambiguous project name"},
+		{
+			name: "ID and name",
+			values: map[string]tftypes.Value{
+				"id":   tftypes.NewValue(tftypes.String, "proj-1"),
+				"name": tftypes.NewValue(tftypes.String, "checkout"),
+			},
+			expectedError: "Invalid project lookup",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			d := NewProjectDataSource().(*projectDataSource)
+			clientFactory := mocks.NewMockClientFactory(ctrl)
+			d.Configure(ctx, datasource.ConfigureRequest{ProviderData: clientFactory}, &datasource.ConfigureResponse{})
+			clientFactory.OrganizationClient.EXPECT().ListProjects(gomock.Any()).Return(projects, nil).AnyTimes()
+
+			schemaResp, config := auditLogsConfig(ctx, t, d, tc.values)
+			readResp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+			d.Read(ctx, datasource.ReadRequest{Config: config}, &readResp)
+
+			if tc.expectedError != "" {
+				if !readResp.Diagnostics.HasError() || readResp.Diagnostics.Errors()[0].Summary() != tc.expectedError {
+					t.Fatalf("expected %q, got: %v", tc.expectedError, readResp.Diagnostics)
+				}
+				return
+			}
+			if readResp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics from Read: %v", readResp.Diagnostics)
+			}
+
+			var data projectDataSourceModel
+			readResp.Diagnostics.Append(readResp.State.Get(ctx, &data)...)
+			if data.ID.ValueString() != tc.wantID {
+				t.Errorf("expected project %s, got %+v", tc.wantID, data)
+			}
+			if tc.wantID == "proj-1" && (data.RetentionDays.ValueInt64() != 30 || data.Metadata.Elements()["team"].String() != `"payments"`) {
+				t.Errorf("unexpected project attributes: %+v", data)
+			}
+		})
+	}
+}
@@ -16,6 +16,15 @@ import (
 
 var _ resource.Resource = &projectResource{}
 var _ resource.ResourceWithImportState = &projectResource{}
+var _ resource.ResourceWithValidateConfig = &projectResource{}
+var _ resource.ResourceWithModifyPlan = &projectResource{}
+
+// projectRequestFields maps the fields of project requests to the attributes they are set from.
+var projectRequestFields = map[string]path.Path{
+	"name":      path.Root("name"),
+	"retention": path.Root("retention_days"),
+	"metadata":  path.Root("metadata"),
+}
 
 func NewProjectResource() resource.Resource {
 	return &projectResource{}
@@ -27,8 +36,31 @@ type projectResourceModel struct {
 	RetentionDays          types.Int32  `tfsdk:"retention_days"`
 	Metadata               types.Map    `tfsdk:"metadata"`
 	OrganizationID         types.String `tfsdk:"organization_id"`
+	OrganizationName       types.String `tfsdk:"organization_name"`
 	OrganizationPublicKey  types.String `tfsdk:"organization_public_key"`
 	OrganizationPrivateKey types.String `tfsdk:"organization_private_key"`
+	CredentialRef          types.String `tfsdk:"credential_ref"`
+	ManagedMetadataOnly    types.Bool   `tfsdk:"managed_metadata_only"`
+	Host                   types.String `tfsdk:"host"`
+	IngestionEndpoint      types.String `tfsdk:"ingestion_endpoint"`
+	OtlpEndpoint           types.String `tfsdk:"otlp_endpoint"`
+}
+
+// setEndpoints derives the ingestion endpoints from the instance the project lives on, so that
+// application configuration does not hardcode URL shapes that differ between cloud regions and
+// self-hosted setups.
+func (m *projectResourceModel) setEndpoints(clientFactory langfuse.ClientFactory) {
+	if clientFactory == nil || m.Host.IsUnknown() || m.Host.ValueString() == "" && providerHostUnknown(clientFactory) {
+		m.IngestionEndpoint = types.StringUnknown()
+		m.OtlpEndpoint = types.StringUnknown()
+		return
+	}
+	host := clientFactory.Host()
+	if !m.Host.IsNull() && m.Host.ValueString() != "" {
+		host = m.Host.ValueString()
+	}
+	m.IngestionEndpoint = types.StringValue(ingestionEndpoint(host))
+	m.OtlpEndpoint = types.StringValue(otlpEndpoint(host))
 }
 
 type projectResource struct {
@@ -66,6 +98,11 @@ func (r *projectResource) Schema(ctx context.Context, req resource.SchemaRequest
 				ElementType: types.StringType,
 				Description: "Metadata for the project as key-value pairs.",
 			},
+			"managed_metadata_only": schema.BoolAttribute{
+				Optional: true,
+				Description: "Only manage the `metadata` keys declared in the configuration. Keys written by Langfuse or other tools are " +
+					"neither shown as drift nor removed on update. Defaults to `false`, which replaces the whole metadata map.",
+			},
 			"organization_id": schema.StringAttribute{
 				Required:    true,
 				Description: "The ID of the organization that owns this project.",
@@ -73,33 +110,90 @@ func (r *projectResource) Schema(ctx context.Context, req resource.SchemaRequest
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
-			"organization_public_key": schema.StringAttribute{
-				Required:    true,
-				Sensitive:   true,
-				Description: "Organization public key to authenticate the call.",
+			"organization_name": schema.StringAttribute{
+				Computed: true,
+				Description: "The name of the organization that owns this project. Resolved through the admin API, so it is null " +
+					"without an admin API key and on Langfuse Cloud.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"organization_public_key": schema.StringAttribute{
+				Optional: true,
+				Description: "Organization public key to authenticate the call. Conflicts with `credential_ref`. Defaults to LANGFUSE_ORG_PUBLIC_KEY. " +
+					"Changing it, e.g. when the organization API key is rotated, never replaces the project.",
+			},
 			"organization_private_key": schema.StringAttribute{
-				Required:    true,
-				Sensitive:   true,
-				Description: "Organization private key to authenticate the call.",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-				},
+				Optional:  true,
+				Sensitive: true,
+				Description: "Organization private key to authenticate the call. Conflicts with `credential_ref`. Defaults to LANGFUSE_ORG_SECRET_KEY. " +
+					"Changing it never replaces the project.",
+			},
+			"credential_ref": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of organization credentials declared in the provider `credentials` block, used instead of `organization_public_key`/`organization_private_key`.",
+			},
+			"host": hostOverrideAttribute("project"),
+			"ingestion_endpoint": schema.StringAttribute{
+				Computed:    true,
+				Description: "REST ingestion endpoint of the instance the project lives on, for clients that post ingestion batches directly.",
+			},
+			"otlp_endpoint": schema.StringAttribute{
+				Computed:    true,
+				Description: "OTLP/HTTP endpoint of the OpenTelemetry ingestion of the instance the project lives on, for OTEL_EXPORTER_OTLP_ENDPOINT.",
 			},
 		},
 	}
 }
 
+func (r *projectResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data projectResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateOrganizationCredentials(data.OrganizationPublicKey, data.OrganizationPrivateKey, data.CredentialRef)...)
+	resp.Diagnostics.Append(validateHostOverride(data.Host)...)
+}
+
+// ModifyPlan plans the ingestion endpoints, so they are known before the project exists, and
+// reports the sensitive attributes the plan writes to state, when requested, and production
+// projects without a retention period.
+func (r *projectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	addSensitiveStateSummary(ctx, r.ClientFactory, req, resp)
+	addRetentionWarning(ctx, r.ClientFactory, req, resp)
+
+	if req.Plan.Raw.IsNull() || r.ClientFactory == nil {
+		return
+	}
+	var plan projectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.setEndpoints(r.ClientFactory)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("ingestion_endpoint"), plan.IngestionEndpoint)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("otlp_endpoint"), plan.OtlpEndpoint)...)
+}
+
 func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer addRunWarnings(&resp.Diagnostics, r.ClientFactory)
+
 	var data projectResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	clientFactory := clientFactoryForHost(r.ClientFactory, data.Host)
+
+	resp.Diagnostics.Append(checkInstanceVersion(ctx, clientFactory, "langfuse_project")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withRequestAttributes(ctx, clientFactory, data.OrganizationID, types.StringNull())
 
 	metadata := make(map[string]string)
 	if !data.Metadata.IsNull() && !data.Metadata.IsUnknown() {
@@ -109,21 +203,30 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 		}
 	}
 
-	organizationClient := r.ClientFactory.NewOrganizationClient(data.OrganizationPublicKey.ValueString(), data.OrganizationPrivateKey.ValueString())
+	organizationClient, diags := newOrganizationClient(clientFactory, data.OrganizationPublicKey, data.OrganizationPrivateKey, data.CredentialRef)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	project, err := organizationClient.CreateProject(ctx, &langfuse.CreateProjectRequest{
 		Name:          data.Name.ValueString(),
 		RetentionDays: data.RetentionDays.ValueInt32(),
-		Metadata:      metadata,
+		Metadata:      stampManagedByMetadata(clientFactory, metadata),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating project", err.Error())
+		addClientFieldErrors(&resp.Diagnostics, "Error creating project", err, projectRequestFields)
 		return
 	}
 
+	remoteMetadata := stripManagedByMetadata(project.Metadata, metadata)
+	if data.ManagedMetadataOnly.ValueBool() {
+		remoteMetadata = filterManagedMetadata(remoteMetadata, metadata)
+	}
+
 	var metadataMap types.Map
-	if len(project.Metadata) > 0 {
+	if len(remoteMetadata) > 0 {
 		var diags diag.Diagnostics
-		metadataMap, diags = types.MapValueFrom(ctx, types.StringType, project.Metadata)
+		metadataMap, diags = types.MapValueFrom(ctx, types.StringType, remoteMetadata)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
@@ -132,15 +235,21 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 		metadataMap = types.MapNull(types.StringType)
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &projectResourceModel{
+	state := projectResourceModel{
 		ID:                     types.StringValue(project.ID),
 		Name:                   types.StringValue(project.Name),
-		RetentionDays:          types.Int32Value(project.RetentionDays),
+		RetentionDays:          data.RetentionDays,
 		Metadata:               metadataMap,
 		OrganizationID:         types.StringValue(data.OrganizationID.ValueString()),
-		OrganizationPublicKey:  types.StringValue(data.OrganizationPublicKey.ValueString()),
-		OrganizationPrivateKey: types.StringValue(data.OrganizationPrivateKey.ValueString()),
-	})...)
+		OrganizationName:       organizationName(ctx, clientFactory, data.OrganizationID.ValueString()),
+		OrganizationPublicKey:  data.OrganizationPublicKey,
+		OrganizationPrivateKey: data.OrganizationPrivateKey,
+		CredentialRef:          data.CredentialRef,
+		ManagedMetadataOnly:    data.ManagedMetadataOnly,
+		Host:                   data.Host,
+	}
+	state.setEndpoints(r.ClientFactory)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -150,18 +259,34 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	clientFactory := clientFactoryForHost(r.ClientFactory, data.Host)
+	ctx = withRequestAttributes(ctx, clientFactory, data.OrganizationID, data.ID)
 
-	organizationClient := r.ClientFactory.NewOrganizationClient(data.OrganizationPublicKey.ValueString(), data.OrganizationPrivateKey.ValueString())
+	organizationClient, diags := newOrganizationClient(clientFactory, data.OrganizationPublicKey, data.OrganizationPrivateKey, data.CredentialRef)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	project, err := organizationClient.GetProject(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Error reading project", err.Error())
+		addOrganizationClientError(ctx, &resp.Diagnostics, clientFactory, "Error reading project", data.OrganizationPublicKey, data.OrganizationID.ValueString(), err)
 		return
 	}
 
+	declared, diags := metadataElements(ctx, data.Metadata)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	remoteMetadata := stripManagedByMetadata(project.Metadata, declared)
+	if data.ManagedMetadataOnly.ValueBool() {
+		remoteMetadata = filterManagedMetadata(remoteMetadata, declared)
+	}
+
 	var metadataMap types.Map
-	if len(project.Metadata) > 0 {
+	if len(remoteMetadata) > 0 {
 		var diags diag.Diagnostics
-		metadataMap, diags = types.MapValueFrom(ctx, types.StringType, project.Metadata)
+		metadataMap, diags = types.MapValueFrom(ctx, types.StringType, remoteMetadata)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
@@ -170,25 +295,33 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 		metadataMap = types.MapNull(types.StringType)
 	}
 
-	// Note: retention_days is write-only in the Langfuse API and not returned in responses.
-	resp.Diagnostics.Append(resp.State.Set(ctx, &projectResourceModel{
+	state := projectResourceModel{
 		ID:                     types.StringValue(project.ID),
 		Name:                   types.StringValue(project.Name),
-		RetentionDays:          data.RetentionDays,
+		RetentionDays:          retentionDaysValue(project.RetentionDays, data.RetentionDays),
 		Metadata:               metadataMap,
 		OrganizationID:         types.StringValue(data.OrganizationID.ValueString()),
-		OrganizationPublicKey:  types.StringValue(data.OrganizationPublicKey.ValueString()),
-		OrganizationPrivateKey: types.StringValue(data.OrganizationPrivateKey.ValueString()),
-	})...)
+		OrganizationName:       organizationName(ctx, clientFactory, data.OrganizationID.ValueString()),
+		OrganizationPublicKey:  data.OrganizationPublicKey,
+		OrganizationPrivateKey: data.OrganizationPrivateKey,
+		CredentialRef:          data.CredentialRef,
+		ManagedMetadataOnly:    data.ManagedMetadataOnly,
+		Host:                   data.Host,
+	}
+	state.setEndpoints(r.ClientFactory)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer addRunWarnings(&resp.Diagnostics, r.ClientFactory)
+
 	var data projectResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	clientFactory := clientFactoryForHost(r.ClientFactory, data.Host)
 
 	// Get ID from current state (ID is not in config during updates)
 	var currentState projectResourceModel
@@ -196,9 +329,23 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	ctx = withRequestAttributes(ctx, clientFactory, currentState.OrganizationID, currentState.ID)
 
 	projectID := currentState.ID.ValueString()
 
+	// The organization credentials are connection settings. When nothing else changed, e.g. after
+	// the organization API key was rotated, only the new credentials are stored; the project
+	// itself is left alone.
+	if data.Name.Equal(currentState.Name) && data.RetentionDays.Equal(currentState.RetentionDays) && data.Metadata.Equal(currentState.Metadata) &&
+		data.ManagedMetadataOnly.Equal(currentState.ManagedMetadataOnly) {
+		currentState.OrganizationPublicKey = data.OrganizationPublicKey
+		currentState.OrganizationPrivateKey = data.OrganizationPrivateKey
+		currentState.CredentialRef = data.CredentialRef
+		currentState.setEndpoints(r.ClientFactory)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &currentState)...)
+		return
+	}
+
 	metadata := make(map[string]string)
 	if !data.Metadata.IsNull() && !data.Metadata.IsUnknown() {
 		resp.Diagnostics.Append(data.Metadata.ElementsAs(ctx, &metadata, false)...)
@@ -207,24 +354,64 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 		}
 	}
 
-	organizationClient := r.ClientFactory.NewOrganizationClient(data.OrganizationPublicKey.ValueString(), data.OrganizationPrivateKey.ValueString())
+	organizationClient, diags := newOrganizationClient(clientFactory, data.OrganizationPublicKey, data.OrganizationPrivateKey, data.CredentialRef)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
+	metadataChanged := !data.Metadata.Equal(currentState.Metadata) || !data.ManagedMetadataOnly.Equal(currentState.ManagedMetadataOnly)
+	requestMetadata := stampManagedByMetadata(clientFactory, metadata)
+	if metadataChanged && data.ManagedMetadataOnly.ValueBool() {
+		// Preserve the keys this resource does not manage. Keys are only removed when they were
+		// managed before, i.e. declared while managed_metadata_only was already set.
+		current, err := organizationClient.GetProject(ctx, projectID)
+		if err != nil {
+			addClientError(&resp.Diagnostics, "Error reading project", err)
+			return
+		}
+		previous := make(map[string]string)
+		if currentState.ManagedMetadataOnly.ValueBool() {
+			previous, diags = metadataElements(ctx, currentState.Metadata)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+		requestMetadata = mergeManagedMetadata(current.Metadata, previous, requestMetadata)
+	}
+
+	// Only the changed fields are sent, so that e.g. a metadata change does not reset a retention
+	// set outside this resource. Langfuse requires the name on every update.
 	request := &langfuse.UpdateProjectRequest{
-		Name:          data.Name.ValueString(),
-		RetentionDays: data.RetentionDays.ValueInt32(),
-		Metadata:      metadata,
+		Name: data.Name.ValueString(),
+	}
+	if !data.RetentionDays.Equal(currentState.RetentionDays) {
+		request.RetentionDays = data.RetentionDays.ValueInt32Pointer()
+		if request.RetentionDays == nil {
+			// Unsetting retention_days stores data indefinitely again.
+			request.RetentionDays = new(int32)
+		}
+	}
+	if metadataChanged {
+		request.Metadata = requestMetadata
 	}
 
 	project, err := organizationClient.UpdateProject(ctx, projectID, request)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating project", err.Error())
+		addClientFieldErrors(&resp.Diagnostics, "Error updating project", err, projectRequestFields)
 		return
 	}
 
+	remoteMetadata := stripManagedByMetadata(project.Metadata, metadata)
+	if data.ManagedMetadataOnly.ValueBool() {
+		remoteMetadata = filterManagedMetadata(remoteMetadata, metadata)
+	}
+
 	var metadataMap types.Map
-	if len(project.Metadata) > 0 {
+	if len(remoteMetadata) > 0 {
 		var diags diag.Diagnostics
-		metadataMap, diags = types.MapValueFrom(ctx, types.StringType, project.Metadata)
+		metadataMap, diags = types.MapValueFrom(ctx, types.StringType, remoteMetadata)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
@@ -233,29 +420,43 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 		metadataMap = types.MapNull(types.StringType)
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &projectResourceModel{
+	state := projectResourceModel{
 		ID:                     types.StringValue(project.ID),
 		Name:                   types.StringValue(project.Name),
 		RetentionDays:          data.RetentionDays, // Use from config, not API response
 		Metadata:               metadataMap,
 		OrganizationID:         types.StringValue(data.OrganizationID.ValueString()),
-		OrganizationPublicKey:  types.StringValue(data.OrganizationPublicKey.ValueString()),
-		OrganizationPrivateKey: types.StringValue(data.OrganizationPrivateKey.ValueString()),
-	})...)
+		OrganizationName:       organizationName(ctx, clientFactory, data.OrganizationID.ValueString()),
+		OrganizationPublicKey:  data.OrganizationPublicKey,
+		OrganizationPrivateKey: data.OrganizationPrivateKey,
+		CredentialRef:          data.CredentialRef,
+		ManagedMetadataOnly:    data.ManagedMetadataOnly,
+		Host:                   data.Host,
+	}
+	state.setEndpoints(r.ClientFactory)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer addRunWarnings(&resp.Diagnostics, r.ClientFactory)
+
 	var data projectResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	clientFactory := clientFactoryForHost(r.ClientFactory, data.Host)
+	ctx = withRequestAttributes(ctx, clientFactory, data.OrganizationID, data.ID)
 
-	organizationClient := r.ClientFactory.NewOrganizationClient(data.OrganizationPublicKey.ValueString(), data.OrganizationPrivateKey.ValueString())
+	organizationClient, diags := newOrganizationClient(clientFactory, data.OrganizationPublicKey, data.OrganizationPrivateKey, data.CredentialRef)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	err := organizationClient.DeleteProject(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Error deleting project", err.Error())
+		addClientError(&resp.Diagnostics, "Error deleting project", err)
 		return
 	}
 
@@ -265,29 +466,51 @@ func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest
 		RetentionDays:          types.Int32Value(0),
 		Metadata:               types.MapNull(types.StringType),
 		OrganizationID:         types.StringValue(""),
+		OrganizationName:       types.StringNull(),
 		OrganizationPublicKey:  types.StringValue(""),
 		OrganizationPrivateKey: types.StringValue(""),
+		CredentialRef:          types.StringValue(""),
+		ManagedMetadataOnly:    types.BoolNull(),
+		Host:                   types.StringNull(),
 	})...)
 }
 
 func (r *projectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(checkInstanceVersion(ctx, r.ClientFactory, "langfuse_project")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Import format: project_id,organization_id,organization_public_key,organization_private_key
 	// Example: terraform import langfuse_project.example "proj_123,org_456,pk_789,sk_012"
+	// Alternatively: project_id,organization_id,credential_ref
+	// Example: terraform import langfuse_project.example "proj_123,org_456,prod-org"
 
 	importParts := strings.Split(req.ID, ",")
-	if len(importParts) != 4 {
+	if len(importParts) != 3 && len(importParts) != 4 {
 		resp.Diagnostics.AddError("Invalid import format",
-			"Import ID must be in format: project_id,organization_id,organization_public_key,organization_private_key")
+			"Import ID must be in format: project_id,organization_id,organization_public_key,organization_private_key or project_id,organization_id,credential_ref")
 		return
 	}
 
 	projectID := importParts[0]
 	organizationID := importParts[1]
-	organizationPublicKey := importParts[2]
-	organizationPrivateKey := importParts[3]
+	organizationPublicKey := types.StringNull()
+	organizationPrivateKey := types.StringNull()
+	credentialRef := types.StringNull()
+	if len(importParts) == 4 {
+		organizationPublicKey = types.StringValue(importParts[2])
+		organizationPrivateKey = types.StringValue(importParts[3])
+	} else {
+		credentialRef = types.StringValue(importParts[2])
+	}
 
 	// Get the project details using the provided organization credentials
-	organizationClient := r.ClientFactory.NewOrganizationClient(organizationPublicKey, organizationPrivateKey)
+	organizationClient, diags := newOrganizationClient(r.ClientFactory, organizationPublicKey, organizationPrivateKey, credentialRef)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	project, err := organizationClient.GetProject(ctx, projectID)
 	if err != nil {
 		resp.Diagnostics.AddError("Error importing project",
@@ -297,9 +520,9 @@ func (r *projectResource) ImportState(ctx context.Context, req resource.ImportSt
 
 	// Convert metadata to the appropriate type
 	var metadataMap types.Map
-	if len(project.Metadata) > 0 {
+	if remoteMetadata := stripManagedByMetadata(project.Metadata, nil); len(remoteMetadata) > 0 {
 		var diags diag.Diagnostics
-		metadataMap, diags = types.MapValueFrom(ctx, types.StringType, project.Metadata)
+		metadataMap, diags = types.MapValueFrom(ctx, types.StringType, remoteMetadata)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
@@ -308,17 +531,35 @@ func (r *projectResource) ImportState(ctx context.Context, req resource.ImportSt
 		metadataMap = types.MapNull(types.StringType)
 	}
 
-	// Set the imported state with all required information
-	resp.Diagnostics.Append(resp.State.Set(ctx, &projectResourceModel{
+	// Set the imported state with all required information. The retention is null on instances that
+	// do not report it, rather than a guess.
+	state := projectResourceModel{
 		ID:                     types.StringValue(project.ID),
 		Name:                   types.StringValue(project.Name),
-		RetentionDays:          types.Int32Value(0), // Default value since retention_days is write-only in Langfuse API
+		RetentionDays:          retentionDaysValue(project.RetentionDays, types.Int32Null()),
 		Metadata:               metadataMap,
 		OrganizationID:         types.StringValue(organizationID),
-		OrganizationPublicKey:  types.StringValue(organizationPublicKey),
-		OrganizationPrivateKey: types.StringValue(organizationPrivateKey),
-	})...)
+		OrganizationName:       organizationName(ctx, r.ClientFactory, organizationID),
+		OrganizationPublicKey:  organizationPublicKey,
+		OrganizationPrivateKey: organizationPrivateKey,
+		CredentialRef:          credentialRef,
+		ManagedMetadataOnly:    types.BoolNull(),
+		Host:                   types.StringNull(),
+	}
+	state.setEndpoints(r.ClientFactory)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 
 	// Set the ID attribute explicitly to just the project ID (not the full import string)
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), resource.ImportStateRequest{ID: projectID}, resp)
 }
+
+// retentionDaysValue returns the retention_days to store for the retention reported by Langfuse.
+// Many instances only report the retention when a project is created or updated, so without a
+// reported value the known one is kept; a reported 0 keeps a null known value, as both mean that
+// data is stored indefinitely.
+func retentionDaysValue(reported *int32, known types.Int32) types.Int32 {
+	if reported == nil || (*reported == 0 && known.ValueInt32() == 0) {
+		return known
+	}
+	return types.Int32Value(*reported)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
 	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse/mocks"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	resschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -88,6 +89,7 @@ func TestProjectResourceCRUD(t *testing.T) {
 	}
 
 	clientFactory := mocks.NewMockClientFactory(ctrl)
+	clientFactory.AdminClient.EXPECT().GetOrganization(ctx, "org-123").Return(&langfuse.Organization{ID: "org-123", Name: "Acme Inc"}, nil).AnyTimes()
 
 	var resourceSchema resschema.Schema
 	t.Run("Configure", func(t *testing.T) {
@@ -124,10 +126,9 @@ func TestProjectResourceCRUD(t *testing.T) {
 			Metadata:      createMetadata,
 		}
 		clientFactory.OrganizationClient.EXPECT().CreateProject(ctx, expectedProject).Return(&langfuse.Project{
-			ID:            projectID,
-			Name:          createName,
-			RetentionDays: 0,
-			Metadata:      createMetadata,
+			ID:       projectID,
+			Name:     createName,
+			Metadata: createMetadata,
 		}, nil)
 
 		metadataValue := tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
@@ -144,6 +145,7 @@ func TestProjectResourceCRUD(t *testing.T) {
 				"organization_id":          tftypes.NewValue(tftypes.String, organizationID),
 				"organization_public_key":  tftypes.NewValue(tftypes.String, publicKey),
 				"organization_private_key": tftypes.NewValue(tftypes.String, privateKey),
+				"credential_ref":           tftypes.NewValue(tftypes.String, nil),
 			}),
 			Schema: resourceSchema,
 		}
@@ -152,15 +154,20 @@ func TestProjectResourceCRUD(t *testing.T) {
 		if createResp.Diagnostics.HasError() {
 			t.Fatalf("unexpected diagnostics from Create: %v", createResp.Diagnostics)
 		}
+
+		var orgName string
+		createResp.State.GetAttribute(ctx, path.Root("organization_name"), &orgName)
+		if orgName != "Acme Inc" {
+			t.Errorf("unexpected organization_name. got %q, want %q", orgName, "Acme Inc")
+		}
 	})
 
 	var readResp resource.ReadResponse
 	t.Run("Read", func(t *testing.T) {
 		clientFactory.OrganizationClient.EXPECT().GetProject(ctx, "proj-123").Return(&langfuse.Project{
-			ID:            "proj-123",
-			Name:          createName,
-			RetentionDays: 0,
-			Metadata:      createMetadata,
+			ID:       "proj-123",
+			Name:     createName,
+			Metadata: createMetadata,
 		}, nil)
 
 		readResp.State.Schema = resourceSchema
@@ -177,12 +184,12 @@ func TestProjectResourceCRUD(t *testing.T) {
 		newMetadata := map[string]string{"environment": "production", "team": "ai", "version": "2.0"}
 		clientFactory.OrganizationClient.EXPECT().UpdateProject(ctx, "proj-123", &langfuse.UpdateProjectRequest{
 			Name:          newName,
-			RetentionDays: newRetention,
+			RetentionDays: &newRetention,
 			Metadata:      newMetadata,
 		}).Return(&langfuse.Project{
 			ID:            "proj-123",
 			Name:          newName,
-			RetentionDays: newRetention,
+			RetentionDays: &newRetention,
 			Metadata:      newMetadata,
 		}, nil)
 
@@ -201,6 +208,7 @@ func TestProjectResourceCRUD(t *testing.T) {
 				"organization_id":          tftypes.NewValue(tftypes.String, organizationID),
 				"organization_public_key":  tftypes.NewValue(tftypes.String, publicKey),
 				"organization_private_key": tftypes.NewValue(tftypes.String, privateKey),
+				"credential_ref":           tftypes.NewValue(tftypes.String, nil),
 			}),
 			Schema: resourceSchema,
 		}
@@ -228,12 +236,13 @@ func TestProjectResourceCRUD(t *testing.T) {
 		r := &projectResource{}
 
 		clientFactory := mocks.NewMockClientFactory(ctrl)
+		clientFactory.NoAdminAPIKey = true
 
 		clientFactory.OrganizationClient.EXPECT().GetProject(ctx, "proj-123").Return(&langfuse.Project{
-			ID:            "proj-123",
-			Name:          "test-project",
-			RetentionDays: 0, // API returns 0 (doesn't return actual value)
-			Metadata:      map[string]string{"test": "value"},
+			ID:   "proj-123",
+			Name: "test-project",
+			// The project listing does not report retention_days.
+			Metadata: map[string]string{"test": "value"},
 		}, nil)
 
 		r.ClientFactory = clientFactory
@@ -250,6 +259,7 @@ func TestProjectResourceCRUD(t *testing.T) {
 			"organization_id":          tftypes.NewValue(tftypes.String, organizationID),
 			"organization_public_key":  tftypes.NewValue(tftypes.String, "pub-key"),
 			"organization_private_key": tftypes.NewValue(tftypes.String, "priv-key"),
+			"credential_ref":           tftypes.NewValue(tftypes.String, nil),
 		})
 
 		var readResp resource.ReadResponse
@@ -270,6 +280,106 @@ func TestProjectResourceCRUD(t *testing.T) {
 			t.Errorf("expected retention_days to be preserved as 30, got %d", stateData.RetentionDays.ValueInt32())
 		}
 	})
+
+	t.Run("Update with managed_metadata_only preserves undeclared keys", func(t *testing.T) {
+		ctx := context.Background()
+		clientFactory := mocks.NewMockClientFactory(ctrl)
+		clientFactory.NoAdminAPIKey = true
+		r := &projectResource{ClientFactory: clientFactory}
+
+		metadataValue := func(entries map[string]string) tftypes.Value {
+			values := make(map[string]tftypes.Value)
+			for key, value := range entries {
+				values[key] = tftypes.NewValue(tftypes.String, value)
+			}
+			return tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, values)
+		}
+		projectValue := func(metadata map[string]string) tftypes.Value {
+			return buildProjectObjectValue(map[string]tftypes.Value{
+				"id":                       tftypes.NewValue(tftypes.String, "proj-123"),
+				"name":                     tftypes.NewValue(tftypes.String, "test-project"),
+				"metadata":                 metadataValue(metadata),
+				"organization_id":          tftypes.NewValue(tftypes.String, organizationID),
+				"organization_public_key":  tftypes.NewValue(tftypes.String, publicKey),
+				"organization_private_key": tftypes.NewValue(tftypes.String, privateKey),
+				"managed_metadata_only":    tftypes.NewValue(tftypes.Bool, true),
+			})
+		}
+
+		clientFactory.OrganizationClient.EXPECT().GetProject(ctx, "proj-123").Return(&langfuse.Project{
+			ID:       "proj-123",
+			Name:     "test-project",
+			Metadata: map[string]string{"team": "ai", "owner": "jane", "pipeline_run": "42"},
+		}, nil)
+		updated := map[string]string{"team": "ml", "pipeline_run": "42"}
+		clientFactory.OrganizationClient.EXPECT().UpdateProject(ctx, "proj-123", &langfuse.UpdateProjectRequest{
+			Name:     "test-project",
+			Metadata: updated,
+		}).Return(&langfuse.Project{ID: "proj-123", Name: "test-project", Metadata: updated}, nil)
+
+		var updateResp resource.UpdateResponse
+		updateResp.State.Schema = resourceSchema
+		r.Update(ctx, resource.UpdateRequest{
+			Config: tfsdk.Config{Raw: projectValue(map[string]string{"team": "ml"}), Schema: resourceSchema},
+			State:  tfsdk.State{Raw: projectValue(map[string]string{"team": "ai", "owner": "jane"}), Schema: resourceSchema},
+		}, &updateResp)
+		if updateResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics from Update: %v", updateResp.Diagnostics)
+		}
+
+		var stateData projectResourceModel
+		updateResp.State.Get(ctx, &stateData)
+		metadata, _ := metadataElements(ctx, stateData.Metadata)
+		if len(metadata) != 1 || metadata["team"] != "ml" {
+			t.Errorf("expected only the declared metadata in state, got %v", metadata)
+		}
+	})
+
+	// Rotating the organization key must neither replace the project nor call the API.
+	t.Run("Update with rotated credentials only", func(t *testing.T) {
+		ctx := context.Background()
+		r := &projectResource{ClientFactory: mocks.NewMockClientFactory(ctrl)}
+
+		projectValue := func(publicKey, privateKey string) tftypes.Value {
+			return buildProjectObjectValue(map[string]tftypes.Value{
+				"id":                       tftypes.NewValue(tftypes.String, "proj-123"),
+				"name":                     tftypes.NewValue(tftypes.String, "test-project"),
+				"retention_days":           tftypes.NewValue(tftypes.Number, big.NewFloat(30)),
+				"metadata":                 tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+				"organization_id":          tftypes.NewValue(tftypes.String, organizationID),
+				"organization_public_key":  tftypes.NewValue(tftypes.String, publicKey),
+				"organization_private_key": tftypes.NewValue(tftypes.String, privateKey),
+				"credential_ref":           tftypes.NewValue(tftypes.String, nil),
+			})
+		}
+
+		var updateResp resource.UpdateResponse
+		updateResp.State.Schema = resourceSchema
+		r.Update(ctx, resource.UpdateRequest{
+			Config: tfsdk.Config{Raw: projectValue("pk-new", "sk-new"), Schema: resourceSchema},
+			State:  tfsdk.State{Raw: projectValue("pk-old", "sk-old"), Schema: resourceSchema},
+		}, &updateResp)
+		if updateResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics from Update: %v", updateResp.Diagnostics)
+		}
+
+		var stateData projectResourceModel
+		updateResp.State.Get(ctx, &stateData)
+		if stateData.OrganizationPublicKey.ValueString() != "pk-new" || stateData.OrganizationPrivateKey.ValueString() != "sk-new" {
+			t.Errorf("expected the rotated credentials in state, got %s", stateData.OrganizationPublicKey)
+		}
+		if stateData.ID.ValueString() != "proj-123" {
+			t.Errorf("unexpected project ID %q", stateData.ID.ValueString())
+		}
+
+		var schemaResp resource.SchemaResponse
+		r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+		for _, attribute := range []string{"organization_public_key", "organization_private_key"} {
+			if modifiers := schemaResp.Schema.Attributes[attribute].(resschema.StringAttribute).PlanModifiers; len(modifiers) != 0 {
+				t.Errorf("%s must not have plan modifiers, got %d", attribute, len(modifiers))
+			}
+		}
+	})
 }
 
 func TestProjectResourceImport(t *testing.T) {
@@ -286,6 +396,7 @@ func TestProjectResourceImport(t *testing.T) {
 	}
 
 	clientFactory := mocks.NewMockClientFactory(ctrl)
+	clientFactory.NoAdminAPIKey = true
 
 	// Configure the resource
 	var configureResp resource.ConfigureResponse
@@ -311,10 +422,10 @@ func TestProjectResourceImport(t *testing.T) {
 
 	// Mock the organization client and its GetProject method
 	clientFactory.OrganizationClient.EXPECT().GetProject(ctx, projectID).Return(&langfuse.Project{
-		ID:            projectID,
-		Name:          projectName,
-		RetentionDays: 0, // API doesn't return retention_days
-		Metadata:      projectMetadata,
+		ID:   projectID,
+		Name: projectName,
+		// The project listing does not report retention_days.
+		Metadata: projectMetadata,
 	}, nil)
 
 	// Test successful import
@@ -349,8 +460,8 @@ func TestProjectResourceImport(t *testing.T) {
 		if stateData.OrganizationPrivateKey.ValueString() != privateKey {
 			t.Errorf("expected OrganizationPrivateKey %q, got %q", privateKey, stateData.OrganizationPrivateKey.ValueString())
 		}
-		if stateData.RetentionDays.ValueInt32() != 0 {
-			t.Errorf("expected RetentionDays 0 (default value since Langfuse API doesn't return retention_days), got %d", stateData.RetentionDays.ValueInt32())
+		if !stateData.RetentionDays.IsNull() {
+			t.Errorf("expected null RetentionDays since the project listing does not report it, got %d", stateData.RetentionDays.ValueInt32())
 		}
 
 		// Verify metadata
@@ -371,6 +482,35 @@ func TestProjectResourceImport(t *testing.T) {
 		}
 	})
 
+	// Test import from an instance that reports retention_days
+	t.Run("Import with reported retention_days", func(t *testing.T) {
+		retention := int32(30)
+		clientFactory.OrganizationClient.EXPECT().GetProject(ctx, projectID).Return(&langfuse.Project{ID: projectID, Name: projectName, RetentionDays: &retention}, nil)
+
+		var importResp resource.ImportStateResponse
+		importResp.State.Schema = schemaResp.Schema
+		r.ImportState(ctx, resource.ImportStateRequest{ID: projectID + "," + organizationID + "," + publicKey + "," + privateKey}, &importResp)
+		if importResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics from ImportState: %v", importResp.Diagnostics)
+		}
+
+		var stateData projectResourceModel
+		importResp.State.Get(ctx, &stateData)
+		if stateData.RetentionDays.ValueInt32() != 30 {
+			t.Errorf("expected RetentionDays 30, got %s", stateData.RetentionDays)
+		}
+	})
+
+	// A retention in the import ID is no longer accepted
+	t.Run("Import with retention_days in the ID", func(t *testing.T) {
+		var importResp resource.ImportStateResponse
+		importResp.State.Schema = schemaResp.Schema
+		r.ImportState(ctx, resource.ImportStateRequest{ID: projectID + "," + organizationID + "," + publicKey + "," + privateKey + ",30"}, &importResp)
+		if !importResp.Diagnostics.HasError() {
+			t.Error("expected an error for an import ID with five parts")
+		}
+	})
+
 	// Test invalid import format
 	t.Run("Invalid import format", func(t *testing.T) {
 		invalidImportID := "just-project-id" // Missing other required parts
@@ -428,26 +568,259 @@ func TestProjectResourceImport(t *testing.T) {
 }
 
 func buildProjectObjectValue(values map[string]tftypes.Value) tftypes.Value {
-	return tftypes.NewValue(
-		tftypes.Object{
-			AttributeTypes: map[string]tftypes.Type{
-				"id":                       tftypes.String,
-				"name":                     tftypes.String,
-				"retention_days":           tftypes.Number,
-				"metadata":                 tftypes.Map{ElementType: tftypes.String},
-				"organization_id":          tftypes.String,
-				"organization_public_key":  tftypes.String,
-				"organization_private_key": tftypes.String,
-			},
-			OptionalAttributes: map[string]struct{}{
-				"id":                       {},
-				"retention_days":           {},
-				"metadata":                 {},
-				"organization_id":          {},
-				"organization_public_key":  {},
-				"organization_private_key": {},
-			},
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"id":                       tftypes.String,
+			"name":                     tftypes.String,
+			"retention_days":           tftypes.Number,
+			"metadata":                 tftypes.Map{ElementType: tftypes.String},
+			"organization_id":          tftypes.String,
+			"organization_name":        tftypes.String,
+			"organization_public_key":  tftypes.String,
+			"organization_private_key": tftypes.String,
+			"credential_ref":           tftypes.String,
+			"managed_metadata_only":    tftypes.Bool,
+			"host":                     tftypes.String,
+			"ingestion_endpoint":       tftypes.String,
+			"otlp_endpoint":            tftypes.String,
 		},
-		values,
-	)
+	}
+	for name, attributeType := range objectType.AttributeTypes {
+		if _, ok := values[name]; !ok {
+			values[name] = tftypes.NewValue(attributeType, nil)
+		}
+	}
+	return tftypes.NewValue(objectType, values)
+}
+
+func TestProjectResourceHostOverride(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	host := "https://langfuse-new.example.com"
+
+	r := NewProjectResource().(*projectResource)
+	clientFactory := mocks.NewMockClientFactory(ctrl)
+	clientFactory.BaseURL = "https://langfuse-old.example.com"
+	r.Configure(ctx, resource.ConfigureRequest{ProviderData: clientFactory}, &resource.ConfigureResponse{})
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	t.Run("invalid host", func(t *testing.T) {
+		var validateResp resource.ValidateConfigResponse
+		r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw: buildProjectObjectValue(map[string]tftypes.Value{
+				"name":            tftypes.NewValue(tftypes.String, "checkout"),
+				"organization_id": tftypes.NewValue(tftypes.String, "org-123"),
+				"host":            tftypes.NewValue(tftypes.String, "langfuse-new.example.com"),
+			}),
+		}}, &validateResp)
+		if !validateResp.Diagnostics.HasError() || validateResp.Diagnostics.Errors()[0].Summary() != "Invalid host" {
+			t.Fatalf("expected an invalid host error, got: %v", validateResp.Diagnostics)
+		}
+	})
+
+	t.Run("create and read on the other host", func(t *testing.T) {
+		clientFactory.OrganizationClient.EXPECT().CreateProject(ctx, gomock.Any()).Return(&langfuse.Project{ID: "proj-123", Name: "checkout"}, nil)
+		clientFactory.OrganizationClient.EXPECT().GetProject(ctx, "proj-123").Return(&langfuse.Project{ID: "proj-123", Name: "checkout"}, nil)
+
+		createResp := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+		r.Create(ctx, resource.CreateRequest{Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw: buildProjectObjectValue(map[string]tftypes.Value{
+				"name":                     tftypes.NewValue(tftypes.String, "checkout"),
+				"organization_id":          tftypes.NewValue(tftypes.String, "org-123"),
+				"organization_public_key":  tftypes.NewValue(tftypes.String, "pk-new"),
+				"organization_private_key": tftypes.NewValue(tftypes.String, "sk-new"),
+				"host":                     tftypes.NewValue(tftypes.String, host),
+			}),
+		}}, &createResp)
+		if createResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics from Create: %v", createResp.Diagnostics)
+		}
+
+		readResp := resource.ReadResponse{State: createResp.State}
+		r.Read(ctx, resource.ReadRequest{State: createResp.State}, &readResp)
+		if readResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics from Read: %v", readResp.Diagnostics)
+		}
+
+		var data projectResourceModel
+		readResp.Diagnostics.Append(readResp.State.Get(ctx, &data)...)
+		if data.Host.ValueString() != host {
+			t.Errorf("unexpected host %q in state, want %q", data.Host.ValueString(), host)
+		}
+		if data.IngestionEndpoint.ValueString() != host+"/api/public/ingestion" || data.OtlpEndpoint.ValueString() != host+"/api/public/otel" {
+			t.Errorf("unexpected endpoints %q and %q, want them on %s", data.IngestionEndpoint.ValueString(), data.OtlpEndpoint.ValueString(), host)
+		}
+		if !data.OrganizationName.IsNull() {
+			t.Errorf("expected no organization name without an admin API key on the other host, got %q", data.OrganizationName.ValueString())
+		}
+		if len(clientFactory.Hosts) != 2 || clientFactory.Hosts[0] != host || clientFactory.Hosts[1] != host {
+			t.Errorf("expected Create and Read to use %s, got %v", host, clientFactory.Hosts)
+		}
+	})
+}
+
+func TestProjectResourcePlansEndpoints(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	r := NewProjectResource().(*projectResource)
+	clientFactory := mocks.NewMockClientFactory(ctrl)
+	clientFactory.BaseURL = "https://eu.example.com/"
+	r.Configure(ctx, resource.ConfigureRequest{ProviderData: clientFactory}, &resource.ConfigureResponse{})
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	plan := tfsdk.Plan{
+		Schema: schemaResp.Schema,
+		Raw: buildProjectObjectValue(map[string]tftypes.Value{
+			"id":                 tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"name":               tftypes.NewValue(tftypes.String, "checkout"),
+			"ingestion_endpoint": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"otlp_endpoint":      tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		}),
+	}
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	resp := resource.ModifyPlanResponse{Plan: plan}
+	r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan, State: state}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics from ModifyPlan: %v", resp.Diagnostics)
+	}
+
+	var planned projectResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &planned)...)
+	if planned.IngestionEndpoint.ValueString() != "https://eu.example.com/api/public/ingestion" {
+		t.Errorf("unexpected ingestion_endpoint %s", planned.IngestionEndpoint)
+	}
+	if planned.OtlpEndpoint.ValueString() != "https://eu.example.com/api/public/otel" {
+		t.Errorf("unexpected otlp_endpoint %s", planned.OtlpEndpoint)
+	}
+}
+
+func TestProjectResourceManagedByMetadata(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	r := NewProjectResource().(*projectResource)
+	clientFactory := mocks.NewMockClientFactory(ctrl)
+	clientFactory.NoAdminAPIKey = true
+	clientFactory.ManagedBy = managedByMetadata(map[string]string{"workspace": "prod"}, "run-123")
+	clientFactory.DefaultCredentials = &langfuse.OrganizationCredentials{PublicKey: "pk-org", PrivateKey: "sk-org"}
+	r.Configure(ctx, resource.ConfigureRequest{ProviderData: clientFactory}, &resource.ConfigureResponse{})
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	stamped := map[string]string{"team": "ai", "managed-by": "terraform", "managed-by-workspace": "prod", "managed-by-run-id": "run-123"}
+	clientFactory.OrganizationClient.EXPECT().CreateProject(ctx, &langfuse.CreateProjectRequest{Name: "checkout", Metadata: stamped}).
+		Return(&langfuse.Project{ID: "proj-123", Name: "checkout", Metadata: stamped}, nil)
+
+	createResp := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Create(ctx, resource.CreateRequest{Config: tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw: buildProjectObjectValue(map[string]tftypes.Value{
+			"name":            tftypes.NewValue(tftypes.String, "checkout"),
+			"organization_id": tftypes.NewValue(tftypes.String, "org-123"),
+			"metadata": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+				"team": tftypes.NewValue(tftypes.String, "ai"),
+			}),
+		}),
+	}}, &createResp)
+	if createResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics from Create: %v", createResp.Diagnostics)
+	}
+
+	// The stamp is not drift, also once managed_by_metadata has been removed from the provider.
+	clientFactory.ManagedBy = nil
+	clientFactory.OrganizationClient.EXPECT().GetProject(ctx, "proj-123").
+		Return(&langfuse.Project{ID: "proj-123", Name: "checkout", Metadata: stamped}, nil)
+
+	readResp := resource.ReadResponse{State: createResp.State}
+	r.Read(ctx, resource.ReadRequest{State: createResp.State}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics from Read: %v", readResp.Diagnostics)
+	}
+
+	for name, state := range map[string]tfsdk.State{"create": createResp.State, "read": readResp.State} {
+		var metadata map[string]string
+		if diags := state.GetAttribute(ctx, path.Root("metadata"), &metadata); diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if len(metadata) != 1 || metadata["team"] != "ai" {
+			t.Errorf("expected only the declared metadata after %s, got %v", name, metadata)
+		}
+	}
+}
+
+func TestProjectResourcePartialUpdate(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	r := NewProjectResource().(*projectResource)
+	clientFactory := mocks.NewMockClientFactory(ctrl)
+	clientFactory.NoAdminAPIKey = true
+	clientFactory.DefaultCredentials = &langfuse.OrganizationCredentials{PublicKey: "pk-org", PrivateKey: "sk-org"}
+	r.Configure(ctx, resource.ConfigureRequest{ProviderData: clientFactory}, &resource.ConfigureResponse{})
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	projectValue := func(name, team string) tftypes.Value {
+		return buildProjectObjectValue(map[string]tftypes.Value{
+			"id":              tftypes.NewValue(tftypes.String, "proj-123"),
+			"name":            tftypes.NewValue(tftypes.String, name),
+			"organization_id": tftypes.NewValue(tftypes.String, "org-123"),
+			"metadata": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+				"team": tftypes.NewValue(tftypes.String, team),
+			}),
+		})
+	}
+	update := func(t *testing.T, config, state tftypes.Value) {
+		t.Helper()
+		updateResp := resource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+		r.Update(ctx, resource.UpdateRequest{
+			Config: tfsdk.Config{Raw: config, Schema: schemaResp.Schema},
+			State:  tfsdk.State{Raw: state, Schema: schemaResp.Schema},
+		}, &updateResp)
+		if updateResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics from Update: %v", updateResp.Diagnostics)
+		}
+	}
+
+	// retention_days is not configured, e.g. because langfuse_organization_retention_policy sets it,
+	// so neither update may send a retention.
+	t.Run("metadata only", func(t *testing.T) {
+		clientFactory.OrganizationClient.EXPECT().UpdateProject(ctx, "proj-123", &langfuse.UpdateProjectRequest{
+			Name:     "checkout",
+			Metadata: map[string]string{"team": "ml"},
+		}).Return(&langfuse.Project{ID: "proj-123", Name: "checkout", Metadata: map[string]string{"team": "ml"}}, nil)
+		update(t, projectValue("checkout", "ml"), projectValue("checkout", "ai"))
+	})
+
+	t.Run("rename only", func(t *testing.T) {
+		clientFactory.OrganizationClient.EXPECT().UpdateProject(ctx, "proj-123", &langfuse.UpdateProjectRequest{
+			Name: "checkout-v2",
+		}).Return(&langfuse.Project{ID: "proj-123", Name: "checkout-v2", Metadata: map[string]string{"team": "ai"}}, nil)
+		update(t, projectValue("checkout-v2", "ai"), projectValue("checkout", "ai"))
+	})
 }
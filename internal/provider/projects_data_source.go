@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+)
+
+var _ datasource.DataSource = &projectsDataSource{}
+
+func NewProjectsDataSource() datasource.DataSource {
+	return &projectsDataSource{}
+}
+
+type projectsDataSourceModel struct {
+	Selector               types.String         `tfsdk:"selector"`
+	OrganizationPublicKey  types.String         `tfsdk:"organization_public_key"`
+	OrganizationPrivateKey types.String         `tfsdk:"organization_private_key"`
+	CredentialRef          types.String         `tfsdk:"credential_ref"`
+	Projects               []projectsEntryModel `tfsdk:"projects"`
+	IDs                    types.List           `tfsdk:"ids"`
+}
+
+type projectsEntryModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Metadata      types.Map    `tfsdk:"metadata"`
+	RetentionDays types.Int64  `tfsdk:"retention_days"`
+}
+
+type projectsDataSource struct {
+	ClientFactory langfuse.ClientFactory
+}
+
+func (d *projectsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clientFactory, ok := req.ProviderData.(langfuse.ClientFactory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected langfuse.ClientFactory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.ClientFactory = clientFactory
+}
+
+func (d *projectsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_projects"
+}
+
+func (d *projectsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the projects of an organization whose metadata matches a selector, e.g. `team=ai,env=prod`, so that fleet-wide " +
+			"changes such as retention bumps can be driven by project metadata instead of hand-maintained lists. The result is ordered by name and ID.",
+		Attributes: map[string]schema.Attribute{
+			"selector": schema.StringAttribute{
+				Optional: true,
+				Description: "Comma-separated metadata requirements, all of which a project must meet: `key=value` (or `key==value`), " +
+					"`key!=value`, which also matches projects without the key, `key` for projects with the key and `!key` for projects without it. " +
+					"Lists every project when unset.",
+			},
+			"organization_public_key": schema.StringAttribute{
+				Optional:    true,
+				Description: "Organization public key to authenticate the call. Conflicts with `credential_ref`. Defaults to LANGFUSE_ORG_PUBLIC_KEY.",
+			},
+			"organization_private_key": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Organization private key to authenticate the call. Conflicts with `credential_ref`. Defaults to LANGFUSE_ORG_SECRET_KEY.",
+			},
+			"credential_ref": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of organization credentials declared in the provider `credentials` block, used instead of `organization_public_key`/`organization_private_key`.",
+			},
+			"projects": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The matching projects, ordered by name and ID.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":   schema.StringAttribute{Computed: true},
+						"name": schema.StringAttribute{Computed: true},
+						"metadata": schema.MapAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"retention_days": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Retention in days, or null when the instance does not report it or the project keeps data indefinitely.",
+						},
+					},
+				},
+			},
+			"ids": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "IDs of the matching projects, in the order of `projects`.",
+			},
+		},
+	}
+}
+
+func (d *projectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer addRunWarnings(&resp.Diagnostics, d.ClientFactory)
+
+	resp.Diagnostics.Append(checkInstanceVersion(ctx, d.ClientFactory, "langfuse_projects")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data projectsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = withRequestAttributes(ctx, d.ClientFactory, types.StringNull(), types.StringNull())
+
+	selector, err := parseMetadataSelector(data.Selector.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("selector"), "Invalid selector", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(validateOrganizationCredentials(data.OrganizationPublicKey, data.OrganizationPrivateKey, data.CredentialRef)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationClient, diags := newOrganizationClient(d.ClientFactory, data.OrganizationPublicKey, data.OrganizationPrivateKey, data.CredentialRef)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projects, err := organizationClient.ListProjects(ctx)
+	if err != nil {
+		addOrganizationClientError(ctx, &resp.Diagnostics, d.ClientFactory, "Error listing projects", data.OrganizationPublicKey, "", err)
+		return
+	}
+	sort.Slice(projects, func(i, j int) bool {
+		if projects[i].Name != projects[j].Name {
+			return projects[i].Name < projects[j].Name
+		}
+		return projects[i].ID < projects[j].ID
+	})
+
+	data.Projects = []projectsEntryModel{}
+	ids := []attr.Value{}
+	for _, project := range projects {
+		if !selector.Matches(project.Metadata) {
+			continue
+		}
+
+		metadata := project.Metadata
+		if metadata == nil {
+			metadata = map[string]string{}
+		}
+		metadataMap, diags := types.MapValueFrom(ctx, types.StringType, metadata)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		data.Projects = append(data.Projects, projectsEntryModel{
+			ID:            types.StringValue(project.ID),
+			Name:          types.StringValue(project.Name),
+			Metadata:      metadataMap,
+			RetentionDays: projectRetentionDaysValue(project),
+		})
+		ids = append(ids, types.StringValue(project.ID))
+	}
+
+	idList, diags := types.ListValue(types.StringType, ids)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.IDs = idList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse/mocks"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestProjectsDataSourceRead(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	d := NewProjectsDataSource().(*projectsDataSource)
+	clientFactory := mocks.NewMockClientFactory(ctrl)
+	d.Configure(ctx, datasource.ConfigureRequest{ProviderData: clientFactory}, &datasource.ConfigureResponse{})
+
+	clientFactory.OrganizationClient.EXPECT().ListProjects(gomock.Any()).Return([]*langfuse.Project{
+		{ID: "proj-3", Name: "search", Metadata: map[string]string{"team": "ai", "env": "prod"}},
+		{ID: "proj-1", Name: "checkout", Metadata: map[string]string{"team": "payments", "env": "prod"}},
+		{ID: "proj-2", Name: "chat", Metadata: map[string]string{"team": "ai", "env": "prod"}},
+		{ID: "proj-4", Name: "sandbox", Metadata: map[string]string{"team": "ai", "env": "dev"}},
+		{ID: "proj-5", Name: "legacy"},
+	}, nil)
+
+	schemaResp, config := auditLogsConfig(ctx, t, d, map[string]tftypes.Value{"selector": tftypes.NewValue(tftypes.String, "team=ai,env=prod")})
+	readResp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics from Read: %v", readResp.Diagnostics)
+	}
+
+	var data projectsDataSourceModel
+	readResp.Diagnostics.Append(readResp.State.Get(ctx, &data)...)
+	if len(data.Projects) != 2 || data.Projects[0].ID.ValueString() != "proj-2" || data.Projects[1].ID.ValueString() != "proj-3" {
+		t.Fatalf("unexpected projects: %+v", data.Projects)
+	}
+	if ids := data.IDs.Elements(); len(ids) != 2 || ids[0].String() != `"proj-2"` {
+		t.Errorf("unexpected IDs: %v", data.IDs)
+	}
+}
+
+func TestProjectsDataSourceInvalidSelector(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	d := NewProjectsDataSource().(*projectsDataSource)
+	d.Configure(ctx, datasource.ConfigureRequest{ProviderData: mocks.NewMockClientFactory(ctrl)}, &datasource.ConfigureResponse{})
+
+	schemaResp, config := auditLogsConfig(ctx, t, d, map[string]tftypes.Value{"selector": tftypes.NewValue(tftypes.String, "team=ai,,env=prod")})
+	readResp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, &readResp)
+	if !readResp.Diagnostics.HasError() || readResp.Diagnostics.Errors()[0].Summary() != "Invalid selector" {
+		t.Fatalf("expected an invalid selector error, got: %v", readResp.Diagnostics)
+	}
+}
@@ -0,0 +1,362 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+)
+
+var _ resource.Resource = &promptReleaseResource{}
+var _ resource.ResourceWithImportState = &promptReleaseResource{}
+var _ resource.ResourceWithValidateConfig = &promptReleaseResource{}
+var _ resource.ResourceWithModifyPlan = &promptReleaseResource{}
+
+// promptLabelPattern matches the labels Langfuse accepts.
+var promptLabelPattern = regexp.MustCompile(`^[a-z0-9_.-]+$`)
+
+func NewPromptReleaseResource() resource.Resource {
+	return &promptReleaseResource{}
+}
+
+type promptReleaseResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	PromptName       types.String `tfsdk:"prompt_name"`
+	Label            types.String `tfsdk:"label"`
+	Version          types.Int64  `tfsdk:"version"`
+	ApprovedBy       types.String `tfsdk:"approved_by"`
+	ApprovalLabel    types.String `tfsdk:"approval_label"`
+	ProjectPublicKey types.String `tfsdk:"project_public_key"`
+	ProjectSecretKey types.String `tfsdk:"project_secret_key"`
+}
+
+// setRelease records the version holding the release label and the approver found on it. The
+// approver is null when the version carries no approval label for the release, e.g. after the label
+// was moved in the Langfuse UI.
+func (m *promptReleaseResourceModel) setRelease(prompt *langfuse.Prompt) {
+	label := m.Label.ValueString()
+	m.ID = types.StringValue(prompt.Name + ":" + label)
+	m.PromptName = types.StringValue(prompt.Name)
+	m.Version = types.Int64Value(int64(prompt.Version))
+
+	prefix := promptApprovalLabel(label, prompt.Version, "")
+	var approvers []string
+	for _, promptLabel := range prompt.Labels {
+		if approver, ok := strings.CutPrefix(promptLabel, prefix); ok && approver != "" {
+			approvers = append(approvers, approver)
+		}
+	}
+	if len(approvers) == 0 {
+		m.ApprovedBy = types.StringNull()
+		m.ApprovalLabel = types.StringNull()
+		return
+	}
+	// Keep the approver in state when several people approved the same version.
+	approver := m.ApprovedBy.ValueString()
+	if !slices.Contains(approvers, approver) {
+		approver = approvers[0]
+	}
+	m.ApprovedBy = types.StringValue(approver)
+	m.ApprovalLabel = types.StringValue(promptApprovalLabel(label, prompt.Version, approver))
+}
+
+// promptApprovalLabel returns the label recording who approved promoting version to label. It names
+// the version so that it stays unique across versions and survives later promotions.
+func promptApprovalLabel(label string, version int, approver string) string {
+	return fmt.Sprintf("%s.v%d.approved-by.%s", label, version, approver)
+}
+
+type promptReleaseResource struct {
+	ClientFactory langfuse.ClientFactory
+}
+
+func (r *promptReleaseResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.ClientFactory = req.ProviderData.(langfuse.ClientFactory)
+}
+
+func (r *promptReleaseResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_prompt_release"
+}
+
+func (r *promptReleaseResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Promotes a prompt version to a label such as `production` and records the approver as an additional label on the version, " +
+			"so that the promotion trail is kept in Langfuse. Changing `version` promotes another version; approval labels of earlier versions are kept.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "`<prompt_name>:<label>`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"prompt_name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the prompt.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"label": schema.StringAttribute{
+				Required:    true,
+				Description: "Label the version is promoted to, e.g. `production`. Lowercase letters, digits, `_`, `-` and `.`; `latest` is managed by Langfuse.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version": schema.Int64Attribute{
+				Required:    true,
+				Description: "Prompt version holding the label. Langfuse moves the label away from the version that held it before.",
+			},
+			"approved_by": schema.StringAttribute{
+				Required:    true,
+				Description: "Handle of the approver of the promotion, using the same characters as `label`.",
+			},
+			"approval_label": schema.StringAttribute{
+				Computed:    true,
+				Description: "Label recording the approval on the version: `<label>.v<version>.approved-by.<approved_by>`.",
+			},
+			"project_public_key": schema.StringAttribute{
+				Optional: true,
+				Description: "Public key of a project API key of the project the prompt belongs to. " +
+					"Defaults to the provider's `project_public_key`. Changing it never replaces the release.",
+			},
+			"project_secret_key": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Description: "Secret key of a project API key of the project the prompt belongs to. " +
+					"Defaults to the provider's `project_secret_key`. Changing it never replaces the release.",
+			},
+		},
+	}
+}
+
+func (r *promptReleaseResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data promptReleaseResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateProjectCredentials(data.ProjectPublicKey, data.ProjectSecretKey)...)
+
+	for attribute, value := range map[string]types.String{"label": data.Label, "approved_by": data.ApprovedBy} {
+		if value.IsNull() || value.IsUnknown() || promptLabelPattern.MatchString(value.ValueString()) {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			path.Root(attribute),
+			"Invalid prompt label",
+			fmt.Sprintf("%s may only contain lowercase letters, digits, \"_\", \"-\" and \".\". Got: %s", attribute, value.ValueString()),
+		)
+	}
+	if data.Label.ValueString() == langfuse.PromptLabelLatest {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("label"),
+			"Invalid prompt label",
+			"The latest label is managed by Langfuse and cannot be promoted to.",
+		)
+	}
+	if !data.Version.IsNull() && !data.Version.IsUnknown() && data.Version.ValueInt64() < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("version"),
+			"Invalid prompt version",
+			fmt.Sprintf("version must be 1 or greater. Got: %d", data.Version.ValueInt64()),
+		)
+	}
+}
+
+// promote sets the release and approval labels on the planned version, keeping its other labels.
+func (r *promptReleaseResource) promote(ctx context.Context, projectClient langfuse.ProjectClient, data *promptReleaseResourceModel) (*langfuse.Prompt, error) {
+	name := data.PromptName.ValueString()
+	version := int(data.Version.ValueInt64())
+
+	prompt, err := projectClient.GetPromptVersion(ctx, name, version)
+	if err != nil {
+		return nil, err
+	}
+
+	labels := slices.DeleteFunc(slices.Clone(prompt.Labels), func(label string) bool { return label == langfuse.PromptLabelLatest })
+	for _, label := range []string{data.Label.ValueString(), promptApprovalLabel(data.Label.ValueString(), version, data.ApprovedBy.ValueString())} {
+		if !slices.Contains(labels, label) {
+			labels = append(labels, label)
+		}
+	}
+
+	return projectClient.UpdatePromptLabels(ctx, name, version, labels)
+}
+
+// ModifyPlan only reports the sensitive attributes the plan writes to state, when requested.
+func (r *promptReleaseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	addSensitiveStateSummary(ctx, r.ClientFactory, req, resp)
+}
+
+func (r *promptReleaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer addRunWarnings(&resp.Diagnostics, r.ClientFactory)
+
+	resp.Diagnostics.Append(checkInstanceVersion(ctx, r.ClientFactory, "langfuse_prompt_release")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data promptReleaseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectClient, diags := newProjectClient(r.ClientFactory, data.ProjectPublicKey, data.ProjectSecretKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prompt, err := r.promote(ctx, projectClient, &data)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error promoting prompt version", err)
+		return
+	}
+
+	data.setRelease(prompt)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *promptReleaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data promptReleaseResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectClient, diags := newProjectClient(r.ClientFactory, data.ProjectPublicKey, data.ProjectSecretKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	prompt, err := projectClient.GetPromptByLabel(ctx, data.PromptName.ValueString(), data.Label.ValueString())
+	if errors.Is(err, langfuse.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error reading prompt release", err)
+		return
+	}
+
+	data.setRelease(prompt)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *promptReleaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer addRunWarnings(&resp.Diagnostics, r.ClientFactory)
+
+	var data promptReleaseResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectClient, diags := newProjectClient(r.ClientFactory, data.ProjectPublicKey, data.ProjectSecretKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prompt, err := r.promote(ctx, projectClient, &data)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error promoting prompt version", err)
+		return
+	}
+
+	data.setRelease(prompt)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete removes the release label from the version if it still holds it. The approval label is
+// kept as a record of the promotion.
+func (r *promptReleaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer addRunWarnings(&resp.Diagnostics, r.ClientFactory)
+
+	var data promptReleaseResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectClient, diags := newProjectClient(r.ClientFactory, data.ProjectPublicKey, data.ProjectSecretKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	name := data.PromptName.ValueString()
+	version := int(data.Version.ValueInt64())
+	prompt, err := projectClient.GetPromptVersion(ctx, name, version)
+	if errors.Is(err, langfuse.ErrNotFound) {
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error reading prompt version", err)
+		return
+	}
+	if !slices.Contains(prompt.Labels, data.Label.ValueString()) {
+		return
+	}
+
+	labels := slices.DeleteFunc(slices.Clone(prompt.Labels), func(label string) bool {
+		return label == data.Label.ValueString() || label == langfuse.PromptLabelLatest
+	})
+	if _, err := projectClient.UpdatePromptLabels(ctx, name, version, labels); err != nil {
+		addClientError(&resp.Diagnostics, "Error removing prompt release label", err)
+		return
+	}
+}
+
+func (r *promptReleaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import format: prompt_name,label,project_public_key,project_secret_key
+	importParts := strings.Split(req.ID, ",")
+	if len(importParts) != 4 {
+		resp.Diagnostics.AddError("Invalid import format",
+			"Import ID must be in format: prompt_name,label,project_public_key,project_secret_key")
+		return
+	}
+
+	data := promptReleaseResourceModel{
+		Label:            types.StringValue(importParts[1]),
+		ProjectPublicKey: types.StringValue(importParts[2]),
+		ProjectSecretKey: types.StringValue(importParts[3]),
+	}
+
+	resp.Diagnostics.Append(checkProviderConfigurationKnown(r.ClientFactory)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectClient := r.ClientFactory.NewProjectClient(importParts[2], importParts[3])
+	prompt, err := projectClient.GetPromptByLabel(ctx, importParts[0], importParts[1])
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing prompt release",
+			"Could not read the version of prompt "+importParts[0]+" labeled "+importParts[1]+": "+err.Error())
+		return
+	}
+
+	data.setRelease(prompt)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
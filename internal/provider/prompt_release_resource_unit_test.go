@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse/mocks"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestPromptReleaseResourceCRUD(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	r := NewPromptReleaseResource().(*promptReleaseResource)
+	clientFactory := mocks.NewMockClientFactory(ctrl)
+	r.Configure(ctx, resource.ConfigureRequest{ProviderData: clientFactory}, &resource.ConfigureResponse{})
+
+	planValues := func(version int64, approvedBy string) map[string]tftypes.Value {
+		return map[string]tftypes.Value{
+			"id":                 tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"prompt_name":        tftypes.NewValue(tftypes.String, "support-agent"),
+			"label":              tftypes.NewValue(tftypes.String, "production"),
+			"version":            tftypes.NewValue(tftypes.Number, version),
+			"approved_by":        tftypes.NewValue(tftypes.String, approvedBy),
+			"approval_label":     tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"project_public_key": tftypes.NewValue(tftypes.String, "pk-lf-1"),
+			"project_secret_key": tftypes.NewValue(tftypes.String, "sk-lf-1"),
+		}
+	}
+	plan, schemaResp := resourceObjectValue(ctx, r, planValues(3, "jdoe"))
+	if diags := schemaResp.Schema.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("schema implementation validation failed: %v", diags)
+	}
+
+	var createResp resource.CreateResponse
+	t.Run("Create", func(t *testing.T) {
+		clientFactory.ProjectClient.EXPECT().GetPromptVersion(ctx, "support-agent", 3).
+			Return(&langfuse.Prompt{Name: "support-agent", Version: 3, Labels: []string{"latest", "staging"}}, nil)
+		clientFactory.ProjectClient.EXPECT().UpdatePromptLabels(ctx, "support-agent", 3, []string{"staging", "production", "production.v3.approved-by.jdoe"}).
+			Return(&langfuse.Prompt{Name: "support-agent", Version: 3, Labels: []string{"latest", "production", "production.v3.approved-by.jdoe", "staging"}}, nil)
+
+		createResp.State.Schema = schemaResp.Schema
+		r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema, Raw: plan}}, &createResp)
+		if createResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics from Create: %v", createResp.Diagnostics)
+		}
+
+		var state promptReleaseResourceModel
+		createResp.State.Get(ctx, &state)
+		if state.ID.ValueString() != "support-agent:production" || state.ApprovalLabel.ValueString() != "production.v3.approved-by.jdoe" {
+			t.Errorf("unexpected state after Create: %+v", state)
+		}
+	})
+
+	t.Run("Update promotes another version", func(t *testing.T) {
+		updatedPlan, _ := resourceObjectValue(ctx, r, planValues(4, "asmith"))
+		clientFactory.ProjectClient.EXPECT().GetPromptVersion(ctx, "support-agent", 4).
+			Return(&langfuse.Prompt{Name: "support-agent", Version: 4, Labels: []string{"latest"}}, nil)
+		clientFactory.ProjectClient.EXPECT().UpdatePromptLabels(ctx, "support-agent", 4, []string{"production", "production.v4.approved-by.asmith"}).
+			Return(&langfuse.Prompt{Name: "support-agent", Version: 4, Labels: []string{"latest", "production", "production.v4.approved-by.asmith"}}, nil)
+
+		updateResp := resource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+		r.Update(ctx, resource.UpdateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema, Raw: updatedPlan}, State: createResp.State}, &updateResp)
+		if updateResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics from Update: %v", updateResp.Diagnostics)
+		}
+
+		var state promptReleaseResourceModel
+		updateResp.State.Get(ctx, &state)
+		if state.Version.ValueInt64() != 4 || state.ApprovedBy.ValueString() != "asmith" {
+			t.Errorf("unexpected state after Update: %+v", state)
+		}
+	})
+
+	t.Run("Read detects a label moved outside Terraform", func(t *testing.T) {
+		clientFactory.ProjectClient.EXPECT().GetPromptByLabel(ctx, "support-agent", "production").
+			Return(&langfuse.Prompt{Name: "support-agent", Version: 5, Labels: []string{"latest", "production"}}, nil)
+
+		readResp := resource.ReadResponse{State: createResp.State}
+		r.Read(ctx, resource.ReadRequest{State: createResp.State}, &readResp)
+		if readResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics from Read: %v", readResp.Diagnostics)
+		}
+
+		var state promptReleaseResourceModel
+		readResp.State.Get(ctx, &state)
+		if state.Version.ValueInt64() != 5 || !state.ApprovedBy.IsNull() || !state.ApprovalLabel.IsNull() {
+			t.Errorf("unexpected state after Read: %+v", state)
+		}
+	})
+
+	t.Run("Delete keeps the approval label", func(t *testing.T) {
+		clientFactory.ProjectClient.EXPECT().GetPromptVersion(ctx, "support-agent", 3).
+			Return(&langfuse.Prompt{Name: "support-agent", Version: 3, Labels: []string{"latest", "production", "production.v3.approved-by.jdoe"}}, nil)
+		clientFactory.ProjectClient.EXPECT().UpdatePromptLabels(ctx, "support-agent", 3, []string{"production.v3.approved-by.jdoe"}).
+			Return(&langfuse.Prompt{Name: "support-agent", Version: 3}, nil)
+
+		deleteResp := resource.DeleteResponse{State: createResp.State}
+		r.Delete(ctx, resource.DeleteRequest{State: createResp.State}, &deleteResp)
+		if deleteResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics from Delete: %v", deleteResp.Diagnostics)
+		}
+	})
+}
+
+func TestPromptReleaseResourceValidateConfig(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := NewPromptReleaseResource().(*promptReleaseResource)
+
+	testCases := []struct {
+		name        string
+		label       string
+		approvedBy  string
+		version     int64
+		expectError bool
+	}{
+		{name: "valid", label: "production", approvedBy: "jdoe", version: 1},
+		{name: "latest label", label: "latest", approvedBy: "jdoe", version: 1, expectError: true},
+		{name: "invalid approver", label: "production", approvedBy: "jdoe@example.com", version: 1, expectError: true},
+		{name: "uppercase label", label: "Production", approvedBy: "jdoe", version: 1, expectError: true},
+		{name: "version zero", label: "production", approvedBy: "jdoe", version: 0, expectError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config, schemaResp := resourceObjectValue(ctx, r, map[string]tftypes.Value{
+				"prompt_name": tftypes.NewValue(tftypes.String, "support-agent"),
+				"label":       tftypes.NewValue(tftypes.String, tc.label),
+				"approved_by": tftypes.NewValue(tftypes.String, tc.approvedBy),
+				"version":     tftypes.NewValue(tftypes.Number, tc.version),
+			})
+
+			var resp resource.ValidateConfigResponse
+			r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config}}, &resp)
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Errorf("expected error: %t, got diagnostics: %v", tc.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
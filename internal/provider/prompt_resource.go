@@ -0,0 +1,631 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+)
+
+var _ resource.Resource = &promptResource{}
+var _ resource.ResourceWithImportState = &promptResource{}
+var _ resource.ResourceWithValidateConfig = &promptResource{}
+var _ resource.ResourceWithModifyPlan = &promptResource{}
+
+// promptRequestFields maps the fields of prompt requests to the attributes they are set from. The
+// prompt field of chat prompts is set from messages; see requestFields.
+var promptRequestFields = map[string]path.Path{
+	"name":          path.Root("name"),
+	"type":          path.Root("type"),
+	"prompt":        path.Root("prompt"),
+	"config":        path.Root("config"),
+	"labels":        path.Root("labels"),
+	"tags":          path.Root("tags"),
+	"commitMessage": path.Root("commit_message"),
+}
+
+// promptMessageAttributeTypes are the attributes of an element of messages.
+var promptMessageAttributeTypes = map[string]attr.Type{
+	"role":    types.StringType,
+	"content": types.StringType,
+}
+
+func NewPromptResource() resource.Resource {
+	return &promptResource{}
+}
+
+type promptResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Type             types.String `tfsdk:"type"`
+	Prompt           types.String `tfsdk:"prompt"`
+	Messages         types.List   `tfsdk:"messages"`
+	Config           types.String `tfsdk:"config"`
+	Labels           types.Set    `tfsdk:"labels"`
+	Tags             types.Set    `tfsdk:"tags"`
+	CommitMessage    types.String `tfsdk:"commit_message"`
+	Version          types.Int64  `tfsdk:"version"`
+	ProjectPublicKey types.String `tfsdk:"project_public_key"`
+	ProjectSecretKey types.String `tfsdk:"project_secret_key"`
+}
+
+type promptMessageModel struct {
+	Role    types.String `tfsdk:"role"`
+	Content types.String `tfsdk:"content"`
+}
+
+// requestFields returns promptRequestFields with the prompt field of chat prompts mapped to messages.
+func (m *promptResourceModel) requestFields() map[string]path.Path {
+	if m.Type.ValueString() != langfuse.PromptTypeChat {
+		return promptRequestFields
+	}
+	fields := maps.Clone(promptRequestFields)
+	fields["prompt"] = path.Root("messages")
+	return fields
+}
+
+// createRequest builds the request creating a version with the planned content and labels.
+func (m *promptResourceModel) createRequest(ctx context.Context) (*langfuse.CreatePromptRequest, diag.Diagnostics) {
+	request := &langfuse.CreatePromptRequest{
+		Name:          m.Name.ValueString(),
+		Type:          m.Type.ValueString(),
+		Prompt:        m.Prompt.ValueString(),
+		Labels:        sortedSetElements(m.Labels),
+		Tags:          sortedSetElements(m.Tags),
+		CommitMessage: m.CommitMessage.ValueString(),
+	}
+
+	if request.Type == langfuse.PromptTypeChat {
+		var messages []promptMessageModel
+		if diags := m.Messages.ElementsAs(ctx, &messages, false); diags.HasError() {
+			return nil, diags
+		}
+		chat := make([]langfuse.PromptMessage, 0, len(messages))
+		for _, message := range messages {
+			chat = append(chat, langfuse.PromptMessage{Role: message.Role.ValueString(), Content: message.Content.ValueString()})
+		}
+		request.Prompt = chat
+	}
+
+	if !m.Config.IsNull() {
+		var config any
+		if err := json.Unmarshal([]byte(m.Config.ValueString()), &config); err != nil {
+			var diags diag.Diagnostics
+			diags.AddAttributeError(path.Root("config"), "Invalid prompt config", "config must be a JSON document: "+err.Error())
+			return nil, diags
+		}
+		request.Config = config
+	}
+
+	return request, nil
+}
+
+// createsVersion reports whether applying m over state needs a new prompt version. Versions are
+// immutable, so everything but the labels and the commit message is only changed by a new version.
+func (m *promptResourceModel) createsVersion(state *promptResourceModel) bool {
+	return !m.Type.Equal(state.Type) ||
+		!m.Prompt.Equal(state.Prompt) ||
+		!m.Messages.Equal(state.Messages) ||
+		!promptConfigEqual(m.Config, state.Config) ||
+		m.Tags.IsUnknown() ||
+		!maps.Equal(setElements(m.Tags), setElements(state.Tags))
+}
+
+// setPrompt copies the content of a prompt version. Only the labels in managed are reported, so that
+// labels set by langfuse_prompt_release or in the Langfuse UI do not show up as drift; a nil
+// managed reports every label, as imports do.
+func (m *promptResourceModel) setPrompt(ctx context.Context, prompt *langfuse.Prompt, managed map[string]struct{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(prompt.Name)
+	m.Name = types.StringValue(prompt.Name)
+	m.Version = types.Int64Value(int64(prompt.Version))
+	if prompt.Type != "" {
+		m.Type = types.StringValue(prompt.Type)
+	}
+
+	m.Prompt = types.StringNull()
+	m.Messages = types.ListNull(types.ObjectType{AttrTypes: promptMessageAttributeTypes})
+	if m.Type.ValueString() == langfuse.PromptTypeChat {
+		messages, err := prompt.Messages()
+		if err != nil {
+			diags.AddError("Error reading prompt", err.Error())
+			return diags
+		}
+		models := make([]promptMessageModel, 0, len(messages))
+		for _, message := range messages {
+			models = append(models, promptMessageModel{Role: types.StringValue(message.Role), Content: types.StringValue(message.Content)})
+		}
+		list, listDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: promptMessageAttributeTypes}, models)
+		diags.Append(listDiags...)
+		m.Messages = list
+	} else if text, ok := prompt.Prompt.(string); ok {
+		m.Prompt = types.StringValue(text)
+	}
+
+	m.Config = promptConfigValue(m.Config, prompt.Config)
+
+	var labels []string
+	for _, label := range prompt.Labels {
+		if _, ok := managed[label]; label != langfuse.PromptLabelLatest && (managed == nil || ok) {
+			labels = append(labels, label)
+		}
+	}
+	m.Labels = setValueOrNull(ctx, m.Labels, labels, &diags)
+	m.Tags = setValueOrNull(ctx, m.Tags, prompt.Tags, &diags)
+
+	return diags
+}
+
+// promptConfigEqual compares config documents by their JSON value, so that formatting differences
+// between the configuration and what Langfuse returns are no change.
+func promptConfigEqual(a, b types.String) bool {
+	if a.IsUnknown() || b.IsUnknown() || a.IsNull() || b.IsNull() {
+		return a.Equal(b)
+	}
+	var decodedA, decodedB any
+	if json.Unmarshal([]byte(a.ValueString()), &decodedA) != nil || json.Unmarshal([]byte(b.ValueString()), &decodedB) != nil {
+		return a.Equal(b)
+	}
+	return reflect.DeepEqual(decodedA, decodedB)
+}
+
+// promptConfigValue returns the config attribute for the config Langfuse returned, keeping the
+// current value when it is the same document. Langfuse returns {} for prompts without config,
+// which is null.
+func promptConfigValue(current types.String, config any) types.String {
+	if config == nil {
+		return types.StringNull()
+	}
+	if object, ok := config.(map[string]any); ok && len(object) == 0 && current.IsNull() {
+		return types.StringNull()
+	}
+	encoded, err := json.Marshal(config)
+	if err != nil {
+		return current
+	}
+	remote := types.StringValue(string(encoded))
+	if promptConfigEqual(current, remote) {
+		return current
+	}
+	return remote
+}
+
+// setValueOrNull returns a set of values, or null when there are none and current is null.
+func setValueOrNull(ctx context.Context, current types.Set, values []string, diags *diag.Diagnostics) types.Set {
+	if len(values) == 0 && current.IsNull() {
+		return types.SetNull(types.StringType)
+	}
+	if values == nil {
+		values = []string{}
+	}
+	set, setDiags := types.SetValueFrom(ctx, types.StringType, values)
+	diags.Append(setDiags...)
+	return set
+}
+
+// sortedSetElements returns the elements of a string set in a stable order, never nil.
+func sortedSetElements(set types.Set) []string {
+	elements := []string{}
+	for element := range setElements(set) {
+		elements = append(elements, element)
+	}
+	sort.Strings(elements)
+	return elements
+}
+
+type promptResource struct {
+	ClientFactory langfuse.ClientFactory
+}
+
+func (r *promptResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.ClientFactory = req.ProviderData.(langfuse.ClientFactory)
+}
+
+func (r *promptResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_prompt"
+}
+
+func (r *promptResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a prompt of a project. Langfuse prompt versions are immutable: changing the content, config or tags creates a new " +
+			"version, which becomes `latest`, while changing only `labels` moves the labels on the current version. Destroying the resource " +
+			"deletes the prompt with all its versions.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the prompt.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "Name of the prompt, unique within the project.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(langfuse.PromptTypeText),
+				Description: "`text` or `chat`. Defaults to `text`. Changing it replaces the prompt.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"prompt": schema.StringAttribute{
+				Optional:    true,
+				Description: "Content of a `text` prompt.",
+			},
+			"messages": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "Messages of a `chat` prompt.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role": schema.StringAttribute{
+							Required:    true,
+							Description: "Role of the message author, e.g. `system` or `user`.",
+						},
+						"content": schema.StringAttribute{
+							Required:    true,
+							Description: "Content of the message.",
+						},
+					},
+				},
+			},
+			"config": schema.StringAttribute{
+				Optional:    true,
+				Description: "Config of the prompt as a JSON document, e.g. `jsonencode({ model = \"gpt-4o\", temperature = 0.2 })`.",
+			},
+			"labels": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Labels of the current version, e.g. `production`. Langfuse moves them away from the versions that held them before. " +
+					"Other labels of the version, such as those set by `langfuse_prompt_release`, are left alone; `latest` is managed by Langfuse.",
+			},
+			"tags": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Tags of the prompt, shared by all its versions.",
+			},
+			"commit_message": schema.StringAttribute{
+				Optional:    true,
+				Description: "Commit message of the versions the resource creates. Changing only the commit message does not create a version.",
+			},
+			"version": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The version created by the last change of the content, config or tags.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_public_key": schema.StringAttribute{
+				Optional: true,
+				Description: "Public key of a project API key of the project the prompt belongs to. " +
+					"Defaults to the provider's `project_public_key`. Changing it never replaces the prompt.",
+			},
+			"project_secret_key": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Description: "Secret key of a project API key of the project the prompt belongs to. " +
+					"Defaults to the provider's `project_secret_key`. Changing it never replaces the prompt.",
+			},
+		},
+	}
+}
+
+func (r *promptResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data promptResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateProjectCredentials(data.ProjectPublicKey, data.ProjectSecretKey)...)
+
+	if !data.Type.IsUnknown() {
+		switch promptType := data.Type.ValueString(); promptType {
+		case "", langfuse.PromptTypeText:
+			if data.Prompt.IsNull() || !data.Messages.IsNull() {
+				resp.Diagnostics.AddAttributeError(path.Root("prompt"), "Invalid prompt",
+					"A text prompt requires prompt and does not take messages.")
+			}
+		case langfuse.PromptTypeChat:
+			if data.Messages.IsNull() || !data.Prompt.IsNull() {
+				resp.Diagnostics.AddAttributeError(path.Root("messages"), "Invalid prompt",
+					"A chat prompt requires messages and does not take prompt.")
+			}
+		default:
+			resp.Diagnostics.AddAttributeError(path.Root("type"), "Invalid prompt type",
+				fmt.Sprintf("type must be %s or %s. Got: %s", langfuse.PromptTypeText, langfuse.PromptTypeChat, promptType))
+		}
+	}
+
+	if !data.Config.IsNull() && !data.Config.IsUnknown() && !json.Valid([]byte(data.Config.ValueString())) {
+		resp.Diagnostics.AddAttributeError(path.Root("config"), "Invalid prompt config",
+			"config must be a JSON document, e.g. the result of jsonencode.")
+	}
+
+	for label := range setElements(data.Labels) {
+		if !promptLabelPattern.MatchString(label) || label == langfuse.PromptLabelLatest {
+			resp.Diagnostics.AddAttributeError(path.Root("labels"), "Invalid prompt label",
+				fmt.Sprintf("Labels may only contain lowercase letters, digits, \"_\", \"-\" and \".\", and latest is managed by Langfuse. Got: %s", label))
+		}
+	}
+}
+
+// ModifyPlan marks the version as unknown when the apply creates a new version, and reports the
+// sensitive attributes the plan writes to state when requested.
+func (r *promptResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	addSensitiveStateSummary(ctx, r.ClientFactory, req, resp)
+
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state promptResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.createsVersion(&state) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("version"), types.Int64Unknown())...)
+	}
+}
+
+func (r *promptResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer addRunWarnings(&resp.Diagnostics, r.ClientFactory)
+
+	resp.Diagnostics.Append(checkInstanceVersion(ctx, r.ClientFactory, "langfuse_prompt")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data promptResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request, diags := data.createRequest(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectClient, diags := newProjectClient(r.ClientFactory, data.ProjectPublicKey, data.ProjectSecretKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Creating a prompt under an existing name adds a version to it, and destroying the resource
+	// would then delete the versions it did not create, so existing prompts must be imported.
+	existing, err := projectClient.GetPromptByLabel(ctx, data.Name.ValueString(), "latest")
+	switch {
+	case err == nil:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Prompt already exists",
+			fmt.Sprintf("A prompt named %q already exists (latest version %d) and is not managed by this resource. Import it with "+
+				"`terraform import` using `name,project_public_key,project_secret_key` instead of creating it.", existing.Name, existing.Version),
+		)
+		return
+	case !errors.Is(err, langfuse.ErrNotFound):
+		addClientError(&resp.Diagnostics, "Error checking for an existing prompt", err)
+		return
+	}
+
+	prompt, err := projectClient.CreatePrompt(ctx, request)
+	if err != nil {
+		addClientFieldErrors(&resp.Diagnostics, "Error creating prompt", err, data.requestFields())
+		return
+	}
+
+	data.ID = types.StringValue(data.Name.ValueString())
+	data.Version = types.Int64Value(int64(prompt.Version))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *promptResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data promptResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectClient, diags := newProjectClient(r.ClientFactory, data.ProjectPublicKey, data.ProjectSecretKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	prompt, err := projectClient.GetPromptVersion(ctx, data.Name.ValueString(), int(data.Version.ValueInt64()))
+	if errors.Is(err, langfuse.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error reading prompt", err)
+		return
+	}
+
+	resp.Diagnostics.Append(data.setPrompt(ctx, prompt, setElements(data.Labels))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *promptResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer addRunWarnings(&resp.Diagnostics, r.ClientFactory)
+
+	var data, state promptResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectClient, diags := newProjectClient(r.ClientFactory, data.ProjectPublicKey, data.ProjectSecretKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.ID = state.ID
+	data.Version = state.Version
+
+	switch {
+	case data.createsVersion(&state):
+		request, diags := data.createRequest(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		prompt, err := projectClient.CreatePrompt(ctx, request)
+		if err != nil {
+			addClientFieldErrors(&resp.Diagnostics, "Error creating prompt version", err, data.requestFields())
+			return
+		}
+		data.Version = types.Int64Value(int64(prompt.Version))
+
+		// The new version took the declared labels; labels dropped from the configuration would
+		// stay on the previous version, where Read does not look, so they are removed there.
+		if dropped := droppedLabels(state.Labels, data.Labels); len(dropped) > 0 {
+			resp.Diagnostics.Append(relabelPromptVersion(ctx, projectClient, data.Name.ValueString(), int(state.Version.ValueInt64()), dropped, nil)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+
+	case !maps.Equal(setElements(data.Labels), setElements(state.Labels)):
+		resp.Diagnostics.Append(relabelPromptVersion(ctx, projectClient, data.Name.ValueString(), int(state.Version.ValueInt64()),
+			droppedLabels(state.Labels, data.Labels), sortedSetElements(data.Labels))...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// droppedLabels returns the labels the resource managed before but no longer declares.
+func droppedLabels(previous, desired types.Set) map[string]struct{} {
+	dropped := setElements(previous)
+	for label := range setElements(desired) {
+		delete(dropped, label)
+	}
+	return dropped
+}
+
+// relabelPromptVersion removes the dropped labels from a prompt version and adds the given ones,
+// keeping the labels the resource does not manage.
+func relabelPromptVersion(ctx context.Context, projectClient langfuse.ProjectClient, name string, version int, dropped map[string]struct{}, add []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	prompt, err := projectClient.GetPromptVersion(ctx, name, version)
+	if err != nil {
+		addClientError(&diags, "Error reading prompt version", err)
+		return diags
+	}
+
+	labels := slices.DeleteFunc(slices.Clone(prompt.Labels), func(label string) bool {
+		_, isDropped := dropped[label]
+		return label == langfuse.PromptLabelLatest || isDropped
+	})
+	for _, label := range add {
+		if !slices.Contains(labels, label) {
+			labels = append(labels, label)
+		}
+	}
+	if _, err := projectClient.UpdatePromptLabels(ctx, name, version, labels); err != nil {
+		addClientFieldErrors(&diags, "Error updating prompt labels", err, map[string]path.Path{"newLabels": path.Root("labels")})
+	}
+	return diags
+}
+
+func (r *promptResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer addRunWarnings(&resp.Diagnostics, r.ClientFactory)
+
+	var data promptResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectClient, diags := newProjectClient(r.ClientFactory, data.ProjectPublicKey, data.ProjectSecretKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := projectClient.DeletePrompt(ctx, data.Name.ValueString()); err != nil {
+		addClientError(&resp.Diagnostics, "Error deleting prompt", err)
+		return
+	}
+}
+
+func (r *promptResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import format: name,project_public_key,project_secret_key
+	importParts := strings.Split(req.ID, ",")
+	if len(importParts) != 3 {
+		resp.Diagnostics.AddError("Invalid import format",
+			"Import ID must be in format: name,project_public_key,project_secret_key")
+		return
+	}
+
+	data := promptResourceModel{
+		Type:             types.StringValue(langfuse.PromptTypeText),
+		Config:           types.StringNull(),
+		Labels:           types.SetNull(types.StringType),
+		Tags:             types.SetNull(types.StringType),
+		CommitMessage:    types.StringNull(),
+		ProjectPublicKey: types.StringValue(importParts[1]),
+		ProjectSecretKey: types.StringValue(importParts[2]),
+	}
+
+	resp.Diagnostics.Append(checkProviderConfigurationKnown(r.ClientFactory)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectClient := r.ClientFactory.NewProjectClient(importParts[1], importParts[2])
+	prompt, err := projectClient.GetPromptByLabel(ctx, importParts[0], langfuse.PromptLabelLatest)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing prompt",
+			"Could not read the latest version of prompt "+importParts[0]+": "+err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(data.setPrompt(ctx, prompt, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,304 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse/mocks"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func promptStringSet(values ...string) tftypes.Value {
+	elements := make([]tftypes.Value, 0, len(values))
+	for _, value := range values {
+		elements = append(elements, tftypes.NewValue(tftypes.String, value))
+	}
+	return tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, elements)
+}
+
+func TestPromptResourceCRUD(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	r := NewPromptResource().(*promptResource)
+	clientFactory := mocks.NewMockClientFactory(ctrl)
+	r.Configure(ctx, resource.ConfigureRequest{ProviderData: clientFactory}, &resource.ConfigureResponse{})
+
+	planValues := func(text string, labels ...string) map[string]tftypes.Value {
+		return map[string]tftypes.Value{
+			"id":                 tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"name":               tftypes.NewValue(tftypes.String, "support-agent"),
+			"type":               tftypes.NewValue(tftypes.String, "text"),
+			"prompt":             tftypes.NewValue(tftypes.String, text),
+			"config":             tftypes.NewValue(tftypes.String, `{"model": "gpt-4o", "temperature": 0.2}`),
+			"labels":             promptStringSet(labels...),
+			"tags":               promptStringSet("support"),
+			"version":            tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+			"project_public_key": tftypes.NewValue(tftypes.String, "pk-lf-1"),
+			"project_secret_key": tftypes.NewValue(tftypes.String, "sk-lf-1"),
+		}
+	}
+	plan, schemaResp := resourceObjectValue(ctx, r, planValues("Help {{customer}}.", "staging"))
+	if diags := schemaResp.Schema.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("schema implementation validation failed: %v", diags)
+	}
+
+	t.Run("Create refuses an existing prompt", func(t *testing.T) {
+		clientFactory.ProjectClient.EXPECT().GetPromptByLabel(ctx, "support-agent", "latest").
+			Return(&langfuse.Prompt{Name: "support-agent", Version: 3}, nil)
+
+		resp := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+		r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema, Raw: plan}}, &resp)
+		if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Prompt already exists" {
+			t.Fatalf("expected an existing prompt error, got: %v", resp.Diagnostics)
+		}
+		if !resp.State.Raw.IsNull() {
+			t.Errorf("expected no state, got: %v", resp.State.Raw)
+		}
+	})
+
+	var createResp resource.CreateResponse
+	t.Run("Create", func(t *testing.T) {
+		clientFactory.ProjectClient.EXPECT().GetPromptByLabel(ctx, "support-agent", "latest").Return(nil, langfuse.ErrNotFound)
+		clientFactory.ProjectClient.EXPECT().CreatePrompt(ctx, &langfuse.CreatePromptRequest{
+			Name:   "support-agent",
+			Type:   "text",
+			Prompt: "Help {{customer}}.",
+			Config: map[string]any{"model": "gpt-4o", "temperature": 0.2},
+			Labels: []string{"staging"},
+			Tags:   []string{"support"},
+		}).Return(&langfuse.Prompt{Name: "support-agent", Version: 1}, nil)
+
+		createResp.State.Schema = schemaResp.Schema
+		r.Create(ctx, resource.CreateRequest{Plan: tfsdk.Plan{Schema: schemaResp.Schema, Raw: plan}}, &createResp)
+		if createResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics from Create: %v", createResp.Diagnostics)
+		}
+
+		var state promptResourceModel
+		createResp.State.Get(ctx, &state)
+		if state.ID.ValueString() != "support-agent" || state.Version.ValueInt64() != 1 {
+			t.Errorf("unexpected state after Create: %+v", state)
+		}
+	})
+
+	t.Run("Read ignores formatting and unmanaged labels", func(t *testing.T) {
+		clientFactory.ProjectClient.EXPECT().GetPromptVersion(ctx, "support-agent", 1).Return(&langfuse.Prompt{
+			Name:    "support-agent",
+			Version: 1,
+			Type:    "text",
+			Prompt:  "Help {{customer}}.",
+			Config:  map[string]any{"temperature": 0.2, "model": "gpt-4o"},
+			Labels:  []string{"latest", "staging", "production", "production.v1.approved-by.jdoe"},
+			Tags:    []string{"support"},
+		}, nil)
+
+		readResp := resource.ReadResponse{State: createResp.State}
+		r.Read(ctx, resource.ReadRequest{State: createResp.State}, &readResp)
+		if readResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics from Read: %v", readResp.Diagnostics)
+		}
+		if !readResp.State.Raw.Equal(createResp.State.Raw) {
+			t.Errorf("expected Read to keep the state, got: %v", readResp.State.Raw)
+		}
+	})
+
+	t.Run("Update of labels only moves labels", func(t *testing.T) {
+		updatedPlan, _ := resourceObjectValue(ctx, r, planValues("Help {{customer}}.", "production"))
+
+		var modifyResp resource.ModifyPlanResponse
+		modifyResp.Plan = tfsdk.Plan{Schema: schemaResp.Schema, Raw: updatedPlan}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{State: createResp.State, Plan: modifyResp.Plan}, &modifyResp)
+		if modifyResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics from ModifyPlan: %v", modifyResp.Diagnostics)
+		}
+
+		clientFactory.ProjectClient.EXPECT().GetPromptVersion(ctx, "support-agent", 1).
+			Return(&langfuse.Prompt{Name: "support-agent", Version: 1, Labels: []string{"latest", "staging", "production.v1.approved-by.jdoe"}}, nil)
+		clientFactory.ProjectClient.EXPECT().UpdatePromptLabels(ctx, "support-agent", 1, []string{"production.v1.approved-by.jdoe", "production"}).
+			Return(&langfuse.Prompt{Name: "support-agent", Version: 1}, nil)
+
+		updateResp := resource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+		r.Update(ctx, resource.UpdateRequest{Plan: modifyResp.Plan, State: createResp.State}, &updateResp)
+		if updateResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics from Update: %v", updateResp.Diagnostics)
+		}
+
+		var state promptResourceModel
+		updateResp.State.Get(ctx, &state)
+		if state.Version.ValueInt64() != 1 {
+			t.Errorf("expected version 1 to be kept, got %d", state.Version.ValueInt64())
+		}
+	})
+
+	t.Run("Update of the content creates a version", func(t *testing.T) {
+		updatedPlan, _ := resourceObjectValue(ctx, r, planValues("Help {{customer}} politely.", "staging"))
+
+		var modifyResp resource.ModifyPlanResponse
+		modifyResp.Plan = tfsdk.Plan{Schema: schemaResp.Schema, Raw: updatedPlan}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{State: createResp.State, Plan: modifyResp.Plan}, &modifyResp)
+		var planned promptResourceModel
+		modifyResp.Plan.Get(ctx, &planned)
+		if !planned.Version.IsUnknown() {
+			t.Errorf("expected the version to be unknown in the plan, got %v", planned.Version)
+		}
+
+		clientFactory.ProjectClient.EXPECT().CreatePrompt(ctx, &langfuse.CreatePromptRequest{
+			Name:   "support-agent",
+			Type:   "text",
+			Prompt: "Help {{customer}} politely.",
+			Config: map[string]any{"model": "gpt-4o", "temperature": 0.2},
+			Labels: []string{"staging"},
+			Tags:   []string{"support"},
+		}).Return(&langfuse.Prompt{Name: "support-agent", Version: 2}, nil)
+
+		updateResp := resource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+		r.Update(ctx, resource.UpdateRequest{Plan: modifyResp.Plan, State: createResp.State}, &updateResp)
+		if updateResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics from Update: %v", updateResp.Diagnostics)
+		}
+
+		var state promptResourceModel
+		updateResp.State.Get(ctx, &state)
+		if state.Version.ValueInt64() != 2 {
+			t.Errorf("expected version 2, got %d", state.Version.ValueInt64())
+		}
+	})
+
+	t.Run("Update of content and labels strips dropped labels from the previous version", func(t *testing.T) {
+		updatedPlan, _ := resourceObjectValue(ctx, r, planValues("Help {{customer}} politely.", "production"))
+
+		var modifyResp resource.ModifyPlanResponse
+		modifyResp.Plan = tfsdk.Plan{Schema: schemaResp.Schema, Raw: updatedPlan}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{State: createResp.State, Plan: modifyResp.Plan}, &modifyResp)
+
+		clientFactory.ProjectClient.EXPECT().CreatePrompt(ctx, &langfuse.CreatePromptRequest{
+			Name:   "support-agent",
+			Type:   "text",
+			Prompt: "Help {{customer}} politely.",
+			Config: map[string]any{"model": "gpt-4o", "temperature": 0.2},
+			Labels: []string{"production"},
+			Tags:   []string{"support"},
+		}).Return(&langfuse.Prompt{Name: "support-agent", Version: 2}, nil)
+		clientFactory.ProjectClient.EXPECT().GetPromptVersion(ctx, "support-agent", 1).
+			Return(&langfuse.Prompt{Name: "support-agent", Version: 1, Labels: []string{"staging", "production.v1.approved-by.jdoe"}}, nil)
+		clientFactory.ProjectClient.EXPECT().UpdatePromptLabels(ctx, "support-agent", 1, []string{"production.v1.approved-by.jdoe"}).
+			Return(&langfuse.Prompt{Name: "support-agent", Version: 1}, nil)
+
+		updateResp := resource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+		r.Update(ctx, resource.UpdateRequest{Plan: modifyResp.Plan, State: createResp.State}, &updateResp)
+		if updateResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics from Update: %v", updateResp.Diagnostics)
+		}
+
+		var state promptResourceModel
+		updateResp.State.Get(ctx, &state)
+		if state.Version.ValueInt64() != 2 {
+			t.Errorf("expected version 2, got %d", state.Version.ValueInt64())
+		}
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		clientFactory.ProjectClient.EXPECT().DeletePrompt(ctx, "support-agent").Return(nil)
+
+		deleteResp := resource.DeleteResponse{State: createResp.State}
+		r.Delete(ctx, resource.DeleteRequest{State: createResp.State}, &deleteResp)
+		if deleteResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics from Delete: %v", deleteResp.Diagnostics)
+		}
+	})
+}
+
+func TestPromptResourceChatImport(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	r := NewPromptResource().(*promptResource)
+	clientFactory := mocks.NewMockClientFactory(ctrl)
+	r.Configure(ctx, resource.ConfigureRequest{ProviderData: clientFactory}, &resource.ConfigureResponse{})
+
+	clientFactory.ProjectClient.EXPECT().GetPromptByLabel(ctx, "triage", "latest").Return(&langfuse.Prompt{
+		Name:    "triage",
+		Version: 4,
+		Type:    "chat",
+		Prompt:  []any{map[string]any{"role": "system", "content": "Triage the ticket."}},
+		Config:  map[string]any{},
+		Labels:  []string{"latest", "production"},
+		Tags:    []string{},
+	}, nil)
+
+	_, schemaResp := resourceObjectValue(ctx, r, map[string]tftypes.Value{})
+	importResp := resource.ImportStateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.ImportState(ctx, resource.ImportStateRequest{ID: "triage,pk-lf-1,sk-lf-1"}, &importResp)
+	if importResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics from ImportState: %v", importResp.Diagnostics)
+	}
+
+	var state promptResourceModel
+	importResp.State.Get(ctx, &state)
+	var messages []promptMessageModel
+	state.Messages.ElementsAs(ctx, &messages, false)
+	if state.Type.ValueString() != "chat" || state.Version.ValueInt64() != 4 || len(messages) != 1 || messages[0].Role.ValueString() != "system" {
+		t.Errorf("unexpected state after ImportState: %+v", state)
+	}
+	if labels := setElements(state.Labels); len(labels) != 1 {
+		t.Errorf("expected the production label, got %v", state.Labels)
+	}
+	if !state.Config.IsNull() || !state.Tags.IsNull() || !state.Prompt.IsNull() {
+		t.Errorf("expected empty config and tags to be null, got %+v", state)
+	}
+}
+
+func TestPromptResourceValidateConfig(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := NewPromptResource().(*promptResource)
+
+	messages := tftypes.NewValue(tftypes.List{ElementType: tftypes.Object{AttributeTypes: map[string]tftypes.Type{"role": tftypes.String, "content": tftypes.String}}},
+		[]tftypes.Value{tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"role": tftypes.String, "content": tftypes.String}}, map[string]tftypes.Value{
+			"role":    tftypes.NewValue(tftypes.String, "system"),
+			"content": tftypes.NewValue(tftypes.String, "Triage the ticket."),
+		})})
+
+	testCases := []struct {
+		name        string
+		values      map[string]tftypes.Value
+		expectError bool
+	}{
+		{name: "text", values: map[string]tftypes.Value{"prompt": tftypes.NewValue(tftypes.String, "Help.")}},
+		{name: "chat", values: map[string]tftypes.Value{"type": tftypes.NewValue(tftypes.String, "chat"), "messages": messages}},
+		{name: "text without prompt", values: map[string]tftypes.Value{"messages": messages}, expectError: true},
+		{name: "chat with prompt", values: map[string]tftypes.Value{"type": tftypes.NewValue(tftypes.String, "chat"), "prompt": tftypes.NewValue(tftypes.String, "Help.")}, expectError: true},
+		{name: "unknown type", values: map[string]tftypes.Value{"type": tftypes.NewValue(tftypes.String, "completion"), "prompt": tftypes.NewValue(tftypes.String, "Help.")}, expectError: true},
+		{name: "invalid config", values: map[string]tftypes.Value{"prompt": tftypes.NewValue(tftypes.String, "Help."), "config": tftypes.NewValue(tftypes.String, "{model")}, expectError: true},
+		{name: "latest label", values: map[string]tftypes.Value{"prompt": tftypes.NewValue(tftypes.String, "Help."), "labels": promptStringSet("latest")}, expectError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.values["name"] = tftypes.NewValue(tftypes.String, "support-agent")
+			config, schemaResp := resourceObjectValue(ctx, r, tc.values)
+
+			var resp resource.ValidateConfigResponse
+			r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config}}, &resp)
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Errorf("expected error: %t, got diagnostics: %v", tc.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -2,25 +2,72 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse/fake"
 )
 
 var _ provider.Provider = &langfuseProvider{}
+var _ provider.ProviderWithValidateConfig = &langfuseProvider{}
+var _ provider.ProviderWithEphemeralResources = &langfuseProvider{}
+var _ provider.ProviderWithFunctions = &langfuseProvider{}
+
+// cloudRegionHosts maps the supported cloud_region values to their Langfuse Cloud host.
+var cloudRegionHosts = map[string]string{
+	"us":    "https://us.cloud.langfuse.com",
+	"eu":    "https://cloud.langfuse.com",
+	"hipaa": "https://hipaa.cloud.langfuse.com",
+}
 
 type langfuseProvider struct {
 	version string
+
+	// fakeServer is started on first configuration when mock = true and lives as long as the provider process.
+	fakeServer *fake.Server
 }
 
 type langfuseProviderModel struct {
-	Host        types.String `tfsdk:"host"`
-	AdminAPIKey types.String `tfsdk:"admin_api_key"`
+	Host         types.String `tfsdk:"host"`
+	CloudRegion  types.String `tfsdk:"cloud_region"`
+	AdminAPIKey  types.String `tfsdk:"admin_api_key"`
+	Credentials  types.Map    `tfsdk:"credentials"`
+	ReadOnly     types.Bool   `tfsdk:"read_only"`
+	AuditLogPath types.String `tfsdk:"audit_log_path"`
+	Mock         types.Bool   `tfsdk:"mock"`
+
+	OrganizationPublicKey types.String `tfsdk:"organization_public_key"`
+	OrganizationSecretKey types.String `tfsdk:"organization_secret_key"`
+	ProjectPublicKey      types.String `tfsdk:"project_public_key"`
+	ProjectSecretKey      types.String `tfsdk:"project_secret_key"`
+
+	KeyCreationConcurrency types.Int64  `tfsdk:"key_creation_concurrency"`
+	WarnUnknownFields      types.Bool   `tfsdk:"warn_unknown_fields"`
+	MaxResponseSizeMB      types.Int64  `tfsdk:"max_response_size_mb"`
+	SensitiveStateSummary  types.Bool   `tfsdk:"sensitive_state_summary"`
+	FastRefresh            types.Bool   `tfsdk:"fast_refresh"`
+	RetentionWarning       types.Bool   `tfsdk:"retention_warning"`
+	RequestHeaders         types.Map    `tfsdk:"request_headers"`
+	ManagedByMetadata      types.Map    `tfsdk:"managed_by_metadata"`
+	StateEncryptionKey     types.String `tfsdk:"state_encryption_key"`
+
+	Retry types.Object `tfsdk:"retry"`
+}
+
+type langfuseProviderCredentialsModel struct {
+	PublicKey  types.String `tfsdk:"public_key"`
+	PrivateKey types.String `tfsdk:"private_key"`
 }
 
 func (p *langfuseProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -33,17 +80,208 @@ func (p *langfuseProvider) Schema(ctx context.Context, req provider.SchemaReques
 		Attributes: map[string]schema.Attribute{
 			"host": schema.StringAttribute{
 				Optional:    true,
-				Description: "Base URI of the Langfuse instance (defaults to https://app.langfuse.com).",
+				Description: "Base URI of the Langfuse instance (defaults to https://app.langfuse.com). Conflicts with `cloud_region`.",
+			},
+			"cloud_region": schema.StringAttribute{
+				Optional:    true,
+				Description: "Langfuse Cloud region to connect to: `us`, `eu` or `hipaa`. Conflicts with `host`.",
 			},
 			"admin_api_key": schema.StringAttribute{
 				Optional:    true,
 				Sensitive:   true,
 				Description: "Admin API key. Only needed when managing organizations. Can also come from LANGFUSE_ADMIN_KEY.",
 			},
+			"read_only": schema.BoolAttribute{
+				Optional:    true,
+				Description: "When true, the provider only sends read requests; any create, update or delete fails before reaching Langfuse.",
+			},
+			"audit_log_path": schema.StringAttribute{
+				Optional: true,
+				Description: "Path of a file to which a JSON line is appended for every create, update or delete request " +
+					"(timestamp, method, API path and outcome). The file is created if it does not exist.",
+			},
+			"mock": schema.BoolAttribute{
+				Optional: true,
+				Description: "When true, the provider talks to an in-memory fake of the Langfuse API started inside the provider process, " +
+					"so modules can be tested without a Langfuse instance. State is lost when the provider process exits. Conflicts with `host` and `cloud_region`.",
+			},
+			"key_creation_concurrency": schema.Int64Attribute{
+				Optional: true,
+				Description: fmt.Sprintf("Maximum number of API key creation requests sent in parallel for the same project (defaults to %d). "+
+					"Creation requests that fail because they raced with another one are retried. Set to 0 to remove the limit.", langfuse.DefaultKeyCreationConcurrency),
+			},
+			"warn_unknown_fields": schema.BoolAttribute{
+				Optional: true,
+				Description: "When true, a warning listing the fields is logged whenever a Langfuse API response contains fields the provider does not model, " +
+					"e.g. after upgrading Langfuse. Visible with TF_LOG=WARN or more verbose.",
+			},
+			"max_response_size_mb": schema.Int64Attribute{
+				Optional: true,
+				Description: fmt.Sprintf("Maximum size, in MiB, of a decompressed Langfuse API response (defaults to %d). Reads of larger responses fail "+
+					"instead of exhausting the memory of the plan. Responses are requested gzip-compressed. Set to 0 to remove the limit.", langfuse.DefaultMaxResponseSize>>20),
+			},
+			"fast_refresh": schema.BoolAttribute{
+				Optional: true,
+				Description: "When true, refreshing `langfuse_organization_api_key`, `langfuse_project_api_key` and `langfuse_organization_membership` " +
+					"keeps their state instead of looking them up in Langfuse's key and membership lists. Changes made outside Terraform are " +
+					"then not detected; resources whose state is incomplete, e.g. right after import, are still read.",
+			},
+			"sensitive_state_summary": schema.BoolAttribute{
+				Optional: true,
+				Description: "When true, every planned resource that will write sensitive values (API keys, secrets) to state reports them " +
+					"in a \"Sensitive values written to state\" warning, so run tasks and policy checks can gate on it.",
+			},
+			"retention_warning": schema.BoolAttribute{
+				Optional: true,
+				Description: fmt.Sprintf("When true, the default, planning a `langfuse_project` whose metadata sets `%s` to `production` or `prod` "+
+					"without a `retention_days` warns that its data is kept indefinitely. Set to false to silence the warning.", productionMetadataKey),
+			},
+			"request_headers": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: fmt.Sprintf("Extra headers sent with every Langfuse API request, e.g. for gateways that route by tenant. Values may "+
+					"reference `${%s}`; a header is only sent by the resources that know every value it references.",
+					strings.Join(langfuse.RequestAttributes, "}` and `${")),
+			},
+			"managed_by_metadata": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: fmt.Sprintf("When set, organizations and projects get `%[1]s = %[2]s` in their metadata whenever they are created or updated, "+
+					"plus `%[1]s-<name>` for every entry, e.g. `{ workspace = terraform.workspace }`, so they are recognisable as Terraform-owned in the UI. "+
+					"In HCP Terraform runs `%[1]s-run-id` is set to TFC_RUN_ID. Set to `{}` to stamp only `%[1]s`. The stamped keys are never "+
+					"shown as drift, also after this is removed, unless declared in a resource's `metadata`.", managedByMetadataKey, managedByMetadataValue),
+			},
+			"state_encryption_key": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Description: "Base64-encoded 256-bit key, e.g. a data key generated by a KMS, used to encrypt the secret of every " +
+					"`langfuse_project_api_key` with `secret_key_storage = \"encrypted\"` before it is written to state. The " +
+					"`langfuse_project_api_key_secret` ephemeral resource decrypts it again. Can also come from LANGFUSE_STATE_ENCRYPTION_KEY.",
+			},
+			"retry": retrySchemaAttribute(),
+			"organization_public_key": schema.StringAttribute{
+				Optional: true,
+				Description: "Organization public key used by resources that set neither an organization key pair nor `credential_ref`. " +
+					"Can also come from LANGFUSE_ORG_PUBLIC_KEY.",
+			},
+			"organization_secret_key": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Organization secret key paired with `organization_public_key`. Can also come from LANGFUSE_ORG_SECRET_KEY.",
+			},
+			"project_public_key": schema.StringAttribute{
+				Optional:    true,
+				Description: "Project public key used by resources that authenticate with a project key pair and do not set one.",
+			},
+			"project_secret_key": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Project secret key paired with `project_public_key`.",
+			},
+			"credentials": schema.MapNestedAttribute{
+				Optional:    true,
+				Description: "Named organization API key pairs. Resources reference them through `credential_ref` so the keys are kept out of their state.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"public_key": schema.StringAttribute{
+							Required:    true,
+							Description: "Organization public key.",
+						},
+						"private_key": schema.StringAttribute{
+							Required:    true,
+							Sensitive:   true,
+							Description: "Organization private key.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+func (p *langfuseProvider) ValidateConfig(ctx context.Context, req provider.ValidateConfigRequest, resp *provider.ValidateConfigResponse) {
+	var config langfuseProviderModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Mock.ValueBool() && (!config.Host.IsNull() || !config.CloudRegion.IsNull()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("mock"),
+			"Conflicting provider configuration",
+			"mock cannot be combined with host or cloud_region, because the provider connects to its own in-memory server.",
+		)
+	}
+
+	if !config.KeyCreationConcurrency.IsNull() && !config.KeyCreationConcurrency.IsUnknown() && config.KeyCreationConcurrency.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("key_creation_concurrency"),
+			"Invalid key creation concurrency",
+			fmt.Sprintf("key_creation_concurrency must be 0 or greater. Got: %d", config.KeyCreationConcurrency.ValueInt64()),
+		)
+	}
+
+	if !config.MaxResponseSizeMB.IsNull() && !config.MaxResponseSizeMB.IsUnknown() && config.MaxResponseSizeMB.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_response_size_mb"),
+			"Invalid maximum response size",
+			fmt.Sprintf("max_response_size_mb must be 0 or greater. Got: %d", config.MaxResponseSizeMB.ValueInt64()),
+		)
+	}
+
+	for name, value := range requestHeaders(config.RequestHeaders) {
+		if strings.TrimSpace(name) == "" {
+			resp.Diagnostics.AddAttributeError(path.Root("request_headers"), "Invalid request header", "Header names must not be empty.")
+			continue
+		}
+		if err := langfuse.ValidateHeaderTemplate(value); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("request_headers").AtMapKey(name),
+				"Invalid request header",
+				fmt.Sprintf("The value of header %s is invalid: %s.", name, err),
+			)
+		}
+	}
+
+	if config.OrganizationPublicKey.IsNull() != config.OrganizationSecretKey.IsNull() {
+		resp.Diagnostics.AddError(
+			"Incomplete organization credentials",
+			"organization_public_key and organization_secret_key must be set together.",
+		)
+	}
+	if config.ProjectPublicKey.IsNull() != config.ProjectSecretKey.IsNull() {
+		resp.Diagnostics.AddError(
+			"Incomplete project credentials",
+			"project_public_key and project_secret_key must be set together.",
+		)
+	}
+
+	_, _, diags := retryPolicies(ctx, config.Retry)
+	resp.Diagnostics.Append(diags...)
+
+	if config.CloudRegion.IsNull() || config.CloudRegion.IsUnknown() {
+		return
+	}
+
+	if !config.Host.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("cloud_region"),
+			"Conflicting provider configuration",
+			"cloud_region cannot be combined with host. Use host for self-hosted instances and cloud_region for Langfuse Cloud.",
+		)
+	}
+
+	if _, ok := cloudRegionHosts[config.CloudRegion.ValueString()]; !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("cloud_region"),
+			"Invalid cloud region",
+			fmt.Sprintf("cloud_region must be one of: us, eu, hipaa. Got: %s", config.CloudRegion.ValueString()),
+		)
+	}
+}
+
 func (p *langfuseProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	var config langfuseProviderModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
@@ -52,9 +290,21 @@ func (p *langfuseProvider) Configure(ctx context.Context, req provider.Configure
 		return
 	}
 
+	// Configuration sourced from values that are only known after apply (e.g. another module's
+	// output) cannot be used to build clients. Defer when Terraform supports it; otherwise configure
+	// with the known values and report the unknown ones where a client is built at plan time, so
+	// that plans which only create resources keep working.
+	unknownAttributes := config.unknownAttributes()
+	if len(unknownAttributes) > 0 && req.ClientCapabilities.DeferralAllowed {
+		resp.Deferred = &provider.Deferred{Reason: provider.DeferredReasonProviderConfigUnknown}
+		return
+	}
+
 	host := "https://app.langfuse.com"
-	if !config.Host.IsNull() && !config.Host.IsUnknown() && config.Host.ValueString() != "" {
+	if !config.Host.IsNull() && config.Host.ValueString() != "" {
 		host = config.Host.ValueString()
+	} else if regionHost, ok := cloudRegionHosts[config.CloudRegion.ValueString()]; ok {
+		host = regionHost
 	}
 
 	apiKey := os.Getenv("LANGFUSE_ADMIN_KEY")
@@ -62,13 +312,244 @@ func (p *langfuseProvider) Configure(ctx context.Context, req provider.Configure
 		apiKey = config.AdminAPIKey.ValueString()
 	}
 
-	clientFactory := langfuse.NewClientFactory(host, apiKey)
+	if config.Mock.ValueBool() {
+		if p.fakeServer == nil {
+			p.fakeServer = fake.NewServer()
+		}
+		host = p.fakeServer.URL
+		apiKey = fake.AdminAPIKey
+	}
+
+	credentials := make(map[string]langfuse.OrganizationCredentials)
+	if !config.Credentials.IsNull() && !config.Credentials.IsUnknown() {
+		var credentialsConfig map[string]types.Object
+		resp.Diagnostics.Append(config.Credentials.ElementsAs(ctx, &credentialsConfig, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		for name, object := range credentialsConfig {
+			// Credentials not known until apply are left out; resources using them report it.
+			if object.IsUnknown() {
+				continue
+			}
+			var c langfuseProviderCredentialsModel
+			resp.Diagnostics.Append(object.As(ctx, &c, basetypes.ObjectAsOptions{})...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			if c.PublicKey.IsUnknown() || c.PrivateKey.IsUnknown() {
+				continue
+			}
+			credentials[name] = langfuse.OrganizationCredentials{
+				PublicKey:  c.PublicKey.ValueString(),
+				PrivateKey: c.PrivateKey.ValueString(),
+			}
+		}
+	}
+
+	clientFactoryOptions := []langfuse.ClientFactoryOption{
+		langfuse.WithOrganizationCredentials(credentials),
+		langfuse.WithReadOnly(config.ReadOnly.ValueBool()),
+		langfuse.WithAuditLog(config.AuditLogPath.ValueString()),
+		langfuse.WithUnknownFieldWarnings(config.WarnUnknownFields.ValueBool()),
+		langfuse.WithSensitiveStateSummary(config.SensitiveStateSummary.ValueBool()),
+		langfuse.WithFastRefresh(config.FastRefresh.ValueBool()),
+		langfuse.WithRetentionWarning(config.RetentionWarning.IsNull() || config.RetentionWarning.ValueBool()),
+	}
+	if headers := requestHeaders(config.RequestHeaders); len(headers) > 0 {
+		clientFactoryOptions = append(clientFactoryOptions, langfuse.WithRequestHeaders(headers))
+	}
+	if !config.ManagedByMetadata.IsNull() && !hasUnknownElements(config.ManagedByMetadata) {
+		settings := make(map[string]string)
+		resp.Diagnostics.Append(config.ManagedByMetadata.ElementsAs(ctx, &settings, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		clientFactoryOptions = append(clientFactoryOptions, langfuse.WithManagedByMetadata(managedByMetadata(settings, os.Getenv("TFC_RUN_ID"))))
+	}
+	stateEncryptionKey := os.Getenv("LANGFUSE_STATE_ENCRYPTION_KEY")
+	if !config.StateEncryptionKey.IsNull() {
+		stateEncryptionKey = config.StateEncryptionKey.ValueString()
+	}
+	if stateEncryptionKey != "" {
+		key, err := parseStateEncryptionKey(stateEncryptionKey)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("state_encryption_key"), "Invalid state encryption key", err.Error())
+			return
+		}
+		clientFactoryOptions = append(clientFactoryOptions, langfuse.WithStateEncryptionKey(key))
+	}
+	if !config.KeyCreationConcurrency.IsNull() {
+		clientFactoryOptions = append(clientFactoryOptions, langfuse.WithKeyCreationConcurrency(int(config.KeyCreationConcurrency.ValueInt64())))
+	}
+	if !config.MaxResponseSizeMB.IsNull() {
+		clientFactoryOptions = append(clientFactoryOptions, langfuse.WithMaxResponseSize(config.MaxResponseSizeMB.ValueInt64()<<20))
+	}
+
+	readRetry, writeRetry, diags := retryPolicies(ctx, config.Retry)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	clientFactoryOptions = append(clientFactoryOptions, langfuse.WithRetryPolicies(readRetry, writeRetry))
+
+	orgPublicKey := os.Getenv("LANGFUSE_ORG_PUBLIC_KEY")
+	orgSecretKey := os.Getenv("LANGFUSE_ORG_SECRET_KEY")
+	if config.OrganizationPublicKey.ValueString() != "" && config.OrganizationSecretKey.ValueString() != "" {
+		orgPublicKey = config.OrganizationPublicKey.ValueString()
+		orgSecretKey = config.OrganizationSecretKey.ValueString()
+	}
+	if orgPublicKey != "" && orgSecretKey != "" {
+		clientFactoryOptions = append(clientFactoryOptions, langfuse.WithDefaultOrganizationCredentials(langfuse.OrganizationCredentials{
+			PublicKey:  orgPublicKey,
+			PrivateKey: orgSecretKey,
+		}))
+	}
+
+	if config.ProjectPublicKey.ValueString() != "" && config.ProjectSecretKey.ValueString() != "" {
+		clientFactoryOptions = append(clientFactoryOptions, langfuse.WithDefaultProjectCredentials(langfuse.ProjectCredentials{
+			PublicKey: config.ProjectPublicKey.ValueString(),
+			SecretKey: config.ProjectSecretKey.ValueString(),
+		}))
+	}
+
+	var clientFactory langfuse.ClientFactory = langfuse.NewClientFactory(host, apiKey, clientFactoryOptions...)
+	if len(unknownAttributes) > 0 {
+		clientFactory = &partialClientFactory{ClientFactory: clientFactory, unknown: unknownAttributes}
+	}
 	resp.DataSourceData = clientFactory
 	resp.ResourceData = clientFactory
+	resp.EphemeralResourceData = clientFactory
+}
+
+func (m langfuseProviderModel) unknownAttributes() []string {
+	var unknown []string
+	if m.Host.IsUnknown() {
+		unknown = append(unknown, "host")
+	}
+	if m.CloudRegion.IsUnknown() {
+		unknown = append(unknown, "cloud_region")
+	}
+	if m.AdminAPIKey.IsUnknown() {
+		unknown = append(unknown, "admin_api_key")
+	}
+	if m.ReadOnly.IsUnknown() {
+		unknown = append(unknown, "read_only")
+	}
+	if m.Mock.IsUnknown() {
+		unknown = append(unknown, "mock")
+	}
+	if m.AuditLogPath.IsUnknown() {
+		unknown = append(unknown, "audit_log_path")
+	}
+	if m.KeyCreationConcurrency.IsUnknown() {
+		unknown = append(unknown, "key_creation_concurrency")
+	}
+	if m.WarnUnknownFields.IsUnknown() {
+		unknown = append(unknown, "warn_unknown_fields")
+	}
+	if m.MaxResponseSizeMB.IsUnknown() {
+		unknown = append(unknown, "max_response_size_mb")
+	}
+	if m.SensitiveStateSummary.IsUnknown() {
+		unknown = append(unknown, "sensitive_state_summary")
+	}
+	if m.FastRefresh.IsUnknown() {
+		unknown = append(unknown, "fast_refresh")
+	}
+	if m.RetentionWarning.IsUnknown() {
+		unknown = append(unknown, "retention_warning")
+	}
+	if hasUnknownElements(m.RequestHeaders) {
+		unknown = append(unknown, "request_headers")
+	}
+	if hasUnknownElements(m.ManagedByMetadata) {
+		unknown = append(unknown, "managed_by_metadata")
+	}
+	if m.StateEncryptionKey.IsUnknown() {
+		unknown = append(unknown, "state_encryption_key")
+	}
+	if hasUnknownRetry(m.Retry) {
+		unknown = append(unknown, "retry")
+	}
+	if hasUnknownCredentials(m.Credentials) {
+		unknown = append(unknown, "credentials")
+	}
+	if m.OrganizationPublicKey.IsUnknown() {
+		unknown = append(unknown, "organization_public_key")
+	}
+	if m.OrganizationSecretKey.IsUnknown() {
+		unknown = append(unknown, "organization_secret_key")
+	}
+	if m.ProjectPublicKey.IsUnknown() {
+		unknown = append(unknown, "project_public_key")
+	}
+	if m.ProjectSecretKey.IsUnknown() {
+		unknown = append(unknown, "project_secret_key")
+	}
+	return unknown
+}
+
+func hasUnknownElements(m types.Map) bool {
+	if m.IsUnknown() {
+		return true
+	}
+	for _, element := range m.Elements() {
+		if element.IsUnknown() {
+			return true
+		}
+	}
+	return false
+}
+
+// requestHeaders returns the configured request headers, leaving out values that are not known yet.
+func requestHeaders(headers types.Map) map[string]string {
+	elements := make(map[string]string, len(headers.Elements()))
+	for name, element := range headers.Elements() {
+		if value, ok := element.(types.String); ok && !value.IsNull() && !value.IsUnknown() {
+			elements[name] = value.ValueString()
+		}
+	}
+	return elements
+}
+
+func hasUnknownCredentials(credentials types.Map) bool {
+	if credentials.IsUnknown() {
+		return true
+	}
+	for _, element := range credentials.Elements() {
+		if element.IsUnknown() {
+			return true
+		}
+		object, ok := element.(types.Object)
+		if !ok {
+			continue
+		}
+		for _, value := range object.Attributes() {
+			if value.IsUnknown() {
+				return true
+			}
+		}
+	}
+	return false
 }
 
 func (p *langfuseProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewImportInventoryDataSource,
+		NewSCIMUserDataSource,
+		NewIngestionCheckDataSource,
+		NewObservabilityConfigDataSource,
+		NewTraceCountsDataSource,
+		NewOrganizationApiKeysDataSource,
+		NewAuditLogsDataSource,
+		NewUnmanagedReportDataSource,
+		NewProjectApiKeyImportsDataSource,
+		NewOrganizationMembershipsDataSource,
+		NewProjectDataSource,
+		NewProjectsDataSource,
+		NewOrganizationDataSource,
+	}
 }
 
 func (p *langfuseProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -78,6 +559,25 @@ func (p *langfuseProvider) Resources(ctx context.Context) []func() resource.Reso
 		NewOrganizationMembershipResource,
 		NewProjectResource,
 		NewProjectApiKeyResource,
+		NewProjectApiKeysPolicyResource,
+		NewScoreResource,
+		NewPromptResource,
+		NewPromptReleaseResource,
+		NewOrganizationRetentionPolicyResource,
+	}
+}
+
+func (p *langfuseProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewOrganizationApiKeyEphemeralResource,
+		NewProjectApiKeySecretEphemeralResource,
+	}
+}
+
+func (p *langfuseProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewNormalizeMetadataFunction,
+		NewVerifyWebhookSignatureFunction,
 	}
 }
 
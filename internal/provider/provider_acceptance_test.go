@@ -1,7 +1,10 @@
 package provider
 
 import (
+	"context"
+	"flag"
 	"fmt"
+	"log"
 	"math/rand"
 	"os"
 	"testing"
@@ -11,8 +14,36 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/langfuse/terraform-provider-langfuse/internal/testenv"
 )
 
+// TestMain starts a disposable Langfuse instance through internal/testenv when acceptance tests
+// are enabled but no LANGFUSE_HOST is given, so they run anywhere Docker is available. Sweeper
+// runs (-sweep) and runs against an existing instance go straight to the plugin testing harness.
+func TestMain(m *testing.M) {
+	flag.Parse()
+	if os.Getenv("TF_ACC") != "1" || os.Getenv("LANGFUSE_HOST") != "" || flag.Lookup("sweep").Value.String() != "" {
+		resource.TestMain(m)
+		return
+	}
+
+	ctx := context.Background()
+	log.Println("[INFO] LANGFUSE_HOST is not set, starting a Langfuse test environment")
+	env, err := testenv.Start(ctx)
+	if err != nil {
+		log.Fatalf("failed to start the Langfuse test environment: %v", err)
+	}
+	if err := env.Export(); err != nil {
+		log.Fatalf("failed to export the Langfuse test environment: %v", err)
+	}
+
+	code := m.Run()
+	if err := env.Terminate(ctx); err != nil {
+		log.Printf("[WARN] failed to terminate the Langfuse test environment: %v", err)
+	}
+	os.Exit(code)
+}
+
 // TestAccLangfuseWorkflow tests the complete workflow of creating and managing
 // all Langfuse resources in the correct dependency order:
 // Organization -> Organization API Key -> Project -> Project API Key
@@ -344,10 +375,12 @@ func TestAccLangfuseOrganizationImport(t *testing.T) {
 			},
 			// Step 3: Import the project using the structured format
 			{
-				ResourceName:            "langfuse_project.import_test",
-				ImportState:             true,
-				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"retention_days"}, // Ignore retention_days since it's write-only in Langfuse API
+				ResourceName:      "langfuse_project.import_test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Instances before API version 2 only report the retention through endpoints the import
+				// cannot use, so it is imported as null there.
+				ImportStateVerifyIgnore: []string{"retention_days"},
 				// We need to use the structured import format: project_id,organization_id,organization_public_key,organization_private_key
 				ImportStateIdFunc: func(s *terraform.State) (string, error) {
 					// Get the project ID from state
@@ -376,6 +409,20 @@ func TestAccLangfuseOrganizationImport(t *testing.T) {
 					return fmt.Sprintf("%s,%s,%s,%s", projectID, orgID, publicKey, privateKey), nil
 				},
 			},
+			// Step 3b: Import the organization API key together with its secret key
+			{
+				ResourceName:      "langfuse_organization_api_key.import_test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					orgKeyRs, ok := s.RootModule().Resources["langfuse_organization_api_key.import_test"]
+					if !ok {
+						return "", fmt.Errorf("not found: langfuse_organization_api_key.import_test")
+					}
+					attributes := orgKeyRs.Primary.Attributes
+					return fmt.Sprintf("%s,%s,%s", attributes["organization_id"], orgKeyRs.Primary.ID, attributes["secret_key"]), nil
+				},
+			},
 			// Step 4: Verify imports worked and we can still manage the resources
 			{
 				Config: testAccLangfuseOrganizationImportConfig_Update(orgName, projectName),
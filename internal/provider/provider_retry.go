@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+)
+
+type langfuseProviderRetryModel struct {
+	Read  types.Object `tfsdk:"read"`
+	Write types.Object `tfsdk:"write"`
+}
+
+type langfuseProviderRetryPolicyModel struct {
+	MaxRetries types.Int64  `tfsdk:"max_retries"`
+	Backoff    types.String `tfsdk:"backoff"`
+}
+
+func retrySchemaAttribute() schema.Attribute {
+	policy := func(kind string, defaults langfuse.RetryPolicy) schema.Attribute {
+		return schema.SingleNestedAttribute{
+			Optional:    true,
+			Description: fmt.Sprintf("Retries of %s.", kind),
+			Attributes: map[string]schema.Attribute{
+				"max_retries": schema.Int64Attribute{
+					Optional:    true,
+					Description: fmt.Sprintf("Number of retries after the first attempt (defaults to %d). 0 disables retries.", defaults.MaxRetries),
+				},
+				"backoff": schema.StringAttribute{
+					Optional: true,
+					Description: fmt.Sprintf("Wait before the first retry as a duration such as `2s` (defaults to `%s`); it doubles with every further retry.",
+						defaults.Backoff),
+				},
+			},
+		}
+	}
+
+	return schema.SingleNestedAttribute{
+		Optional: true,
+		Description: "Retries of requests that failed with a network error or with status 429, 502, 503 or 504. Reads (GET requests, e.g. during refresh) " +
+			"and writes (creates, updates, deletes) are configured separately, because a write that timed out may still have been applied.",
+		Attributes: map[string]schema.Attribute{
+			"read":  policy("reads", langfuse.DefaultReadRetryPolicy),
+			"write": policy("writes", langfuse.DefaultWriteRetryPolicy),
+		},
+	}
+}
+
+// retryPolicies returns the read and write retry policies configured in retry, falling back to
+// the defaults for everything that is not set. Invalid values are reported as attribute errors.
+func retryPolicies(ctx context.Context, retry types.Object) (langfuse.RetryPolicy, langfuse.RetryPolicy, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	read, write := langfuse.DefaultReadRetryPolicy, langfuse.DefaultWriteRetryPolicy
+	if retry.IsNull() || retry.IsUnknown() {
+		return read, write, diags
+	}
+
+	var config langfuseProviderRetryModel
+	diags.Append(retry.As(ctx, &config, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return read, write, diags
+	}
+
+	read = retryPolicy(ctx, config.Read, "read", read, &diags)
+	write = retryPolicy(ctx, config.Write, "write", write, &diags)
+	return read, write, diags
+}
+
+func retryPolicy(ctx context.Context, object types.Object, name string, policy langfuse.RetryPolicy, diags *diag.Diagnostics) langfuse.RetryPolicy {
+	if object.IsNull() || object.IsUnknown() {
+		return policy
+	}
+
+	var config langfuseProviderRetryPolicyModel
+	diags.Append(object.As(ctx, &config, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return policy
+	}
+
+	attributePath := path.Root("retry").AtName(name)
+	if !config.MaxRetries.IsNull() && !config.MaxRetries.IsUnknown() {
+		if config.MaxRetries.ValueInt64() < 0 {
+			diags.AddAttributeError(
+				attributePath.AtName("max_retries"),
+				"Invalid retry configuration",
+				fmt.Sprintf("max_retries must be 0 or greater. Got: %d", config.MaxRetries.ValueInt64()),
+			)
+		}
+		policy.MaxRetries = int(config.MaxRetries.ValueInt64())
+	}
+	if !config.Backoff.IsNull() && !config.Backoff.IsUnknown() {
+		backoff, err := time.ParseDuration(config.Backoff.ValueString())
+		if err != nil || backoff <= 0 {
+			diags.AddAttributeError(
+				attributePath.AtName("backoff"),
+				"Invalid retry configuration",
+				fmt.Sprintf("backoff must be a positive duration such as \"2s\". Got: %s", config.Backoff.ValueString()),
+			)
+		}
+		policy.Backoff = backoff
+	}
+	return policy
+}
+
+// hasUnknownRetry reports whether any part of the retry configuration is unknown.
+func hasUnknownRetry(retry types.Object) bool {
+	if retry.IsUnknown() {
+		return true
+	}
+	for _, policy := range retry.Attributes() {
+		if policy.IsUnknown() {
+			return true
+		}
+		object, ok := policy.(types.Object)
+		if !ok {
+			continue
+		}
+		for _, value := range object.Attributes() {
+			if value.IsUnknown() {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,389 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+)
+
+func TestProviderSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	p := New("test")()
+
+	var schemaResp provider.SchemaResponse
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics from Schema: %v", schemaResp.Diagnostics)
+	}
+
+	if diags := schemaResp.Schema.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("schema implementation validation failed: %v", diags)
+	}
+}
+
+func TestProviderValidateConfig(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	p := New("test")().(*langfuseProvider)
+
+	var schemaResp provider.SchemaResponse
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+
+	testCases := []struct {
+		name        string
+		values      map[string]tftypes.Value
+		expectError bool
+	}{
+		{
+			name: "cloud region",
+			values: map[string]tftypes.Value{
+				"cloud_region": tftypes.NewValue(tftypes.String, "eu"),
+			},
+		},
+		{
+			name: "invalid cloud region",
+			values: map[string]tftypes.Value{
+				"cloud_region": tftypes.NewValue(tftypes.String, "apac"),
+			},
+			expectError: true,
+		},
+		{
+			name: "cloud region with host",
+			values: map[string]tftypes.Value{
+				"host":         tftypes.NewValue(tftypes.String, "https://langfuse.example.com"),
+				"cloud_region": tftypes.NewValue(tftypes.String, "us"),
+			},
+			expectError: true,
+		},
+		{
+			name: "mock with host",
+			values: map[string]tftypes.Value{
+				"host": tftypes.NewValue(tftypes.String, "https://langfuse.example.com"),
+				"mock": tftypes.NewValue(tftypes.Bool, true),
+			},
+			expectError: true,
+		},
+		{
+			name: "provider key pairs",
+			values: map[string]tftypes.Value{
+				"organization_public_key": tftypes.NewValue(tftypes.String, "pk-lf-org"),
+				"organization_secret_key": tftypes.NewValue(tftypes.String, "sk-lf-org"),
+				"project_public_key":      tftypes.NewValue(tftypes.String, "pk-lf-project"),
+				"project_secret_key":      tftypes.NewValue(tftypes.String, "sk-lf-project"),
+			},
+		},
+		{
+			name: "incomplete project key pair",
+			values: map[string]tftypes.Value{
+				"project_public_key": tftypes.NewValue(tftypes.String, "pk-lf-project"),
+			},
+			expectError: true,
+		},
+		{
+			name: "negative key creation concurrency",
+			values: map[string]tftypes.Value{
+				"key_creation_concurrency": tftypes.NewValue(tftypes.Number, -1),
+			},
+			expectError: true,
+		},
+		{
+			name: "negative max response size",
+			values: map[string]tftypes.Value{
+				"max_response_size_mb": tftypes.NewValue(tftypes.Number, -1),
+			},
+			expectError: true,
+		},
+		{
+			name: "retry policies",
+			values: map[string]tftypes.Value{
+				"retry": retryConfigValue(ctx, schemaResp, 5, "2s", 0, "1s"),
+			},
+		},
+		{
+			name: "negative max retries",
+			values: map[string]tftypes.Value{
+				"retry": retryConfigValue(ctx, schemaResp, -1, "2s", 0, "1s"),
+			},
+			expectError: true,
+		},
+		{
+			name: "request headers",
+			values: map[string]tftypes.Value{
+				"request_headers": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+					"X-Org-Id": tftypes.NewValue(tftypes.String, "${organization_id}"),
+				}),
+			},
+		},
+		{
+			name: "unknown request header placeholder",
+			values: map[string]tftypes.Value{
+				"request_headers": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+					"X-Org-Id": tftypes.NewValue(tftypes.String, "${org_id}"),
+				}),
+			},
+			expectError: true,
+		},
+		{
+			name: "invalid backoff",
+			values: map[string]tftypes.Value{
+				"retry": retryConfigValue(ctx, schemaResp, 5, "2s", 1, "soon"),
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var resp provider.ValidateConfigResponse
+			p.ValidateConfig(ctx, provider.ValidateConfigRequest{Config: buildProviderConfig(ctx, schemaResp, tc.values)}, &resp)
+
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Fatalf("unexpected validation result. got error=%t, want error=%t: %v", resp.Diagnostics.HasError(), tc.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestProviderConfigure(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	p := New("test")()
+
+	var schemaResp provider.SchemaResponse
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+
+	t.Run("Known configuration", func(t *testing.T) {
+		config := buildProviderConfig(ctx, schemaResp, map[string]tftypes.Value{
+			"host":          tftypes.NewValue(tftypes.String, "http://localhost:3000"),
+			"admin_api_key": tftypes.NewValue(tftypes.String, "admin-key"),
+		})
+
+		var resp provider.ConfigureResponse
+		p.Configure(ctx, provider.ConfigureRequest{Config: config}, &resp)
+
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics from Configure: %v", resp.Diagnostics)
+		}
+		if resp.Deferred != nil {
+			t.Fatalf("unexpected deferral: %v", resp.Deferred)
+		}
+		if _, ok := resp.ResourceData.(langfuse.ClientFactory); !ok {
+			t.Fatalf("expected ResourceData to be a langfuse.ClientFactory, got %T", resp.ResourceData)
+		}
+	})
+
+	t.Run("Provider key pairs", func(t *testing.T) {
+		config := buildProviderConfig(ctx, schemaResp, map[string]tftypes.Value{
+			"host":                    tftypes.NewValue(tftypes.String, "http://localhost:3000"),
+			"organization_public_key": tftypes.NewValue(tftypes.String, "pk-lf-org"),
+			"organization_secret_key": tftypes.NewValue(tftypes.String, "sk-lf-org"),
+			"project_public_key":      tftypes.NewValue(tftypes.String, "pk-lf-project"),
+			"project_secret_key":      tftypes.NewValue(tftypes.String, "sk-lf-project"),
+		})
+
+		var resp provider.ConfigureResponse
+		p.Configure(ctx, provider.ConfigureRequest{Config: config}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics from Configure: %v", resp.Diagnostics)
+		}
+
+		clientFactory := resp.ResourceData.(langfuse.ClientFactory)
+		if credentials, ok := clientFactory.DefaultOrganizationCredentials(); !ok || credentials.PublicKey != "pk-lf-org" || credentials.PrivateKey != "sk-lf-org" {
+			t.Errorf("unexpected default organization credentials %+v", credentials)
+		}
+		if credentials, ok := clientFactory.DefaultProjectCredentials(); !ok || credentials.PublicKey != "pk-lf-project" || credentials.SecretKey != "sk-lf-project" {
+			t.Errorf("unexpected default project credentials %+v", credentials)
+		}
+	})
+
+	t.Run("Unknown admin key with deferral allowed", func(t *testing.T) {
+		config := buildProviderConfig(ctx, schemaResp, map[string]tftypes.Value{
+			"admin_api_key": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		})
+
+		var resp provider.ConfigureResponse
+		p.Configure(ctx, provider.ConfigureRequest{
+			Config:             config,
+			ClientCapabilities: provider.ConfigureProviderClientCapabilities{DeferralAllowed: true},
+		}, &resp)
+
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics from Configure: %v", resp.Diagnostics)
+		}
+		if resp.Deferred == nil || resp.Deferred.Reason != provider.DeferredReasonProviderConfigUnknown {
+			t.Fatalf("expected the provider configuration to be deferred, got %v", resp.Deferred)
+		}
+		if resp.ResourceData != nil {
+			t.Fatalf("expected no ResourceData when deferred, got %T", resp.ResourceData)
+		}
+	})
+
+	t.Run("Invalid state encryption key", func(t *testing.T) {
+		config := buildProviderConfig(ctx, schemaResp, map[string]tftypes.Value{
+			"state_encryption_key": tftypes.NewValue(tftypes.String, "c2hvcnQ="),
+		})
+
+		var resp provider.ConfigureResponse
+		p.Configure(ctx, provider.ConfigureRequest{Config: config}, &resp)
+
+		if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Invalid state encryption key" {
+			t.Fatalf("expected an invalid state encryption key error, got: %v", resp.Diagnostics)
+		}
+	})
+
+	t.Run("Unknown values without deferral support", func(t *testing.T) {
+		credentialsType := schemaResp.Schema.Attributes["credentials"].GetType().TerraformType(ctx).(tftypes.Map)
+		credentialType := credentialsType.ElementType.(tftypes.Object)
+		config := buildProviderConfig(ctx, schemaResp, map[string]tftypes.Value{
+			"host":          tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"admin_api_key": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"credentials": tftypes.NewValue(credentialsType, map[string]tftypes.Value{
+				"known": tftypes.NewValue(credentialType, map[string]tftypes.Value{
+					"public_key":  tftypes.NewValue(tftypes.String, "pk-lf-known"),
+					"private_key": tftypes.NewValue(tftypes.String, "sk-lf-known"),
+				}),
+				"ephemeral": tftypes.NewValue(credentialType, map[string]tftypes.Value{
+					"public_key":  tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+					"private_key": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				}),
+			}),
+		})
+
+		var resp provider.ConfigureResponse
+		p.Configure(ctx, provider.ConfigureRequest{Config: config}, &resp)
+
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics from Configure: %v", resp.Diagnostics)
+		}
+		clientFactory, ok := resp.ResourceData.(langfuse.ClientFactory)
+		if !ok {
+			t.Fatalf("expected ResourceData to be a langfuse.ClientFactory, got %T", resp.ResourceData)
+		}
+		if !clientFactory.HasAdminAPIKey() {
+			t.Error("expected an unknown admin API key not to fail resources in Configure")
+		}
+		if _, ok := clientFactory.OrganizationCredentials("known"); !ok {
+			t.Error("expected the known credentials to be registered")
+		}
+		if !providerHostUnknown(clientFactory) {
+			t.Error("expected the host to be reported as unknown")
+		}
+
+		_, diags := newOrganizationClient(clientFactory, types.StringNull(), types.StringNull(), types.StringValue("known"))
+		if !diags.HasError() || diags.Errors()[0].Summary() != "Provider configuration value unknown at plan time" {
+			t.Fatalf("expected an unknown configuration error when building a client, got: %v", diags)
+		}
+		if detail := diags.Errors()[0].Detail(); !strings.Contains(detail, "host, admin_api_key, credentials") {
+			t.Errorf("expected the unknown attributes in the error, got %q", detail)
+		}
+	})
+}
+
+func TestProviderMockConfiguration(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	p := New("test")()
+
+	var schemaResp provider.SchemaResponse
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+
+	config := buildProviderConfig(ctx, schemaResp, map[string]tftypes.Value{
+		"mock": tftypes.NewValue(tftypes.Bool, true),
+	})
+
+	var resp provider.ConfigureResponse
+	p.Configure(ctx, provider.ConfigureRequest{Config: config}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics from Configure: %v", resp.Diagnostics)
+	}
+	defer p.(*langfuseProvider).fakeServer.Close()
+
+	clientFactory := resp.ResourceData.(langfuse.ClientFactory)
+	if version, err := clientFactory.InstanceVersion(ctx); err != nil || version == "" {
+		t.Fatalf("expected the fake server to report a version, got %q, %v", version, err)
+	}
+
+	adminClient := clientFactory.NewAdminClient()
+	organization, err := adminClient.CreateOrganization(ctx, &langfuse.CreateOrganizationRequest{Name: "Acme Inc"})
+	if err != nil {
+		t.Fatalf("failed to create organization: %v", err)
+	}
+	organizationKey, err := adminClient.CreateOrganizationApiKey(ctx, organization.ID)
+	if err != nil {
+		t.Fatalf("failed to create organization API key: %v", err)
+	}
+
+	organizationClient := clientFactory.NewOrganizationClient(organizationKey.PublicKey, organizationKey.SecretKey)
+	project, err := organizationClient.CreateProject(ctx, &langfuse.CreateProjectRequest{Name: "Chat QA"})
+	if err != nil {
+		t.Fatalf("failed to create project: %v", err)
+	}
+	if _, err := organizationClient.GetProject(ctx, project.ID); err != nil {
+		t.Fatalf("failed to read project back: %v", err)
+	}
+
+	if err := adminClient.DeleteOrganization(ctx, organization.ID); err == nil {
+		t.Error("expected deleting an organization with projects to fail")
+	}
+
+	if _, err := clientFactory.NewOrganizationClient("pk-lf-unknown", "sk-lf-unknown").ListProjects(ctx); err == nil {
+		t.Error("expected an unknown organization key to be rejected")
+	}
+}
+
+// buildProviderConfig builds a provider configuration where every attribute not in values is null.
+func buildProviderConfig(ctx context.Context, schemaResp provider.SchemaResponse, values map[string]tftypes.Value) tfsdk.Config {
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	for name, attributeType := range objectType.AttributeTypes {
+		if _, ok := values[name]; !ok {
+			values[name] = tftypes.NewValue(attributeType, nil)
+		}
+	}
+	return tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(objectType, values),
+	}
+}
+
+func retryConfigValue(ctx context.Context, schemaResp provider.SchemaResponse, readRetries int, readBackoff string, writeRetries int, writeBackoff string) tftypes.Value {
+	retryType := schemaResp.Schema.Attributes["retry"].GetType().TerraformType(ctx).(tftypes.Object)
+	policyType := retryType.AttributeTypes["read"].(tftypes.Object)
+	policy := func(maxRetries int, backoff string) tftypes.Value {
+		return tftypes.NewValue(policyType, map[string]tftypes.Value{
+			"max_retries": tftypes.NewValue(tftypes.Number, maxRetries),
+			"backoff":     tftypes.NewValue(tftypes.String, backoff),
+		})
+	}
+	return tftypes.NewValue(retryType, map[string]tftypes.Value{
+		"read":  policy(readRetries, readBackoff),
+		"write": policy(writeRetries, writeBackoff),
+	})
+}
+
+// resourceObjectValue returns the schema of a resource and an object of it holding values, with
+// the attributes a test does not spell out null.
+func resourceObjectValue(ctx context.Context, r resource.Resource, values map[string]tftypes.Value) (tftypes.Value, resource.SchemaResponse) {
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	for name, attributeType := range objectType.AttributeTypes {
+		if _, ok := values[name]; !ok {
+			values[name] = tftypes.NewValue(attributeType, nil)
+		}
+	}
+	return tftypes.NewValue(objectType, values), schemaResp
+}
@@ -0,0 +1,22 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+)
+
+// withRequestAttributes makes the requests sent with ctx carry the organization and project a
+// resource belongs to, for the ${organization_id} and ${project_id} placeholders of the provider's
+// request_headers. Null and unknown values are left out, and ctx is returned as is when the provider
+// sends no extra headers.
+func withRequestAttributes(ctx context.Context, clientFactory langfuse.ClientFactory, organizationID, projectID types.String) context.Context {
+	if clientFactory == nil || !clientFactory.HasRequestHeaders() {
+		return ctx
+	}
+	return langfuse.WithRequestAttributes(ctx, map[string]string{
+		langfuse.RequestAttributeOrganizationID: organizationID.ValueString(),
+		langfuse.RequestAttributeProjectID:      projectID.ValueString(),
+	})
+}
@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse/mocks"
+)
+
+func TestWithRequestAttributes(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	clientFactory := mocks.NewMockClientFactory(ctrl)
+
+	// Without request headers the context is passed on unchanged, so clients see the caller's context.
+	if got := withRequestAttributes(ctx, clientFactory, types.StringValue("org-1"), types.StringNull()); got != ctx {
+		t.Error("expected the context to be unchanged without request headers")
+	}
+
+	clientFactory.TemplatedHeaders = true
+	if got := withRequestAttributes(ctx, clientFactory, types.StringValue("org-1"), types.StringNull()); got == ctx {
+		t.Error("expected request attributes to be added to the context")
+	}
+}
@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+)
+
+// productionMetadataKey is the project metadata key whose value marks a project as production.
+const productionMetadataKey = "environment"
+
+var productionEnvironments = []string{"production", "prod"}
+
+// addRetentionWarning warns, unless the provider sets retention_warning to false, when the planned
+// project is marked as production in its metadata but keeps its data indefinitely, i.e. has no
+// retention_days or 0. Plans with unknown values are not checked; the apply plan will be.
+func addRetentionWarning(ctx context.Context, clientFactory langfuse.ClientFactory, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if clientFactory == nil || !clientFactory.RetentionWarning() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data projectResourceModel
+	if diags := req.Plan.Get(ctx, &data); diags.HasError() {
+		return
+	}
+	if data.RetentionDays.IsUnknown() || data.RetentionDays.ValueInt32() > 0 || data.Metadata.IsUnknown() {
+		return
+	}
+
+	metadata, diags := metadataElements(ctx, data.Metadata)
+	if diags.HasError() {
+		return
+	}
+	environment, ok := metadata[productionMetadataKey]
+	if !ok || !slices.Contains(productionEnvironments, strings.ToLower(environment)) {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root("retention_days"),
+		"Production project keeps data indefinitely",
+		fmt.Sprintf("Project %q is marked as %s through its %s metadata but sets no retention_days, so Langfuse stores its traces "+
+			"indefinitely. Set retention_days to the retention period of the data-retention standard, or set retention_warning = false "+
+			"on the provider to silence this warning.", data.Name.ValueString(), environment, productionMetadataKey),
+	)
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse/mocks"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestProjectRetentionWarning(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	NewProjectResource().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	resourceSchema := schemaResp.Schema
+	emptyState := tfsdk.State{Schema: resourceSchema, Raw: tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil)}
+
+	metadata := func(environment string) tftypes.Value {
+		return tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"environment": tftypes.NewValue(tftypes.String, environment),
+		})
+	}
+
+	testCases := []struct {
+		name     string
+		values   map[string]tftypes.Value
+		disabled bool
+		warns    bool
+	}{
+		{
+			name:   "production without retention",
+			values: map[string]tftypes.Value{"metadata": metadata("Production")},
+			warns:  true,
+		},
+		{
+			name:   "production with zero retention",
+			values: map[string]tftypes.Value{"metadata": metadata("prod"), "retention_days": tftypes.NewValue(tftypes.Number, 0)},
+			warns:  true,
+		},
+		{
+			name:   "production with retention",
+			values: map[string]tftypes.Value{"metadata": metadata("production"), "retention_days": tftypes.NewValue(tftypes.Number, 90)},
+		},
+		{
+			name:   "production with unknown retention",
+			values: map[string]tftypes.Value{"metadata": metadata("production"), "retention_days": tftypes.NewValue(tftypes.Number, tftypes.UnknownValue)},
+		},
+		{
+			name:   "staging",
+			values: map[string]tftypes.Value{"metadata": metadata("staging")},
+		},
+		{
+			name:   "no metadata",
+			values: map[string]tftypes.Value{},
+		},
+		{
+			name:     "disabled",
+			values:   map[string]tftypes.Value{"metadata": metadata("production")},
+			disabled: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			r := NewProjectResource().(*projectResource)
+			clientFactory := mocks.NewMockClientFactory(ctrl)
+			clientFactory.NoRetentionWarning = tc.disabled
+			r.ClientFactory = clientFactory
+
+			tc.values["name"] = tftypes.NewValue(tftypes.String, "checkout")
+			planned := tfsdk.Plan{Schema: resourceSchema, Raw: buildProjectObjectValue(tc.values)}
+			resp := resource.ModifyPlanResponse{Plan: planned}
+			r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: planned, State: emptyState}, &resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+			}
+			if warns := resp.Diagnostics.WarningsCount() == 1; warns != tc.warns {
+				t.Errorf("expected warning %t, got: %v", tc.warns, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package provider
+
+import (
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+)
+
+// runtimeOf returns the clock and ID generator of the client factory, or the system ones when
+// the resource has not been configured, as in unit tests that call helpers directly.
+func runtimeOf(clientFactory langfuse.ClientFactory) langfuse.Runtime {
+	if clientFactory == nil {
+		return langfuse.DefaultRuntime()
+	}
+	return clientFactory.Runtime()
+}
@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+)
+
+var _ datasource.DataSource = &scimUserDataSource{}
+
+func NewSCIMUserDataSource() datasource.DataSource {
+	return &scimUserDataSource{}
+}
+
+type scimUserDataSourceModel struct {
+	ID                     types.String `tfsdk:"id"`
+	Email                  types.String `tfsdk:"email"`
+	UserName               types.String `tfsdk:"user_name"`
+	DisplayName            types.String `tfsdk:"display_name"`
+	ExternalID             types.String `tfsdk:"external_id"`
+	Active                 types.Bool   `tfsdk:"active"`
+	OrganizationPublicKey  types.String `tfsdk:"organization_public_key"`
+	OrganizationPrivateKey types.String `tfsdk:"organization_private_key"`
+	CredentialRef          types.String `tfsdk:"credential_ref"`
+}
+
+type scimUserDataSource struct {
+	ClientFactory langfuse.ClientFactory
+}
+
+func (d *scimUserDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clientFactory, ok := req.ProviderData.(langfuse.ClientFactory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected langfuse.ClientFactory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.ClientFactory = clientFactory
+}
+
+func (d *scimUserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scim_user"
+}
+
+func (d *scimUserDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up a user of an organization by email through the SCIM endpoint, e.g. to reference its ID without managing the user or its membership.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The user ID.",
+			},
+			"email": schema.StringAttribute{
+				Required:    true,
+				Description: "Email address of the user.",
+			},
+			"user_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The SCIM userName of the user.",
+			},
+			"display_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The SCIM displayName of the user.",
+			},
+			"external_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The SCIM externalId of the user, if set.",
+			},
+			"active": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the user is active.",
+			},
+			"organization_public_key": schema.StringAttribute{
+				Optional:    true,
+				Description: "Organization public key to authenticate the call. Conflicts with `credential_ref`. Defaults to LANGFUSE_ORG_PUBLIC_KEY.",
+			},
+			"organization_private_key": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Organization private key to authenticate the call. Conflicts with `credential_ref`. Defaults to LANGFUSE_ORG_SECRET_KEY.",
+			},
+			"credential_ref": schema.StringAttribute{
+				Optional:    true,
+				Description: "Name of organization credentials declared in the provider `credentials` block, used instead of `organization_public_key`/`organization_private_key`.",
+			},
+		},
+	}
+}
+
+func (d *scimUserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	resp.Diagnostics.Append(checkInstanceVersion(ctx, d.ClientFactory, "langfuse_scim_user")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data scimUserDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateOrganizationCredentials(data.OrganizationPublicKey, data.OrganizationPrivateKey, data.CredentialRef)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationClient, diags := newOrganizationClient(d.ClientFactory, data.OrganizationPublicKey, data.OrganizationPrivateKey, data.CredentialRef)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := organizationClient.GetSCIMUserByEmail(ctx, data.Email.ValueString())
+	if errors.Is(err, langfuse.ErrNotFound) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("email"),
+			"SCIM user not found",
+			fmt.Sprintf("No user with email %s exists in the organization.", data.Email.ValueString()),
+		)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error looking up SCIM user", err)
+		return
+	}
+
+	data.ID = types.StringValue(user.ID)
+	data.UserName = types.StringValue(user.UserName)
+	data.DisplayName = types.StringValue(user.DisplayName)
+	data.ExternalID = types.StringValue(user.ExternalID)
+	data.Active = types.BoolValue(user.Active)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse/mocks"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSCIMUserDataSourceRead(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	newRequest := func(d *scimUserDataSource, email string) (datasource.ReadRequest, datasource.ReadResponse) {
+		var schemaResp datasource.SchemaResponse
+		d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+		if diags := schemaResp.Schema.ValidateImplementation(ctx); diags.HasError() {
+			t.Fatalf("schema implementation validation failed: %v", diags)
+		}
+
+		objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+		values := map[string]tftypes.Value{
+			"email":                    tftypes.NewValue(tftypes.String, email),
+			"organization_public_key":  tftypes.NewValue(tftypes.String, "pk-org"),
+			"organization_private_key": tftypes.NewValue(tftypes.String, "sk-org"),
+		}
+		for name, attributeType := range objectType.AttributeTypes {
+			if _, ok := values[name]; !ok {
+				values[name] = tftypes.NewValue(attributeType, nil)
+			}
+		}
+
+		return datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}},
+			datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	}
+
+	t.Run("found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		d := NewSCIMUserDataSource().(*scimUserDataSource)
+		clientFactory := mocks.NewMockClientFactory(ctrl)
+		d.Configure(ctx, datasource.ConfigureRequest{ProviderData: clientFactory}, &datasource.ConfigureResponse{})
+
+		clientFactory.OrganizationClient.EXPECT().GetSCIMUserByEmail(ctx, "jane@example.com").Return(&langfuse.SCIMUserResponse{
+			ID:          "user-1",
+			UserName:    "jane@example.com",
+			Active:      true,
+			DisplayName: "Jane Doe",
+			ExternalID:  "00u1",
+		}, nil)
+
+		req, resp := newRequest(d, "jane@example.com")
+		d.Read(ctx, req, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics from Read: %v", resp.Diagnostics)
+		}
+
+		var data scimUserDataSourceModel
+		resp.Diagnostics.Append(resp.State.Get(ctx, &data)...)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics reading state: %v", resp.Diagnostics)
+		}
+		if data.ID.ValueString() != "user-1" || !data.Active.ValueBool() || data.DisplayName.ValueString() != "Jane Doe" || data.ExternalID.ValueString() != "00u1" {
+			t.Errorf("unexpected state: %+v", data)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		d := NewSCIMUserDataSource().(*scimUserDataSource)
+		clientFactory := mocks.NewMockClientFactory(ctrl)
+		d.Configure(ctx, datasource.ConfigureRequest{ProviderData: clientFactory}, &datasource.ConfigureResponse{})
+
+		clientFactory.OrganizationClient.EXPECT().GetSCIMUserByEmail(ctx, "nobody@example.com").
+			Return(nil, fmt.Errorf("cannot find SCIM user with email nobody@example.com: %w", langfuse.ErrNotFound))
+
+		req, resp := newRequest(d, "nobody@example.com")
+		d.Read(ctx, req, &resp)
+		if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "SCIM user not found" {
+			t.Fatalf("expected a not found error, got: %v", resp.Diagnostics)
+		}
+	})
+}
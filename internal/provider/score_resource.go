@@ -0,0 +1,391 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+)
+
+var _ resource.Resource = &scoreResource{}
+var _ resource.ResourceWithImportState = &scoreResource{}
+var _ resource.ResourceWithValidateConfig = &scoreResource{}
+var _ resource.ResourceWithModifyPlan = &scoreResource{}
+
+const (
+	// scoreCreationTimeout bounds the wait for a created score to become readable. Langfuse v3
+	// processes POST /api/public/scores asynchronously, so the score is not found right away.
+	scoreCreationTimeout      = time.Minute
+	scoreCreationPollInterval = 2 * time.Second
+)
+
+func NewScoreResource() resource.Resource {
+	return &scoreResource{}
+}
+
+type scoreResourceModel struct {
+	ID               types.String  `tfsdk:"id"`
+	Name             types.String  `tfsdk:"name"`
+	DataType         types.String  `tfsdk:"data_type"`
+	Value            types.Float64 `tfsdk:"value"`
+	StringValue      types.String  `tfsdk:"string_value"`
+	TraceID          types.String  `tfsdk:"trace_id"`
+	SessionID        types.String  `tfsdk:"session_id"`
+	ObservationID    types.String  `tfsdk:"observation_id"`
+	Comment          types.String  `tfsdk:"comment"`
+	ConfigID         types.String  `tfsdk:"config_id"`
+	ProjectPublicKey types.String  `tfsdk:"project_public_key"`
+	ProjectSecretKey types.String  `tfsdk:"project_secret_key"`
+}
+
+// setScore copies the attributes Langfuse returns for a score. Optional attributes stay null when
+// the score does not have them.
+func (m *scoreResourceModel) setScore(score *langfuse.Score) {
+	m.ID = types.StringValue(score.ID)
+	m.Name = types.StringValue(score.Name)
+	m.DataType = types.StringValue(score.DataType)
+	m.Value = types.Float64Null()
+	m.StringValue = types.StringNull()
+	if score.DataType == langfuse.ScoreDataTypeCategorical {
+		m.StringValue = types.StringValue(score.StringValue)
+	} else if score.Value != nil {
+		m.Value = types.Float64Value(*score.Value)
+	}
+	m.TraceID = stringValueOrNull(score.TraceID)
+	m.SessionID = stringValueOrNull(score.SessionID)
+	m.ObservationID = stringValueOrNull(score.ObservationID)
+	m.Comment = stringValueOrNull(score.Comment)
+	m.ConfigID = stringValueOrNull(score.ConfigID)
+}
+
+type scoreResource struct {
+	ClientFactory langfuse.ClientFactory
+}
+
+func (r *scoreResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.ClientFactory = req.ProviderData.(langfuse.ClientFactory)
+}
+
+func (r *scoreResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_score"
+}
+
+func (r *scoreResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	replacedString := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Optional:    true,
+			Description: description,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a score, e.g. a benchmark or threshold score seeded during environment bootstrap. " +
+			"Scores cannot be updated: changing any attribute other than the project keys replaces the score.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the score. Generated by Langfuse unless set; setting it makes creation idempotent.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the score.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"data_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Description: "`NUMERIC`, `CATEGORICAL` or `BOOLEAN`. Defaults to `NUMERIC` when `value` is set and to `CATEGORICAL` when " +
+					"`string_value` is set, or to the data type of the score config referenced by `config_id`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"value": schema.Float64Attribute{
+				Optional:    true,
+				Description: "The value of a numeric or boolean score (`0` or `1`). Conflicts with `string_value`.",
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.RequiresReplace(),
+				},
+			},
+			"string_value":   replacedString("The value of a categorical score. Conflicts with `value`."),
+			"trace_id":       replacedString("The trace the score is attached to."),
+			"session_id":     replacedString("The session the score is attached to."),
+			"observation_id": replacedString("The observation the score is attached to."),
+			"comment":        replacedString("A comment on the score."),
+			"config_id":      replacedString("The score config the score is validated against."),
+			"project_public_key": schema.StringAttribute{
+				Optional: true,
+				Description: "Public key of a project API key of the project the score belongs to. " +
+					"Defaults to the provider's `project_public_key`. Changing it never replaces the score.",
+			},
+			"project_secret_key": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Description: "Secret key of a project API key of the project the score belongs to. " +
+					"Defaults to the provider's `project_secret_key`. Changing it never replaces the score.",
+			},
+		},
+	}
+}
+
+func (r *scoreResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data scoreResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateProjectCredentials(data.ProjectPublicKey, data.ProjectSecretKey)...)
+
+	if data.Value.IsUnknown() || data.StringValue.IsUnknown() || data.DataType.IsUnknown() {
+		return
+	}
+
+	if data.Value.IsNull() == data.StringValue.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("value"),
+			"Invalid score value",
+			"Exactly one of value and string_value must be set.",
+		)
+		return
+	}
+
+	if data.DataType.IsNull() {
+		return
+	}
+	switch dataType := data.DataType.ValueString(); dataType {
+	case langfuse.ScoreDataTypeNumeric, langfuse.ScoreDataTypeBoolean:
+		if data.Value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("value"),
+				"Invalid score value",
+				fmt.Sprintf("%s scores take a numeric value; set value instead of string_value.", dataType),
+			)
+		} else if dataType == langfuse.ScoreDataTypeBoolean && data.Value.ValueFloat64() != 0 && data.Value.ValueFloat64() != 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("value"),
+				"Invalid score value",
+				fmt.Sprintf("BOOLEAN scores take the value 0 or 1. Got: %g", data.Value.ValueFloat64()),
+			)
+		}
+	case langfuse.ScoreDataTypeCategorical:
+		if data.StringValue.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("string_value"),
+				"Invalid score value",
+				"CATEGORICAL scores take a string value; set string_value instead of value.",
+			)
+		}
+	default:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("data_type"),
+			"Invalid score data type",
+			fmt.Sprintf("data_type must be %q, %q or %q. Got: %s",
+				langfuse.ScoreDataTypeNumeric, langfuse.ScoreDataTypeCategorical, langfuse.ScoreDataTypeBoolean, dataType),
+		)
+	}
+}
+
+// ModifyPlan only reports the sensitive attributes the plan writes to state, when requested.
+func (r *scoreResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	addSensitiveStateSummary(ctx, r.ClientFactory, req, resp)
+}
+
+func (r *scoreResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer addRunWarnings(&resp.Diagnostics, r.ClientFactory)
+
+	resp.Diagnostics.Append(checkInstanceVersion(ctx, r.ClientFactory, "langfuse_score")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data scoreResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := &langfuse.CreateScoreRequest{
+		ID:            data.ID.ValueString(),
+		Name:          data.Name.ValueString(),
+		DataType:      data.DataType.ValueString(),
+		TraceID:       data.TraceID.ValueString(),
+		SessionID:     data.SessionID.ValueString(),
+		ObservationID: data.ObservationID.ValueString(),
+		Comment:       data.Comment.ValueString(),
+		ConfigID:      data.ConfigID.ValueString(),
+	}
+	if data.StringValue.IsNull() {
+		request.Value = data.Value.ValueFloat64()
+	} else {
+		request.Value = data.StringValue.ValueString()
+	}
+
+	projectClient, diags := newProjectClient(r.ClientFactory, data.ProjectPublicKey, data.ProjectSecretKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	scoreID, err := projectClient.CreateScore(ctx, request)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error creating score", err)
+		return
+	}
+
+	score, err := waitForScore(ctx, runtimeOf(r.ClientFactory), projectClient, scoreID, scoreCreationTimeout, scoreCreationPollInterval)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error reading created score", err)
+		return
+	}
+
+	data.setScore(score)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *scoreResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data scoreResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectClient, diags := newProjectClient(r.ClientFactory, data.ProjectPublicKey, data.ProjectSecretKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	score, err := projectClient.GetScore(ctx, data.ID.ValueString())
+	if errors.Is(err, langfuse.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error reading score", err)
+		return
+	}
+
+	data.setScore(score)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update only stores new project keys: every other attribute requires replacement.
+func (r *scoreResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data scoreResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *scoreResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer addRunWarnings(&resp.Diagnostics, r.ClientFactory)
+
+	var data scoreResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectClient, diags := newProjectClient(r.ClientFactory, data.ProjectPublicKey, data.ProjectSecretKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err := projectClient.DeleteScore(ctx, data.ID.ValueString()); err != nil {
+		addClientError(&resp.Diagnostics, "Error deleting score", err)
+		return
+	}
+}
+
+func (r *scoreResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import format: score_id,project_public_key,project_secret_key
+	importParts := strings.Split(req.ID, ",")
+	if len(importParts) != 3 {
+		resp.Diagnostics.AddError("Invalid import format",
+			"Import ID must be in format: score_id,project_public_key,project_secret_key")
+		return
+	}
+
+	data := scoreResourceModel{
+		ProjectPublicKey: types.StringValue(importParts[1]),
+		ProjectSecretKey: types.StringValue(importParts[2]),
+	}
+
+	resp.Diagnostics.Append(checkProviderConfigurationKnown(r.ClientFactory)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectClient := r.ClientFactory.NewProjectClient(importParts[1], importParts[2])
+	score, err := projectClient.GetScore(ctx, importParts[0])
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing score",
+			"Could not read score "+importParts[0]+": "+err.Error())
+		return
+	}
+
+	data.setScore(score)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// stringValueOrNull returns a null string for the empty string, which Langfuse uses for unset fields.
+func stringValueOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+// waitForScore polls for a created score until it exists, the timeout passes or ctx is done.
+func waitForScore(ctx context.Context, runtime langfuse.Runtime, client langfuse.ProjectClient, scoreID string, timeout, interval time.Duration) (*langfuse.Score, error) {
+	deadline := runtime.Now().Add(timeout)
+	for {
+		score, err := client.GetScore(ctx, scoreID)
+		if err == nil {
+			return score, nil
+		}
+		if !errors.Is(err, langfuse.ErrNotFound) {
+			return nil, err
+		}
+		if runtime.Now().Add(interval).After(deadline) {
+			return nil, fmt.Errorf("score %s was accepted but not processed within %s; import it once the ingestion workers of the instance have caught up: %w", scoreID, timeout, err)
+		}
+
+		select {
+		case <-runtime.After(interval):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
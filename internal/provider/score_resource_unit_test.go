@@ -0,0 +1,191 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse/mocks"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestScoreResourceCRUD(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	r := NewScoreResource().(*scoreResource)
+	clientFactory := mocks.NewMockClientFactory(ctrl)
+	r.Configure(ctx, resource.ConfigureRequest{ProviderData: clientFactory}, &resource.ConfigureResponse{})
+
+	config, schemaResp := resourceObjectValue(ctx, r, map[string]tftypes.Value{
+		"name":               tftypes.NewValue(tftypes.String, "baseline_accuracy"),
+		"value":              tftypes.NewValue(tftypes.Number, 0.87),
+		"comment":            tftypes.NewValue(tftypes.String, "Q3 benchmark"),
+		"project_public_key": tftypes.NewValue(tftypes.String, "pk-lf-1"),
+		"project_secret_key": tftypes.NewValue(tftypes.String, "sk-lf-1"),
+	})
+	if diags := schemaResp.Schema.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("schema implementation validation failed: %v", diags)
+	}
+
+	value := 0.87
+	created := &langfuse.Score{ID: "score-1", Name: "baseline_accuracy", Value: &value, DataType: langfuse.ScoreDataTypeNumeric, Comment: "Q3 benchmark"}
+
+	var createResp resource.CreateResponse
+	t.Run("Create", func(t *testing.T) {
+		clientFactory.ProjectClient.EXPECT().CreateScore(ctx, &langfuse.CreateScoreRequest{
+			Name:    "baseline_accuracy",
+			Value:   0.87,
+			Comment: "Q3 benchmark",
+		}).Return("score-1", nil)
+		clientFactory.ProjectClient.EXPECT().GetScore(ctx, "score-1").Return(created, nil)
+
+		createResp.State.Schema = schemaResp.Schema
+		r.Create(ctx, resource.CreateRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config}}, &createResp)
+		if createResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics from Create: %v", createResp.Diagnostics)
+		}
+
+		var state scoreResourceModel
+		createResp.State.Get(ctx, &state)
+		if state.ID.ValueString() != "score-1" || state.DataType.ValueString() != langfuse.ScoreDataTypeNumeric || !state.StringValue.IsNull() {
+			t.Errorf("unexpected state after Create: %+v", state)
+		}
+	})
+
+	t.Run("Create waits for the score to be processed", func(t *testing.T) {
+		clientFactory.Deps.After = func(d time.Duration) <-chan time.Time {
+			ch := make(chan time.Time, 1)
+			ch <- time.Now()
+			return ch
+		}
+		defer func() { clientFactory.Deps.After = nil }()
+
+		clientFactory.ProjectClient.EXPECT().CreateScore(ctx, gomock.Any()).Return("score-1", nil)
+		gomock.InOrder(
+			clientFactory.ProjectClient.EXPECT().GetScore(ctx, "score-1").Return(nil, fmt.Errorf("cannot find score score-1: %w", langfuse.ErrNotFound)),
+			clientFactory.ProjectClient.EXPECT().GetScore(ctx, "score-1").Return(created, nil),
+		)
+
+		resp := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+		r.Create(ctx, resource.CreateRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config}}, &resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics from Create: %v", resp.Diagnostics)
+		}
+
+		var state scoreResourceModel
+		resp.State.Get(ctx, &state)
+		if state.ID.ValueString() != "score-1" {
+			t.Errorf("unexpected state after Create: %+v", state)
+		}
+	})
+
+	t.Run("Read removes deleted score", func(t *testing.T) {
+		clientFactory.ProjectClient.EXPECT().GetScore(ctx, "score-1").Return(nil, fmt.Errorf("cannot find score score-1: %w", langfuse.ErrNotFound))
+
+		readResp := resource.ReadResponse{State: createResp.State}
+		r.Read(ctx, resource.ReadRequest{State: createResp.State}, &readResp)
+		if readResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics from Read: %v", readResp.Diagnostics)
+		}
+		if !readResp.State.Raw.IsNull() {
+			t.Errorf("expected the score to be removed from state")
+		}
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		clientFactory.ProjectClient.EXPECT().DeleteScore(ctx, "score-1").Return(nil)
+
+		deleteResp := resource.DeleteResponse{State: createResp.State}
+		r.Delete(ctx, resource.DeleteRequest{State: createResp.State}, &deleteResp)
+		if deleteResp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics from Delete: %v", deleteResp.Diagnostics)
+		}
+	})
+}
+
+func TestScoreResourceValidateConfig(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := NewScoreResource().(*scoreResource)
+
+	testCases := []struct {
+		name        string
+		values      map[string]tftypes.Value
+		expectError bool
+	}{
+		{
+			name:   "numeric value",
+			values: map[string]tftypes.Value{"value": tftypes.NewValue(tftypes.Number, 0.5)},
+		},
+		{
+			name: "categorical value",
+			values: map[string]tftypes.Value{
+				"string_value": tftypes.NewValue(tftypes.String, "good"),
+				"data_type":    tftypes.NewValue(tftypes.String, "CATEGORICAL"),
+			},
+		},
+		{
+			name:        "no value",
+			values:      map[string]tftypes.Value{},
+			expectError: true,
+		},
+		{
+			name: "both values",
+			values: map[string]tftypes.Value{
+				"value":        tftypes.NewValue(tftypes.Number, 1),
+				"string_value": tftypes.NewValue(tftypes.String, "good"),
+			},
+			expectError: true,
+		},
+		{
+			name: "boolean out of range",
+			values: map[string]tftypes.Value{
+				"value":     tftypes.NewValue(tftypes.Number, 2),
+				"data_type": tftypes.NewValue(tftypes.String, "BOOLEAN"),
+			},
+			expectError: true,
+		},
+		{
+			name: "categorical with numeric value",
+			values: map[string]tftypes.Value{
+				"value":     tftypes.NewValue(tftypes.Number, 1),
+				"data_type": tftypes.NewValue(tftypes.String, "CATEGORICAL"),
+			},
+			expectError: true,
+		},
+		{
+			name: "unknown data type",
+			values: map[string]tftypes.Value{
+				"value":     tftypes.NewValue(tftypes.Number, 1),
+				"data_type": tftypes.NewValue(tftypes.String, "TEXT"),
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.values["name"] = tftypes.NewValue(tftypes.String, "accuracy")
+			config, schemaResp := resourceObjectValue(ctx, r, tc.values)
+
+			var resp resource.ValidateConfigResponse
+			r.ValidateConfig(ctx, resource.ValidateConfigRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: config}}, &resp)
+			if resp.Diagnostics.HasError() != tc.expectError {
+				t.Errorf("expected error: %t, got diagnostics: %v", tc.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
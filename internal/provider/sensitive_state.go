@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+)
+
+const sensitiveStateSummary = "Sensitive values written to state"
+
+// addSensitiveStateSummary warns, when the provider sets sensitive_state_summary, about the
+// sensitive attributes that the planned change writes to state: those that are set for the first
+// time, change, or are only known after apply. Terraform attaches the resource address to the
+// warning, so run tasks and policy checks can tell which resources persist secrets.
+func addSensitiveStateSummary(ctx context.Context, clientFactory langfuse.ClientFactory, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if clientFactory == nil || !clientFactory.SensitiveStateSummary() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	planned := make(map[string]tftypes.Value)
+	if err := req.Plan.Raw.As(&planned); err != nil {
+		return
+	}
+	previous := make(map[string]tftypes.Value)
+	if !req.State.Raw.IsNull() {
+		if err := req.State.Raw.As(&previous); err != nil {
+			return
+		}
+	}
+
+	var written []string
+	for name, attribute := range req.Plan.Schema.GetAttributes() {
+		if !attribute.IsSensitive() {
+			continue
+		}
+		value, ok := planned[name]
+		if !ok || value.IsNull() {
+			continue
+		}
+		if old, ok := previous[name]; value.IsKnown() && ok && old.Equal(value) {
+			continue
+		}
+		written = append(written, name)
+	}
+	if len(written) == 0 {
+		return
+	}
+	sort.Strings(written)
+
+	resp.Diagnostics.AddWarning(
+		sensitiveStateSummary,
+		fmt.Sprintf("This apply writes the sensitive attributes %s to Terraform state. Make sure the state backend is encrypted "+
+			"and access to it is restricted, or reference named provider credentials with credential_ref where the resource supports it.",
+			strings.Join(written, ", ")),
+	)
+}
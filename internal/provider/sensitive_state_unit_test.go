@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse/mocks"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestSensitiveStateSummary(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	r := NewOrganizationApiKeyResource().(*organizationApiKeyResource)
+	clientFactory := mocks.NewMockClientFactory(ctrl)
+	clientFactory.SensitiveSummary = true
+	r.ClientFactory = clientFactory
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	resourceSchema := schemaResp.Schema
+
+	planned := tfsdk.Plan{Schema: resourceSchema, Raw: buildOrgApiKeyObjectValue(map[string]tftypes.Value{
+		"organization_id": tftypes.NewValue(tftypes.String, "org-123"),
+		"secret_key":      tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	})}
+	stored := tfsdk.State{Schema: resourceSchema, Raw: buildOrgApiKeyObjectValue(map[string]tftypes.Value{
+		"id":              tftypes.NewValue(tftypes.String, "oak-123"),
+		"organization_id": tftypes.NewValue(tftypes.String, "org-123"),
+		"secret_key":      tftypes.NewValue(tftypes.String, "sk-1234"),
+	})}
+	emptyState := tfsdk.State{Schema: resourceSchema, Raw: tftypes.NewValue(resourceSchema.Type().TerraformType(ctx), nil)}
+
+	t.Run("create", func(t *testing.T) {
+		resp := resource.ModifyPlanResponse{Plan: planned}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: planned, State: emptyState}, &resp)
+		if resp.Diagnostics.WarningsCount() != 1 {
+			t.Fatalf("expected a single warning, got: %v", resp.Diagnostics)
+		}
+		warning := resp.Diagnostics.Warnings()[0]
+		if warning.Summary() != sensitiveStateSummary || !strings.Contains(warning.Detail(), "secret_key") {
+			t.Errorf("unexpected warning: %s: %s", warning.Summary(), warning.Detail())
+		}
+	})
+
+	t.Run("unchanged", func(t *testing.T) {
+		unchanged := tfsdk.Plan{Schema: resourceSchema, Raw: stored.Raw}
+		resp := resource.ModifyPlanResponse{Plan: unchanged}
+		r.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: unchanged, State: stored}, &resp)
+		if len(resp.Diagnostics) != 0 {
+			t.Errorf("expected no diagnostics for an unchanged secret, got: %v", resp.Diagnostics)
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		disabled := mocks.NewMockClientFactory(ctrl)
+		resp := resource.ModifyPlanResponse{Plan: planned}
+		addSensitiveStateSummary(ctx, disabled, resource.ModifyPlanRequest{Plan: planned, State: emptyState}, &resp)
+		if len(resp.Diagnostics) != 0 {
+			t.Errorf("expected no diagnostics without sensitive_state_summary, got: %v", resp.Diagnostics)
+		}
+	})
+}
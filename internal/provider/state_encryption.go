@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// stateEncryptionScheme prefixes secrets encrypted with the provider's state_encryption_key. The
+// full form is aes256gcm:<key fingerprint>:<base64 of nonce and ciphertext>.
+const stateEncryptionScheme = "aes256gcm"
+
+// parseStateEncryptionKey decodes a base64-encoded AES-256 key, as KMS data key APIs return them.
+func parseStateEncryptionKey(encoded string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, fmt.Errorf("the key is not base64-encoded: %w", err)
+	}
+	if len(key) != 32 {
+		return nil, fmt.Errorf("the key must be 32 bytes for AES-256, got %d", len(key))
+	}
+	return key, nil
+}
+
+// stateEncryptionKeyFingerprint identifies a key without revealing it, so that decrypting with
+// another key than the one a secret was encrypted with fails with an explanation.
+func stateEncryptionKeyFingerprint(key []byte) string {
+	sum := sha256.Sum256(key)
+	return hex.EncodeToString(sum[:4])
+}
+
+// encryptStateSecret encrypts secret with AES-256-GCM. The ciphertext is bound to binding, e.g.
+// the ID of the API key, so that it cannot be decrypted as the secret of another object.
+func encryptStateSecret(key []byte, secret, binding string) (string, error) {
+	aead, err := newStateEncryptionAEAD(key)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := aead.Seal(nonce, nonce, []byte(secret), []byte(binding))
+	return strings.Join([]string{stateEncryptionScheme, stateEncryptionKeyFingerprint(key), base64.StdEncoding.EncodeToString(sealed)}, ":"), nil
+}
+
+// decryptStateSecret reverses encryptStateSecret.
+func decryptStateSecret(key []byte, encrypted, binding string) (string, error) {
+	parts := strings.Split(encrypted, ":")
+	if len(parts) != 3 || parts[0] != stateEncryptionScheme {
+		return "", fmt.Errorf("expected %s:<key fingerprint>:<ciphertext>", stateEncryptionScheme)
+	}
+	if fingerprint := stateEncryptionKeyFingerprint(key); parts[1] != fingerprint {
+		return "", fmt.Errorf("the secret was encrypted with key %s, but the provider's state_encryption_key is %s", parts[1], fingerprint)
+	}
+	sealed, err := base64.StdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", fmt.Errorf("the ciphertext is not base64-encoded: %w", err)
+	}
+
+	aead, err := newStateEncryptionAEAD(key)
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < aead.NonceSize() {
+		return "", errors.New("the ciphertext is truncated")
+	}
+	secret, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], []byte(binding))
+	if err != nil {
+		return "", errors.New("the ciphertext was modified or belongs to another object")
+	}
+	return string(secret), nil
+}
+
+func newStateEncryptionAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// stateEncryptionKeyRequired is reported when a resource is to encrypt a secret for state, but the
+// provider has no state_encryption_key.
+func stateEncryptionKeyRequired() diag.Diagnostic {
+	return diag.NewAttributeErrorDiagnostic(
+		path.Root("secret_key_storage"),
+		"State encryption key required",
+		"secret_key_storage = \"encrypted\" encrypts the secret key with the provider's state_encryption_key, which is not set. "+
+			"Set state_encryption_key or LANGFUSE_STATE_ENCRYPTION_KEY, or choose another secret_key_storage.",
+	)
+}
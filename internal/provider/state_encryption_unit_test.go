@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/base64"
+	"strings"
+	"testing"
+)
+
+func TestStateSecretEncryption(t *testing.T) {
+	t.Parallel()
+
+	key := bytes.Repeat([]byte{1}, 32)
+	encrypted, err := encryptStateSecret(key, "sk-lf-secret", "pak-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.HasPrefix(encrypted, stateEncryptionScheme+":"+stateEncryptionKeyFingerprint(key)+":") || strings.Contains(encrypted, "sk-lf-secret") {
+		t.Fatalf("unexpected ciphertext: %q", encrypted)
+	}
+
+	if secret, err := decryptStateSecret(key, encrypted, "pak-1"); err != nil || secret != "sk-lf-secret" {
+		t.Errorf("expected the secret back, got %q, %v", secret, err)
+	}
+	if _, err := decryptStateSecret(key, encrypted, "pak-2"); err == nil {
+		t.Error("expected an error for the ciphertext of another key ID")
+	}
+	if _, err := decryptStateSecret(bytes.Repeat([]byte{2}, 32), encrypted, "pak-1"); err == nil || !strings.Contains(err.Error(), "encrypted with key") {
+		t.Errorf("expected a key mismatch error, got %v", err)
+	}
+	if _, err := decryptStateSecret(key, "sha256:00:00", "pak-1"); err == nil {
+		t.Error("expected an error for a value that is not encrypted")
+	}
+}
+
+func TestParseStateEncryptionKey(t *testing.T) {
+	t.Parallel()
+
+	valid := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32))
+	if key, err := parseStateEncryptionKey(valid + "\n"); err != nil || len(key) != 32 {
+		t.Errorf("expected a 32-byte key, got %d bytes, %v", len(key), err)
+	}
+	for _, encoded := range []string{"not base64!", base64.StdEncoding.EncodeToString([]byte("too short"))} {
+		if _, err := parseStateEncryptionKey(encoded); err == nil {
+			t.Errorf("expected an error for %q", encoded)
+		}
+	}
+}
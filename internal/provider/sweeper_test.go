@@ -0,0 +1,214 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+)
+
+// sweepOrganizationPrefixes lists the name prefixes of organizations created by acceptance tests.
+// Only organizations matching one of them are ever touched by the sweepers.
+var sweepOrganizationPrefixes = []string{"test-org-", "import-test-org-"}
+
+func init() {
+	resource.AddTestSweepers("langfuse_organization", &resource.Sweeper{
+		Name: "langfuse_organization",
+		Dependencies: []string{
+			"langfuse_project",
+			"langfuse_organization_membership",
+			"langfuse_organization_api_key",
+		},
+		F: sweepOrganizations,
+	})
+	resource.AddTestSweepers("langfuse_organization_api_key", &resource.Sweeper{
+		Name: "langfuse_organization_api_key",
+		F:    sweepOrganizationApiKeys,
+	})
+	resource.AddTestSweepers("langfuse_project", &resource.Sweeper{
+		Name:         "langfuse_project",
+		Dependencies: []string{"langfuse_project_api_key"},
+		F:            sweepProjects,
+	})
+	resource.AddTestSweepers("langfuse_project_api_key", &resource.Sweeper{
+		Name: "langfuse_project_api_key",
+		F:    sweepProjectApiKeys,
+	})
+	resource.AddTestSweepers("langfuse_organization_membership", &resource.Sweeper{
+		Name: "langfuse_organization_membership",
+		F:    sweepMemberships,
+	})
+}
+
+// sweeperClientFactory builds a client factory from the same environment variables as the acceptance tests.
+// The region argument passed to sweepers is ignored because a Langfuse instance has no regions.
+func sweeperClientFactory() (langfuse.ClientFactory, error) {
+	host := os.Getenv("LANGFUSE_HOST")
+	adminKey := os.Getenv("LANGFUSE_ADMIN_KEY")
+	if host == "" || adminKey == "" {
+		return nil, errors.New("LANGFUSE_HOST and LANGFUSE_ADMIN_KEY must be set to run sweepers")
+	}
+	return langfuse.NewClientFactory(host, adminKey), nil
+}
+
+func sweepTestOrganizations(ctx context.Context, adminClient langfuse.AdminClient) ([]*langfuse.Organization, error) {
+	organizations, err := adminClient.ListOrganizations(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list organizations: %w", err)
+	}
+
+	var matching []*langfuse.Organization
+	for _, organization := range organizations {
+		for _, prefix := range sweepOrganizationPrefixes {
+			if strings.HasPrefix(organization.Name, prefix) {
+				matching = append(matching, organization)
+				break
+			}
+		}
+	}
+	return matching, nil
+}
+
+// forEachTestOrganization calls fn with an organization client for every test organization. The
+// client authenticates with a temporary organization key that is deleted again afterwards.
+func forEachTestOrganization(fn func(ctx context.Context, organization *langfuse.Organization, client langfuse.OrganizationClient) error) error {
+	ctx := context.Background()
+
+	clientFactory, err := sweeperClientFactory()
+	if err != nil {
+		return err
+	}
+	adminClient := clientFactory.NewAdminClient()
+
+	organizations, err := sweepTestOrganizations(ctx, adminClient)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, organization := range organizations {
+		apiKey, err := adminClient.CreateOrganizationApiKey(ctx, organization.ID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("organization %s: failed to create sweeper key: %w", organization.ID, err))
+			continue
+		}
+
+		if err := fn(ctx, organization, clientFactory.NewOrganizationClient(apiKey.PublicKey, apiKey.SecretKey)); err != nil {
+			errs = append(errs, fmt.Errorf("organization %s: %w", organization.ID, err))
+		}
+
+		if err := adminClient.DeleteOrganizationApiKey(ctx, organization.ID, apiKey.ID); err != nil {
+			errs = append(errs, fmt.Errorf("organization %s: failed to delete sweeper key: %w", organization.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func sweepProjectApiKeys(region string) error {
+	return forEachTestOrganization(func(ctx context.Context, organization *langfuse.Organization, client langfuse.OrganizationClient) error {
+		projects, err := client.ListProjects(ctx)
+		if err != nil {
+			return err
+		}
+		for _, project := range projects {
+			apiKeys, err := client.ListProjectApiKeys(ctx, project.ID)
+			if err != nil {
+				return err
+			}
+			for _, apiKey := range apiKeys {
+				log.Printf("[INFO] Sweeping project API key %s of project %s", apiKey.ID, project.ID)
+				if err := client.DeleteProjectApiKey(ctx, project.ID, apiKey.ID); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+}
+
+func sweepProjects(region string) error {
+	return forEachTestOrganization(func(ctx context.Context, organization *langfuse.Organization, client langfuse.OrganizationClient) error {
+		projects, err := client.ListProjects(ctx)
+		if err != nil {
+			return err
+		}
+		for _, project := range projects {
+			log.Printf("[INFO] Sweeping project %s (%s) of organization %s", project.ID, project.Name, organization.ID)
+			if err := client.DeleteProject(ctx, project.ID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func sweepMemberships(region string) error {
+	return forEachTestOrganization(func(ctx context.Context, organization *langfuse.Organization, client langfuse.OrganizationClient) error {
+		memberships, err := client.ListMemberships(ctx)
+		if err != nil {
+			return err
+		}
+		for _, membership := range memberships {
+			log.Printf("[INFO] Sweeping membership of %s in organization %s", membership.Email, organization.ID)
+			if err := client.RemoveMember(ctx, membership.UserID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func sweepOrganizationApiKeys(region string) error {
+	ctx := context.Background()
+
+	clientFactory, err := sweeperClientFactory()
+	if err != nil {
+		return err
+	}
+	adminClient := clientFactory.NewAdminClient()
+
+	organizations, err := sweepTestOrganizations(ctx, adminClient)
+	if err != nil {
+		return err
+	}
+	for _, organization := range organizations {
+		apiKeys, err := adminClient.ListOrganizationApiKeys(ctx, organization.ID)
+		if err != nil {
+			return err
+		}
+		for _, apiKey := range apiKeys {
+			log.Printf("[INFO] Sweeping organization API key %s of organization %s", apiKey.ID, organization.ID)
+			if err := adminClient.DeleteOrganizationApiKey(ctx, organization.ID, apiKey.ID); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func sweepOrganizations(region string) error {
+	ctx := context.Background()
+
+	clientFactory, err := sweeperClientFactory()
+	if err != nil {
+		return err
+	}
+	adminClient := clientFactory.NewAdminClient()
+
+	organizations, err := sweepTestOrganizations(ctx, adminClient)
+	if err != nil {
+		return err
+	}
+	for _, organization := range organizations {
+		log.Printf("[INFO] Sweeping organization %s (%s)", organization.ID, organization.Name)
+		if err := adminClient.DeleteOrganization(ctx, organization.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,243 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+)
+
+var _ datasource.DataSource = &traceCountsDataSource{}
+
+const defaultTraceCountsWindow = 24 * time.Hour
+
+// traceCountsMetric is the count metric; the metrics API returns it under the key count_count.
+var traceCountsMetric = langfuse.MetricsMetric{Measure: "count", Aggregation: "count"}
+
+var traceCountsEnvironmentType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"trace_count":       types.Int64Type,
+	"observation_count": types.Int64Type,
+}}
+
+func NewTraceCountsDataSource() datasource.DataSource {
+	return &traceCountsDataSource{}
+}
+
+type traceCountsDataSourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	PublicKey        types.String `tfsdk:"public_key"`
+	SecretKey        types.String `tfsdk:"secret_key"`
+	Window           types.String `tfsdk:"window"`
+	From             types.String `tfsdk:"from"`
+	To               types.String `tfsdk:"to"`
+	TraceCount       types.Int64  `tfsdk:"trace_count"`
+	ObservationCount types.Int64  `tfsdk:"observation_count"`
+	Environments     types.Map    `tfsdk:"environments"`
+}
+
+type traceCountsDataSource struct {
+	ClientFactory langfuse.ClientFactory
+}
+
+func (d *traceCountsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clientFactory, ok := req.ProviderData.(langfuse.ClientFactory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected langfuse.ClientFactory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.ClientFactory = clientFactory
+}
+
+func (d *traceCountsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_trace_counts"
+}
+
+func (d *traceCountsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Counts the traces and observations of a project per environment over a time window ending at the time of the read, " +
+			"through the Langfuse metrics API. Use it to size retention or alerting thresholds from actual volumes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The public key the counts were read with.",
+			},
+			"public_key": schema.StringAttribute{
+				Required:    true,
+				Description: "Public key of a project API key of the project to count.",
+			},
+			"secret_key": schema.StringAttribute{
+				Required:    true,
+				Sensitive:   true,
+				Description: "Secret key of the project API key.",
+			},
+			"window": schema.StringAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Length of the time window, as a duration such as `720h` for 30 days. Defaults to `%s`.", defaultTraceCountsWindow),
+			},
+			"from": schema.StringAttribute{
+				Computed:    true,
+				Description: "Start of the time window (RFC 3339, inclusive).",
+			},
+			"to": schema.StringAttribute{
+				Computed:    true,
+				Description: "End of the time window (RFC 3339, exclusive), i.e. the time of the read.",
+			},
+			"trace_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of traces in the window across all environments.",
+			},
+			"observation_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of observations in the window across all environments.",
+			},
+			"environments": schema.MapNestedAttribute{
+				Computed:    true,
+				Description: "Counts per environment, keyed by environment name. Environments without data in the window are absent.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"trace_count": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of traces of the environment in the window.",
+						},
+						"observation_count": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Number of observations of the environment in the window.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *traceCountsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	resp.Diagnostics.Append(checkInstanceVersion(ctx, d.ClientFactory, "langfuse_trace_counts")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data traceCountsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	window := defaultTraceCountsWindow
+	if !data.Window.IsNull() {
+		parsed, err := time.ParseDuration(data.Window.ValueString())
+		if err != nil || parsed <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("window"),
+				"Invalid window",
+				fmt.Sprintf("window must be a positive duration such as \"720h\". Got: %s", data.Window.ValueString()),
+			)
+			return
+		}
+		window = parsed
+	}
+
+	to := runtimeOf(d.ClientFactory).Now().UTC().Truncate(time.Second)
+	from := to.Add(-window)
+
+	resp.Diagnostics.Append(checkProviderConfigurationKnown(d.ClientFactory)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectClient := d.ClientFactory.NewProjectClient(data.PublicKey.ValueString(), data.SecretKey.ValueString())
+	traces, err := countByEnvironment(ctx, projectClient, langfuse.MetricsViewTraces, from, to)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error counting traces", err)
+		return
+	}
+	observations, err := countByEnvironment(ctx, projectClient, langfuse.MetricsViewObservations, from, to)
+	if err != nil {
+		addClientError(&resp.Diagnostics, "Error counting observations", err)
+		return
+	}
+
+	environments := make(map[string]attr.Value)
+	var traceCount, observationCount int64
+	for _, counts := range []map[string]int64{traces, observations} {
+		for environment := range counts {
+			if _, ok := environments[environment]; ok {
+				continue
+			}
+			environments[environment] = types.ObjectValueMust(traceCountsEnvironmentType.AttrTypes, map[string]attr.Value{
+				"trace_count":       types.Int64Value(traces[environment]),
+				"observation_count": types.Int64Value(observations[environment]),
+			})
+			traceCount += traces[environment]
+			observationCount += observations[environment]
+		}
+	}
+	environmentsValue, diags := types.MapValue(traceCountsEnvironmentType, environments)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(data.PublicKey.ValueString())
+	data.From = types.StringValue(from.Format(time.RFC3339))
+	data.To = types.StringValue(to.Format(time.RFC3339))
+	data.TraceCount = types.Int64Value(traceCount)
+	data.ObservationCount = types.Int64Value(observationCount)
+	data.Environments = environmentsValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// countByEnvironment returns the number of rows of a metrics view per environment.
+func countByEnvironment(ctx context.Context, client langfuse.ProjectClient, view string, from, to time.Time) (map[string]int64, error) {
+	metrics, err := client.QueryMetrics(ctx, &langfuse.MetricsQuery{
+		View:          view,
+		Dimensions:    []langfuse.MetricsDimension{{Field: "environment"}},
+		Metrics:       []langfuse.MetricsMetric{traceCountsMetric},
+		Filters:       []any{},
+		FromTimestamp: from,
+		ToTimestamp:   to,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	key := traceCountsMetric.Aggregation + "_" + traceCountsMetric.Measure
+	counts := make(map[string]int64)
+	for _, row := range metrics.Data {
+		environment, _ := row["environment"].(string)
+		if environment == "" {
+			environment = "default"
+		}
+		// Counts come back as JSON numbers or, for 64-bit aggregates, as strings.
+		var count int64
+		switch value := row[key].(type) {
+		case float64:
+			count = int64(value)
+		case string:
+			count, err = strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("unexpected %s %q in %s metrics: %w", key, value, view, err)
+			}
+		default:
+			return nil, fmt.Errorf("unexpected %s %v in %s metrics", key, row[key], view)
+		}
+		counts[environment] += count
+	}
+
+	return counts, nil
+}
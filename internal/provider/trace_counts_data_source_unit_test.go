@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse/mocks"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestTraceCountsDataSourceRead(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	d := NewTraceCountsDataSource().(*traceCountsDataSource)
+	clientFactory := mocks.NewMockClientFactory(ctrl)
+	clientFactory.Deps = langfuse.Runtime{Now: func() time.Time { return now }}
+	d.Configure(ctx, datasource.ConfigureRequest{ProviderData: clientFactory}, &datasource.ConfigureResponse{})
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	if diags := schemaResp.Schema.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("schema implementation validation failed: %v", diags)
+	}
+
+	clientFactory.ProjectClient.EXPECT().QueryMetrics(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, query *langfuse.MetricsQuery) (*langfuse.MetricsResponse, error) {
+		if !query.FromTimestamp.Equal(now.Add(-7*24*time.Hour)) || !query.ToTimestamp.Equal(now) {
+			t.Errorf("unexpected time range %s - %s", query.FromTimestamp, query.ToTimestamp)
+		}
+		if query.View == langfuse.MetricsViewTraces {
+			return &langfuse.MetricsResponse{Data: []map[string]any{
+				{"environment": "production", "count_count": "1200"},
+				{"environment": "staging", "count_count": float64(30)},
+			}}, nil
+		}
+		return &langfuse.MetricsResponse{Data: []map[string]any{
+			{"environment": "production", "count_count": "9000"},
+			{"environment": "", "count_count": "5"},
+		}}, nil
+	}).Times(2)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{
+		"public_key": tftypes.NewValue(tftypes.String, "pk-lf-1"),
+		"secret_key": tftypes.NewValue(tftypes.String, "sk-lf-1"),
+		"window":     tftypes.NewValue(tftypes.String, "168h"),
+	}
+	for name, attributeType := range objectType.AttributeTypes {
+		if _, ok := values[name]; !ok {
+			values[name] = tftypes.NewValue(attributeType, nil)
+		}
+	}
+
+	readResp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics from Read: %v", readResp.Diagnostics)
+	}
+
+	var data traceCountsDataSourceModel
+	readResp.Diagnostics.Append(readResp.State.Get(ctx, &data)...)
+	if data.TraceCount.ValueInt64() != 1230 || data.ObservationCount.ValueInt64() != 9005 {
+		t.Errorf("unexpected totals: traces=%s observations=%s", data.TraceCount, data.ObservationCount)
+	}
+	if data.From.ValueString() != "2026-02-22T12:00:00Z" || data.To.ValueString() != "2026-03-01T12:00:00Z" {
+		t.Errorf("unexpected window: %s - %s", data.From, data.To)
+	}
+
+	environments := make(map[string]struct {
+		TraceCount       int64 `tfsdk:"trace_count"`
+		ObservationCount int64 `tfsdk:"observation_count"`
+	})
+	readResp.Diagnostics.Append(data.Environments.ElementsAs(ctx, &environments, false)...)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics reading environments: %v", readResp.Diagnostics)
+	}
+	if len(environments) != 3 || environments["production"].TraceCount != 1200 || environments["production"].ObservationCount != 9000 ||
+		environments["staging"].ObservationCount != 0 || environments["default"].ObservationCount != 5 {
+		t.Errorf("unexpected environments: %v", environments)
+	}
+}
+
+func TestTraceCountsDataSourceInvalidWindow(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	d := NewTraceCountsDataSource().(*traceCountsDataSource)
+	d.Configure(ctx, datasource.ConfigureRequest{ProviderData: mocks.NewMockClientFactory(ctrl)}, &datasource.ConfigureResponse{})
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{
+		"public_key": tftypes.NewValue(tftypes.String, "pk-lf-1"),
+		"secret_key": tftypes.NewValue(tftypes.String, "sk-lf-1"),
+		"window":     tftypes.NewValue(tftypes.String, "30d"),
+	}
+	for name, attributeType := range objectType.AttributeTypes {
+		if _, ok := values[name]; !ok {
+			values[name] = tftypes.NewValue(attributeType, nil)
+		}
+	}
+
+	readResp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}}, &readResp)
+	if !readResp.Diagnostics.HasError() {
+		t.Fatal("expected an error for a window in days")
+	}
+}
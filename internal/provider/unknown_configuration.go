@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+)
+
+// partialClientFactory is handed to resources and data sources when the provider configuration
+// references values that are not known until apply and Terraform cannot defer. Plans that only
+// create resources need no client and keep working; anything that calls Langfuse at plan time
+// reports the unknown attributes through checkProviderConfigurationKnown. Terraform configures
+// the provider again with known values before applying.
+type partialClientFactory struct {
+	langfuse.ClientFactory
+	unknown []string
+}
+
+// HasAdminAPIKey reports an admin API key when it is only unknown, so that resources managed
+// through the admin API do not fail in Configure before the key is known.
+func (cf *partialClientFactory) HasAdminAPIKey() bool {
+	return slices.Contains(cf.unknown, "admin_api_key") || cf.ClientFactory.HasAdminAPIKey()
+}
+
+// ForHost keeps the unknown attributes that still apply to another instance: its host is known,
+// and the admin API key is not carried over.
+func (cf *partialClientFactory) ForHost(host string) langfuse.ClientFactory {
+	unknown := slices.DeleteFunc(slices.Clone(cf.unknown), func(attribute string) bool {
+		return attribute == "host" || attribute == "cloud_region" || attribute == "admin_api_key"
+	})
+	if len(unknown) == 0 {
+		return cf.ClientFactory.ForHost(host)
+	}
+	return &partialClientFactory{ClientFactory: cf.ClientFactory.ForHost(host), unknown: unknown}
+}
+
+// checkProviderConfigurationKnown returns an error diagnostic when a client is about to be built
+// from a provider configuration with values that are not known until apply.
+func checkProviderConfigurationKnown(clientFactory langfuse.ClientFactory) diag.Diagnostics {
+	var diags diag.Diagnostics
+	partial, ok := clientFactory.(*partialClientFactory)
+	if !ok {
+		return diags
+	}
+
+	diags.AddError(
+		"Provider configuration value unknown at plan time",
+		fmt.Sprintf("Langfuse cannot be called before apply because the provider attributes %s are not known until then. "+
+			"Set them to values known at plan time, apply the resources they depend on first with -target, or use a Terraform "+
+			"version that supports deferred actions.", strings.Join(partial.unknown, ", ")),
+	)
+	return diags
+}
+
+// providerHostUnknown reports whether the instance the provider talks to is not known until apply.
+func providerHostUnknown(clientFactory langfuse.ClientFactory) bool {
+	partial, ok := clientFactory.(*partialClientFactory)
+	return ok && (slices.Contains(partial.unknown, "host") || slices.Contains(partial.unknown, "cloud_region"))
+}
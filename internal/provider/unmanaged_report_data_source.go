@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+)
+
+var _ datasource.DataSource = &unmanagedReportDataSource{}
+
+func NewUnmanagedReportDataSource() datasource.DataSource {
+	return &unmanagedReportDataSource{}
+}
+
+type unmanagedReportDataSourceModel struct {
+	ID                      types.String                       `tfsdk:"id"`
+	ManagedIDs              types.Set                          `tfsdk:"managed_ids"`
+	OrganizationCredentials types.Map                          `tfsdk:"organization_credentials"`
+	Organizations           []importInventoryOrganizationModel `tfsdk:"organizations"`
+	OrganizationApiKeys     []importInventoryApiKeyModel       `tfsdk:"organization_api_keys"`
+	Projects                []importInventoryProjectModel      `tfsdk:"projects"`
+	ProjectApiKeys          []importInventoryApiKeyModel       `tfsdk:"project_api_keys"`
+	Memberships             []importInventoryMembershipModel   `tfsdk:"memberships"`
+	UnmanagedCount          types.Int64                        `tfsdk:"unmanaged_count"`
+}
+
+type unmanagedReportDataSource struct {
+	ClientFactory langfuse.ClientFactory
+}
+
+func (d *unmanagedReportDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clientFactory, ok := req.ProviderData.(langfuse.ClientFactory)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected langfuse.ClientFactory, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.ClientFactory = clientFactory
+	resp.Diagnostics.Append(checkAdminAPIKeyConfigured(clientFactory, "langfuse_unmanaged_report")...)
+}
+
+func (d *unmanagedReportDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_unmanaged_report"
+}
+
+func (d *unmanagedReportDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := importInventoryAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Computed: true,
+	}
+	attributes["managed_ids"] = schema.SetAttribute{
+		ElementType: types.StringType,
+		Required:    true,
+		Description: "IDs of the objects managed by Terraform: organization and project IDs, API key IDs or public keys, and membership user IDs or emails.",
+	}
+	attributes["organization_credentials"] = schema.MapAttribute{
+		ElementType: types.StringType,
+		Optional:    true,
+		Description: "Map of organization ID to the name of provider-level `credentials` for that organization. " +
+			"Projects, project API keys and memberships are only checked for organizations present in this map.",
+	}
+	attributes["unmanaged_count"] = schema.Int64Attribute{
+		Computed:    true,
+		Description: "Number of unmanaged objects across all lists, e.g. to fail a scheduled pipeline with a `check` block.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Reports the organizations, projects, API keys and memberships that exist in Langfuse but are not managed by Terraform, " +
+			"given the IDs of the managed objects. Requires the admin API key.",
+		Attributes: attributes,
+	}
+}
+
+func (d *unmanagedReportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer addRunWarnings(&resp.Diagnostics, d.ClientFactory)
+
+	resp.Diagnostics.Append(checkAdminAPIAvailable(d.ClientFactory, "langfuse_unmanaged_report")...)
+	resp.Diagnostics.Append(checkInstanceVersion(ctx, d.ClientFactory, "langfuse_unmanaged_report")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data unmanagedReportDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	organizationCredentials := make(map[string]string)
+	if !data.OrganizationCredentials.IsNull() {
+		resp.Diagnostics.Append(data.OrganizationCredentials.ElementsAs(ctx, &organizationCredentials, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	var inventory importInventoryDataSourceModel
+	resp.Diagnostics.Append(readImportInventory(ctx, d.ClientFactory, organizationCredentials, &inventory, newImportBlockWriter())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	managed := setElements(data.ManagedIDs)
+	isManaged := func(identifiers ...types.String) bool {
+		for _, identifier := range identifiers {
+			if _, ok := managed[identifier.ValueString()]; ok {
+				return true
+			}
+		}
+		return false
+	}
+
+	data.Organizations = []importInventoryOrganizationModel{}
+	for _, organization := range inventory.Organizations {
+		if !isManaged(organization.ID) {
+			data.Organizations = append(data.Organizations, organization)
+		}
+	}
+	data.OrganizationApiKeys = []importInventoryApiKeyModel{}
+	for _, key := range inventory.OrganizationApiKeys {
+		if !isManaged(key.ID, key.PublicKey) {
+			data.OrganizationApiKeys = append(data.OrganizationApiKeys, key)
+		}
+	}
+	data.Projects = []importInventoryProjectModel{}
+	for _, project := range inventory.Projects {
+		if !isManaged(project.ID) {
+			data.Projects = append(data.Projects, project)
+		}
+	}
+	data.ProjectApiKeys = []importInventoryApiKeyModel{}
+	for _, key := range inventory.ProjectApiKeys {
+		if !isManaged(key.ID, key.PublicKey) {
+			data.ProjectApiKeys = append(data.ProjectApiKeys, key)
+		}
+	}
+	data.Memberships = []importInventoryMembershipModel{}
+	for _, membership := range inventory.Memberships {
+		if !isManaged(membership.UserID, membership.Email) {
+			data.Memberships = append(data.Memberships, membership)
+		}
+	}
+
+	data.ID = types.StringValue(d.ClientFactory.Host())
+	data.UnmanagedCount = types.Int64Value(int64(len(data.Organizations) + len(data.OrganizationApiKeys) + len(data.Projects) + len(data.ProjectApiKeys) + len(data.Memberships)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
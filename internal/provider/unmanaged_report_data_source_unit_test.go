@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse/mocks"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestUnmanagedReportDataSourceRead(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+
+	d := NewUnmanagedReportDataSource().(*unmanagedReportDataSource)
+	clientFactory := mocks.NewMockClientFactory(ctrl)
+	clientFactory.Credentials["acme"] = langfuse.OrganizationCredentials{PublicKey: "pk-org", PrivateKey: "sk-org"}
+	d.Configure(ctx, datasource.ConfigureRequest{ProviderData: clientFactory}, &datasource.ConfigureResponse{})
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	if diags := schemaResp.Schema.ValidateImplementation(ctx); diags.HasError() {
+		t.Fatalf("schema implementation validation failed: %v", diags)
+	}
+
+	clientFactory.AdminClient.EXPECT().ListOrganizations(ctx).Return([]*langfuse.Organization{
+		{ID: "org-1", Name: "Acme Inc"},
+		{ID: "org-2", Name: "Shadow"},
+	}, nil)
+	clientFactory.AdminClient.EXPECT().ListOrganizationApiKeys(ctx, "org-1").Return([]langfuse.OrganizationApiKey{{ID: "oak-1", PublicKey: "pk-lf-1"}}, nil)
+	clientFactory.AdminClient.EXPECT().ListOrganizationApiKeys(ctx, "org-2").Return([]langfuse.OrganizationApiKey{{ID: "oak-2", PublicKey: "pk-lf-2"}}, nil)
+	clientFactory.OrganizationClient.EXPECT().ListProjects(ctx).Return([]*langfuse.Project{{ID: "proj-1", Name: "Chat QA"}, {ID: "proj-2", Name: "Playground"}}, nil)
+	clientFactory.OrganizationClient.EXPECT().ListProjectApiKeys(ctx, "proj-1").Return([]langfuse.ProjectApiKey{{ID: "pak-1", PublicKey: "pk-lf-3"}, {ID: "pak-2", PublicKey: "pk-lf-4"}}, nil)
+	clientFactory.OrganizationClient.EXPECT().ListProjectApiKeys(ctx, "proj-2").Return(nil, nil)
+	clientFactory.OrganizationClient.EXPECT().ListMemberships(ctx).Return([]langfuse.OrganizationMembership{
+		{UserID: "user-1", Email: "jane@example.com", Role: "ADMIN"},
+		{UserID: "user-2", Email: "john@example.com", Role: "OWNER"},
+	}, nil)
+
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	managedIDs := []tftypes.Value{}
+	for _, id := range []string{"org-1", "pk-lf-1", "proj-1", "pak-1", "jane@example.com"} {
+		managedIDs = append(managedIDs, tftypes.NewValue(tftypes.String, id))
+	}
+	values := map[string]tftypes.Value{
+		"managed_ids": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, managedIDs),
+		"organization_credentials": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"org-1": tftypes.NewValue(tftypes.String, "acme"),
+		}),
+	}
+	for name, attributeType := range objectType.AttributeTypes {
+		if _, ok := values[name]; !ok {
+			values[name] = tftypes.NewValue(attributeType, nil)
+		}
+	}
+
+	readResp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	d.Read(ctx, datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics from Read: %v", readResp.Diagnostics)
+	}
+
+	var data unmanagedReportDataSourceModel
+	readResp.Diagnostics.Append(readResp.State.Get(ctx, &data)...)
+
+	if len(data.Organizations) != 1 || data.Organizations[0].ID.ValueString() != "org-2" {
+		t.Errorf("unexpected organizations: %v", data.Organizations)
+	}
+	if len(data.OrganizationApiKeys) != 1 || data.OrganizationApiKeys[0].ID.ValueString() != "oak-2" {
+		t.Errorf("unexpected organization API keys: %v", data.OrganizationApiKeys)
+	}
+	if len(data.Projects) != 1 || data.Projects[0].ImportID.ValueString() != "proj-2,org-1,acme" {
+		t.Errorf("unexpected projects: %v", data.Projects)
+	}
+	if len(data.ProjectApiKeys) != 1 || data.ProjectApiKeys[0].ID.ValueString() != "pak-2" {
+		t.Errorf("unexpected project API keys: %v", data.ProjectApiKeys)
+	}
+	if len(data.Memberships) != 1 || data.Memberships[0].UserID.ValueString() != "user-2" {
+		t.Errorf("unexpected memberships: %v", data.Memberships)
+	}
+	if data.UnmanagedCount.ValueInt64() != 5 {
+		t.Errorf("expected 5 unmanaged objects, got %d", data.UnmanagedCount.ValueInt64())
+	}
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &verifyWebhookSignatureFunction{}
+
+func NewVerifyWebhookSignatureFunction() function.Function {
+	return &verifyWebhookSignatureFunction{}
+}
+
+type verifyWebhookSignatureFunction struct{}
+
+func (f *verifyWebhookSignatureFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "verify_webhook_signature"
+}
+
+func (f *verifyWebhookSignatureFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Verifies the signature of a Langfuse webhook delivery",
+		Description: "Returns whether signature is the HMAC-SHA256 signature Langfuse computes with secret for payload. signature is the value of " +
+			"the `x-langfuse-signature` header, `t=<timestamp>,v1=<hex signature>`, where the signature covers `<timestamp>.<payload>`; " +
+			"a bare hex signature is checked against the payload alone.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "payload",
+				Description: "The raw request body of the delivery.",
+			},
+			function.StringParameter{
+				Name:        "signature",
+				Description: "The value of the `x-langfuse-signature` header.",
+			},
+			function.StringParameter{
+				Name:        "secret",
+				Description: "The signing secret of the webhook.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *verifyWebhookSignatureFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var payload, signature, secret string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &payload, &signature, &secret))
+	if resp.Error != nil {
+		return
+	}
+
+	timestamp, expected, ok := parseWebhookSignature(signature)
+	if !ok {
+		resp.Error = function.NewArgumentFuncError(1, "signature must be a hex signature or an x-langfuse-signature header value such as \"t=1720701136,v1=5f2b...\"")
+		return
+	}
+
+	signed := payload
+	if timestamp != "" {
+		signed = timestamp + "." + payload
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(signed))
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, hmac.Equal(mac.Sum(nil), expected)))
+}
+
+// parseWebhookSignature returns the timestamp and the decoded signature of a signature header
+// value, "t=<timestamp>,v1=<hex>", or of a bare hex signature, which has no timestamp.
+func parseWebhookSignature(signature string) (string, []byte, bool) {
+	signature = strings.TrimSpace(signature)
+	if !strings.Contains(signature, "=") {
+		decoded, err := hex.DecodeString(signature)
+		return "", decoded, err == nil && len(decoded) > 0
+	}
+
+	var timestamp, encoded string
+	for _, part := range strings.Split(signature, ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(part), "=")
+		switch key {
+		case "t":
+			timestamp = value
+		case "v1":
+			encoded = value
+		}
+	}
+	decoded, err := hex.DecodeString(encoded)
+	return timestamp, decoded, timestamp != "" && err == nil && len(decoded) > 0
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestVerifyWebhookSignatureFunction(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	payload := `{"id":"evt-1","type":"prompt-version.created"}`
+	sign := func(message string) string {
+		mac := hmac.New(sha256.New, []byte("whsec-123"))
+		mac.Write([]byte(message))
+		return hex.EncodeToString(mac.Sum(nil))
+	}
+
+	testCases := []struct {
+		name        string
+		signature   string
+		secret      string
+		want        bool
+		expectError bool
+	}{
+		{name: "header", signature: "t=1720701136,v1=" + sign("1720701136."+payload), secret: "whsec-123", want: true},
+		{name: "bare signature", signature: sign(payload), secret: "whsec-123", want: true},
+		{name: "wrong secret", signature: "t=1720701136,v1=" + sign("1720701136."+payload), secret: "whsec-456"},
+		{name: "other timestamp", signature: "t=1720701137,v1=" + sign("1720701136."+payload), secret: "whsec-123"},
+		{name: "malformed", signature: "t=1720701136,v1=zz", secret: "whsec-123", expectError: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{
+				types.StringValue(payload), types.StringValue(tc.signature), types.StringValue(tc.secret),
+			})}
+			resp := function.RunResponse{Result: function.NewResultData(types.BoolUnknown())}
+			NewVerifyWebhookSignatureFunction().Run(ctx, req, &resp)
+
+			if (resp.Error != nil) != tc.expectError {
+				t.Fatalf("expected error %t, got: %v", tc.expectError, resp.Error)
+			}
+			if tc.expectError {
+				return
+			}
+			if got := resp.Result.Value().(types.Bool).ValueBool(); got != tc.want {
+				t.Errorf("expected %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+)
+
+// minimumLangfuseVersion is the oldest Langfuse release shipping the organization-scoped
+// public API and the admin API that every resource of this provider relies on.
+const minimumLangfuseVersion = "3.0.0"
+
+// minimumLangfuseVersions holds, per resource and data source type, the oldest Langfuse release
+// shipping every endpoint the type calls, so that older instances get a version error naming the
+// type instead of the API's 404. Every registered type has an entry; a type that only needs the
+// APIs behind minimumLangfuseVersion lists that.
+var minimumLangfuseVersions = map[string]string{
+	"langfuse_organization":                  minimumLangfuseVersion,
+	"langfuse_organization_api_key":          minimumLangfuseVersion,
+	"langfuse_organization_api_keys":         minimumLangfuseVersion,
+	"langfuse_organization_membership":       minimumLangfuseVersion,
+	"langfuse_organization_memberships":      minimumLangfuseVersion,
+	"langfuse_organization_retention_policy": minimumLangfuseVersion,
+	"langfuse_project":                       minimumLangfuseVersion,
+	"langfuse_projects":                      minimumLangfuseVersion,
+	"langfuse_project_api_key":               minimumLangfuseVersion,
+	"langfuse_project_api_keys_policy":       minimumLangfuseVersion,
+	"langfuse_project_api_key_imports":       minimumLangfuseVersion,
+	"langfuse_import_inventory":              minimumLangfuseVersion,
+	"langfuse_unmanaged_report":              minimumLangfuseVersion,
+	"langfuse_observability_config":          minimumLangfuseVersion,
+	"langfuse_scim_user":                     minimumLangfuseVersion,
+	"langfuse_audit_logs":                    minimumLangfuseVersion,
+	"langfuse_ingestion_check":               minimumLangfuseVersion,
+	"langfuse_score":                         minimumLangfuseVersion,
+	// PATCH /api/public/v2/prompts/{name}/versions/{version}, which moves labels between versions.
+	"langfuse_prompt_release": "3.45.0",
+	// The label endpoint above and DELETE /api/public/v2/prompts/{name}.
+	"langfuse_prompt": "3.80.0",
+	// GET /api/public/metrics.
+	"langfuse_trace_counts": "3.60.0",
+}
+
+// minimumVersionOf returns the minimum Langfuse version of a resource or data source type.
+func minimumVersionOf(typeName string) string {
+	if minimum, ok := minimumLangfuseVersions[typeName]; ok {
+		return minimum
+	}
+	return minimumLangfuseVersion
+}
+
+// checkInstanceVersion returns an error diagnostic when the target instance is older than the
+// minimum version of the resource or data source type, naming the type and both versions. If the
+// version cannot be determined (health endpoint unreachable, development builds), the check is
+// skipped and the API call is left to fail on its own.
+func checkInstanceVersion(ctx context.Context, clientFactory langfuse.ClientFactory, typeName string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if clientFactory == nil {
+		return diags
+	}
+
+	detected, err := clientFactory.InstanceVersion(ctx)
+	if err != nil {
+		tflog.Debug(ctx, "Could not determine Langfuse version, skipping feature detection", map[string]any{"error": err.Error()})
+		return diags
+	}
+
+	detectedVersion, err := version.NewVersion(detected)
+	if err != nil {
+		tflog.Debug(ctx, "Could not parse Langfuse version, skipping feature detection", map[string]any{"version": detected})
+		return diags
+	}
+
+	minimum := minimumVersionOf(typeName)
+	if detectedVersion.Core().LessThan(version.Must(version.NewVersion(minimum))) {
+		diags.AddError(
+			"Unsupported Langfuse version",
+			fmt.Sprintf("%s requires Langfuse >= %s; detected %s. Upgrade the Langfuse instance to use this resource.", typeName, minimum, detected),
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse/mocks"
+)
+
+func TestCheckInstanceVersion(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		typeName       string
+		detected       string
+		expectedDetail string
+	}{
+		{name: "supported version", typeName: "langfuse_project", detected: "3.112.0"},
+		{name: "pre-release of the minimum version", typeName: "langfuse_project", detected: "3.0.0-rc.1"},
+		{
+			name: "unsupported version", typeName: "langfuse_project", detected: "2.95.1",
+			expectedDetail: "langfuse_project requires Langfuse >= 3.0.0; detected 2.95.1. Upgrade the Langfuse instance to use this resource.",
+		},
+		{name: "unknown version", typeName: "langfuse_project", detected: ""},
+		{
+			name: "type with a later minimum", typeName: "langfuse_trace_counts", detected: "3.59.2",
+			expectedDetail: "langfuse_trace_counts requires Langfuse >= 3.60.0; detected 3.59.2. Upgrade the Langfuse instance to use this resource.",
+		},
+		{name: "type with a later minimum on a newer instance", typeName: "langfuse_trace_counts", detected: "3.60.0"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			clientFactory := mocks.NewMockClientFactory(ctrl)
+			clientFactory.Version = tc.detected
+
+			diags := checkInstanceVersion(context.Background(), clientFactory, tc.typeName)
+			if diags.HasError() != (tc.expectedDetail != "") {
+				t.Fatalf("unexpected result. got error=%t, want error=%t: %v", diags.HasError(), tc.expectedDetail != "", diags)
+			}
+			if tc.expectedDetail != "" && diags.Errors()[0].Detail() != tc.expectedDetail {
+				t.Errorf("unexpected detail. got %q, want %q", diags.Errors()[0].Detail(), tc.expectedDetail)
+			}
+		})
+	}
+}
+
+func TestMinimumLangfuseVersionsCoverEveryType(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	p := New("test")()
+
+	var typeNames []string
+	for _, newResource := range p.Resources(ctx) {
+		var resp resource.MetadataResponse
+		newResource().Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "langfuse"}, &resp)
+		typeNames = append(typeNames, resp.TypeName)
+	}
+	for _, newDataSource := range p.(*langfuseProvider).DataSources(ctx) {
+		var resp datasource.MetadataResponse
+		newDataSource().Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: "langfuse"}, &resp)
+		typeNames = append(typeNames, resp.TypeName)
+	}
+
+	for _, typeName := range typeNames {
+		if _, ok := minimumLangfuseVersions[typeName]; !ok {
+			t.Errorf("%s has no minimum Langfuse version", typeName)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+// Package langfuse is the importable client of the Langfuse admin and public APIs the Terraform
+// provider is built on, for operators and CLIs that need to behave exactly like the provider:
+// the same authentication of admin, organization and project API keys, the same retries of
+// transient failures, pagination of list endpoints and API version negotiation.
+//
+// The client itself lives in the provider's internal package; this package is its stable facade.
+// Its types are aliases of the internal ones, and only what is declared here is kept compatible
+// across releases.
+package langfuse
+
+import (
+	"context"
+
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+)
+
+// Client creates the API clients of one Langfuse instance. The clients it creates share their HTTP
+// transport, the negotiated API version and the bound on concurrent project API key creation, so
+// a Client should be created once and reused.
+type Client struct {
+	factory langfuse.ClientFactory
+}
+
+// Option configures a Client.
+type Option = langfuse.ClientFactoryOption
+
+// New returns a Client for the instance at host, e.g. https://cloud.langfuse.com. adminAPIKey is
+// only needed for Admin and may be empty.
+func New(host, adminAPIKey string, opts ...Option) *Client {
+	return &Client{factory: langfuse.NewClientFactory(host, adminAPIKey, opts...)}
+}
+
+// WithRetryPolicies sets how transiently failed requests are retried, separately for reads
+// (GET, HEAD) and for writes. The defaults are DefaultReadRetryPolicy and DefaultWriteRetryPolicy.
+func WithRetryPolicies(read, write RetryPolicy) Option {
+	return langfuse.WithRetryPolicies(read, write)
+}
+
+// WithReadOnly makes every client refuse requests that could mutate data with a *ReadOnlyError.
+func WithReadOnly(readOnly bool) Option {
+	return langfuse.WithReadOnly(readOnly)
+}
+
+// WithRequestHeaders adds headers to every request, e.g. for gateways that route by tenant. Values
+// may reference the attributes set with WithRequestAttributes as ${organization_id} or ${project_id}.
+func WithRequestHeaders(headers map[string]string) Option {
+	return langfuse.WithRequestHeaders(headers)
+}
+
+// WithAuditLog appends a JSON line to the file at path for every mutating request.
+func WithAuditLog(path string) Option {
+	return langfuse.WithAuditLog(path)
+}
+
+// WithMaxResponseSize limits the decompressed size, in bytes, of response bodies. Zero or less
+// removes the limit.
+func WithMaxResponseSize(size int64) Option {
+	return langfuse.WithMaxResponseSize(size)
+}
+
+// WithKeyCreationConcurrency bounds the number of project API key creation calls in flight per
+// project. Zero or less removes the bound.
+func WithKeyCreationConcurrency(concurrency int) Option {
+	return langfuse.WithKeyCreationConcurrency(concurrency)
+}
+
+// WithRequestAttributes returns a context whose requests carry the given attributes, which header
+// templates of WithRequestHeaders can reference.
+func WithRequestAttributes(ctx context.Context, attributes map[string]string) context.Context {
+	return langfuse.WithRequestAttributes(ctx, attributes)
+}
+
+// Host returns the base URL of the instance.
+func (c *Client) Host() string {
+	return c.factory.Host()
+}
+
+// Admin returns a client of the admin API, authenticated with the admin API key.
+func (c *Client) Admin() AdminClient {
+	return c.factory.NewAdminClient()
+}
+
+// Organization returns a client of the organization-scoped API, authenticated with an organization
+// API key pair.
+func (c *Client) Organization(publicKey, privateKey string) OrganizationClient {
+	return c.factory.NewOrganizationClient(publicKey, privateKey)
+}
+
+// Project returns a client of the project-scoped API, authenticated with a project API key pair.
+func (c *Client) Project(publicKey, secretKey string) ProjectClient {
+	return c.factory.NewProjectClient(publicKey, secretKey)
+}
+
+// InstanceVersion returns the version reported by the instance's health endpoint. It is looked up
+// once per Client.
+func (c *Client) InstanceVersion(ctx context.Context) (string, error) {
+	return c.factory.InstanceVersion(ctx)
+}
@@ -0,0 +1,58 @@
+package langfuse_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse/fake"
+	"github.com/langfuse/terraform-provider-langfuse/langfuse"
+)
+
+func TestClient(t *testing.T) {
+	server := fake.NewServer()
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+	client := langfuse.New(server.URL, fake.AdminAPIKey)
+
+	organization, err := client.Admin().CreateOrganization(ctx, &langfuse.CreateOrganizationRequest{Name: "Acme"})
+	if err != nil {
+		t.Fatalf("unexpected error creating organization: %v", err)
+	}
+	key, err := client.Admin().CreateOrganizationApiKey(ctx, organization.ID)
+	if err != nil {
+		t.Fatalf("unexpected error creating organization API key: %v", err)
+	}
+
+	organizationClient := client.Organization(key.PublicKey, key.SecretKey)
+	project, err := organizationClient.CreateProject(ctx, &langfuse.CreateProjectRequest{Name: "checkout"})
+	if err != nil {
+		t.Fatalf("unexpected error creating project: %v", err)
+	}
+	projects, err := organizationClient.ListProjects(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error listing projects: %v", err)
+	}
+	if len(projects) != 1 || projects[0].ID != project.ID {
+		t.Errorf("expected project %s, got %v", project.ID, projects)
+	}
+
+	version, err := client.InstanceVersion(ctx)
+	if err != nil || version != fake.Version {
+		t.Errorf("expected version %s, got %q (%v)", fake.Version, version, err)
+	}
+}
+
+func TestClientReadOnly(t *testing.T) {
+	server := fake.NewServer()
+	t.Cleanup(server.Close)
+
+	client := langfuse.New(server.URL, fake.AdminAPIKey, langfuse.WithReadOnly(true))
+	_, err := client.Admin().CreateOrganization(context.Background(), &langfuse.CreateOrganizationRequest{Name: "Acme"})
+
+	var readOnlyErr *langfuse.ReadOnlyError
+	if !errors.As(err, &readOnlyErr) {
+		t.Fatalf("expected a ReadOnlyError, got: %v", err)
+	}
+}
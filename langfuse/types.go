@@ -0,0 +1,76 @@
+package langfuse
+
+import (
+	"github.com/langfuse/terraform-provider-langfuse/internal/langfuse"
+)
+
+// The API clients.
+type (
+	AdminClient        = langfuse.AdminClient
+	OrganizationClient = langfuse.OrganizationClient
+	ProjectClient      = langfuse.ProjectClient
+)
+
+// Objects of the admin API.
+type (
+	Organization              = langfuse.Organization
+	OrganizationApiKey        = langfuse.OrganizationApiKey
+	CreateOrganizationRequest = langfuse.CreateOrganizationRequest
+	UpdateOrganizationRequest = langfuse.UpdateOrganizationRequest
+)
+
+// Objects of the organization-scoped API.
+type (
+	Project                 = langfuse.Project
+	CreateProjectRequest    = langfuse.CreateProjectRequest
+	UpdateProjectRequest    = langfuse.UpdateProjectRequest
+	ProjectApiKey           = langfuse.ProjectApiKey
+	OrganizationMembership  = langfuse.OrganizationMembership
+	MembershipQuery         = langfuse.MembershipQuery
+	UpdateMembershipRequest = langfuse.UpdateMembershipRequest
+	SCIMUserRequest         = langfuse.SCIMUserRequest
+	SCIMUserResponse        = langfuse.SCIMUserResponse
+	AuditLog                = langfuse.AuditLog
+	AuditLogQuery           = langfuse.AuditLogQuery
+)
+
+// Objects of the project-scoped API.
+type (
+	IngestionTrace      = langfuse.IngestionTrace
+	Trace               = langfuse.Trace
+	CreateScoreRequest  = langfuse.CreateScoreRequest
+	Score               = langfuse.Score
+	Prompt              = langfuse.Prompt
+	PromptMessage       = langfuse.PromptMessage
+	CreatePromptRequest = langfuse.CreatePromptRequest
+	MetricsQuery        = langfuse.MetricsQuery
+	MetricsDimension    = langfuse.MetricsDimension
+	MetricsMetric       = langfuse.MetricsMetric
+	MetricsResponse     = langfuse.MetricsResponse
+)
+
+// Errors returned by the clients. Use errors.As and errors.Is to inspect them.
+type (
+	APIError      = langfuse.APIError
+	FieldError    = langfuse.FieldError
+	AuthError     = langfuse.AuthError
+	RetryError    = langfuse.RetryError
+	ReadOnlyError = langfuse.ReadOnlyError
+)
+
+var (
+	// ErrNotFound is wrapped by errors reporting that an object looked up by ID does not exist.
+	ErrNotFound = langfuse.ErrNotFound
+	// ErrAuditLogUnavailable is returned by ListAuditLogs when the instance does not serve the audit log API.
+	ErrAuditLogUnavailable = langfuse.ErrAuditLogUnavailable
+)
+
+// RetryPolicy describes how transiently failed requests are retried.
+type RetryPolicy = langfuse.RetryPolicy
+
+var (
+	// DefaultReadRetryPolicy applies to GET and HEAD requests unless WithRetryPolicies says otherwise.
+	DefaultReadRetryPolicy = langfuse.DefaultReadRetryPolicy
+	// DefaultWriteRetryPolicy applies to every other request unless WithRetryPolicies says otherwise.
+	DefaultWriteRetryPolicy = langfuse.DefaultWriteRetryPolicy
+)